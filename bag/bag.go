@@ -0,0 +1,256 @@
+// Package bag reads and writes ROS bag files of format version 2.0, so
+// topics can be recorded and played back without the Python rosbag tools.
+//
+// Messages are kept serialized. Connections hold the header of the
+// publisher, which has the type, MD5 sum and definition of its messages.
+package bag
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/akio/rosgo/ros"
+)
+
+const magic = "#ROSBAG V2.0\n"
+
+// Op codes of the records.
+const (
+	opMsgData    = 0x02
+	opBagHeader  = 0x03
+	opIndexData  = 0x04
+	opChunk      = 0x05
+	opChunkInfo  = 0x06
+	opConnection = 0x07
+)
+
+// The bag header record is padded to this size, so that it can be
+// rewritten in place when the bag is closed.
+const bagHeaderSize = 4096
+
+// Connection is a topic of a publisher recorded in a bag.
+type Connection struct {
+	ID    uint32
+	Topic string
+	// Connection header of the publisher, e.g. type, md5sum,
+	// message_definition, callerid and latching.
+	Header map[string]string
+}
+
+// MessageType returns the type of the messages, which are passed around
+// serialized.
+func (c *Connection) MessageType() *ros.RawMessageType {
+	return ros.NewRawMessageType(c.Header["type"], c.Header["md5sum"], c.Header["message_definition"])
+}
+
+// Latching reports whether the publisher was latched.
+func (c *Connection) Latching() bool {
+	return c.Header["latching"] == "1"
+}
+
+// Message is a serialized message recorded in a bag.
+type Message struct {
+	Connection *Connection
+	Time       ros.Time
+	Data       []byte
+}
+
+type field struct {
+	name  string
+	value []byte
+}
+
+func writeUint32(buf *bytes.Buffer, value uint32) {
+	binary.Write(buf, binary.LittleEndian, value)
+}
+
+func uint32Field(name string, value uint32) field {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, value)
+	return field{name, b}
+}
+
+func uint64Field(name string, value uint64) field {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, value)
+	return field{name, b}
+}
+
+func timeField(name string, t ros.Time) field {
+	return field{name, encodeTime(t)}
+}
+
+func encodeTime(t ros.Time) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint32(b, t.Sec)
+	binary.LittleEndian.PutUint32(b[4:], t.NSec)
+	return b
+}
+
+func decodeTime(b []byte) ros.Time {
+	return ros.NewTime(binary.LittleEndian.Uint32(b), binary.LittleEndian.Uint32(b[4:]))
+}
+
+// Encode fields as the headers of records and connections are, each with
+// its length and as name=value.
+func encodeFields(fields []field) []byte {
+	var buf bytes.Buffer
+	for _, f := range fields {
+		writeUint32(&buf, uint32(len(f.name)+1+len(f.value)))
+		buf.WriteString(f.name)
+		buf.WriteByte('=')
+		buf.Write(f.value)
+	}
+	return buf.Bytes()
+}
+
+func decodeFields(data []byte) (map[string][]byte, error) {
+	fields := make(map[string][]byte)
+	for len(data) > 0 {
+		if len(data) < 4 {
+			return nil, fmt.Errorf("truncated header field")
+		}
+		size := binary.LittleEndian.Uint32(data)
+		data = data[4:]
+		if uint64(size) > uint64(len(data)) {
+			return nil, fmt.Errorf("header field of %d bytes exceeds the header", size)
+		}
+		f := data[:size]
+		data = data[size:]
+		i := bytes.IndexByte(f, '=')
+		if i < 0 {
+			return nil, fmt.Errorf("header field without '=': %q", f)
+		}
+		fields[string(f[:i])] = f[i+1:]
+	}
+	return fields, nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func encodeConnectionHeader(header map[string]string) []byte {
+	var fields []field
+	for _, name := range sortedKeys(header) {
+		fields = append(fields, field{name, []byte(header[name])})
+	}
+	return encodeFields(fields)
+}
+
+func decodeConnectionHeader(data []byte) (map[string]string, error) {
+	fields, err := decodeFields(data)
+	if err != nil {
+		return nil, err
+	}
+	header := make(map[string]string)
+	for name, value := range fields {
+		header[name] = string(value)
+	}
+	return header, nil
+}
+
+func writeRecord(w io.Writer, fields []field, data []byte) error {
+	header := encodeFields(fields)
+	var buf bytes.Buffer
+	writeUint32(&buf, uint32(len(header)))
+	buf.Write(header)
+	writeUint32(&buf, uint32(len(data)))
+	buf.Write(data)
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// A record of which only the header has been read.
+type recordHeader struct {
+	fields  map[string][]byte
+	dataLen uint32
+}
+
+func readRecordHeader(r io.Reader) (*recordHeader, error) {
+	var size uint32
+	if err := binary.Read(r, binary.LittleEndian, &size); err != nil {
+		return nil, err
+	}
+	header := make([]byte, size)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	fields, err := decodeFields(header)
+	if err != nil {
+		return nil, err
+	}
+	rec := &recordHeader{fields: fields}
+	if err := binary.Read(r, binary.LittleEndian, &rec.dataLen); err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
+
+func (rec *recordHeader) op() (byte, error) {
+	op, ok := rec.fields["op"]
+	if !ok || len(op) != 1 {
+		return 0, fmt.Errorf("record without op")
+	}
+	return op[0], nil
+}
+
+// Check the op of the record.
+func (rec *recordHeader) expect(op byte) error {
+	actual, err := rec.op()
+	if err != nil {
+		return err
+	}
+	if actual != op {
+		return fmt.Errorf("expected record op %#x but %#x", op, actual)
+	}
+	return nil
+}
+
+func (rec *recordHeader) bytes(name string, size int) ([]byte, error) {
+	value, ok := rec.fields[name]
+	if !ok {
+		return nil, fmt.Errorf("record without %s", name)
+	}
+	if size >= 0 && len(value) != size {
+		return nil, fmt.Errorf("%s of %d bytes in record", name, len(value))
+	}
+	return value, nil
+}
+
+func (rec *recordHeader) uint32(name string) (uint32, error) {
+	value, err := rec.bytes(name, 4)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint32(value), nil
+}
+
+func (rec *recordHeader) uint64(name string) (uint64, error) {
+	value, err := rec.bytes(name, 8)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(value), nil
+}
+
+func (rec *recordHeader) time(name string) (ros.Time, error) {
+	value, err := rec.bytes(name, 8)
+	if err != nil {
+		return ros.Time{}, err
+	}
+	return decodeTime(value), nil
+}
+
+func (rec *recordHeader) string(name string) (string, error) {
+	value, err := rec.bytes(name, -1)
+	return string(value), err
+}
@@ -0,0 +1,273 @@
+package bag
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/akio/rosgo/ros"
+)
+
+// An io.WriteSeeker in memory.
+type memFile struct {
+	data []byte
+	pos  int
+}
+
+func (f *memFile) Write(p []byte) (int, error) {
+	if end := f.pos + len(p); end > len(f.data) {
+		f.data = append(f.data, make([]byte, end-len(f.data))...)
+	}
+	copy(f.data[f.pos:], p)
+	f.pos += len(p)
+	return len(p), nil
+}
+
+func (f *memFile) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+		f.pos = int(offset)
+	case io.SeekCurrent:
+		f.pos += int(offset)
+	case io.SeekEnd:
+		f.pos = len(f.data) + int(offset)
+	}
+	return int64(f.pos), nil
+}
+
+var chatterHeader = map[string]string{
+	"type":               "std_msgs/String",
+	"md5sum":             "992ce8a1687cec8c8bd883ec73ca41d1",
+	"message_definition": "string data\n",
+	"callerid":           "/talker",
+	"latching":           "0",
+}
+
+type readMessage struct {
+	topic string
+	time  ros.Time
+	data  string
+}
+
+func readAll(t *testing.T, r *Reader, topics ...string) []readMessage {
+	var msgs []readMessage
+	err := r.ReadMessages(topics, func(msg *Message) error {
+		msgs = append(msgs, readMessage{msg.Connection.Topic, msg.Time, string(msg.Data)})
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return msgs
+}
+
+func TestWriteAndRead(t *testing.T) {
+	f := &memFile{}
+	w, err := NewWriter(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Small chunks, so that messages are spread over several of them.
+	w.ChunkThreshold = 100
+	chatter := w.AddConnection("/chatter", chatterHeader)
+	odom := w.AddConnection("/odom", map[string]string{"type": "nav_msgs/Odometry", "md5sum": "*", "latching": "1"})
+	// Received out of order, as messages of different topics may be.
+	written := []readMessage{
+		{"/chatter", ros.NewTime(10, 0), "a"},
+		{"/odom", ros.NewTime(12, 0), "b"},
+		{"/chatter", ros.NewTime(11, 500), "c"},
+		{"/chatter", ros.NewTime(13, 0), strings.Repeat("d", 200)},
+		{"/odom", ros.NewTime(9, 0), ""},
+	}
+	for _, msg := range written {
+		conn := chatter
+		if msg.topic == "/odom" {
+			conn = odom
+		}
+		if err := w.WriteMessage(conn, msg.time, []byte(msg.data)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if len(w.chunkInfos) < 2 {
+		t.Errorf("expected several chunks but %d", len(w.chunkInfos))
+	}
+
+	r, err := NewReader(bytes.NewReader(f.data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	conns := r.Connections()
+	if len(conns) != 2 || conns[0].Topic != "/chatter" || conns[1].Topic != "/odom" {
+		t.Fatalf("unexpected connections %v", conns)
+	}
+	if conns[0].Header["callerid"] != "/talker" || conns[0].Header["topic"] != "/chatter" {
+		t.Errorf("unexpected header %v", conns[0].Header)
+	}
+	if msgType := conns[0].MessageType(); msgType.Name() != "std_msgs/String" || msgType.Text() != "string data\n" {
+		t.Errorf("unexpected type %s %q", msgType.Name(), msgType.Text())
+	}
+	if conns[0].Latching() || !conns[1].Latching() {
+		t.Error("unexpected latching")
+	}
+	if start, end := r.StartTime(), r.EndTime(); start != ros.NewTime(9, 0) || end != ros.NewTime(13, 0) {
+		t.Errorf("unexpected times %v %v", start, end)
+	}
+
+	expected := []readMessage{written[4], written[0], written[2], written[1], written[3]}
+	if msgs := readAll(t, r); !reflect.DeepEqual(msgs, expected) {
+		t.Errorf("expected %v but %v", expected, msgs)
+	}
+	expected = []readMessage{written[4], written[1]}
+	if msgs := readAll(t, r, "/odom"); !reflect.DeepEqual(msgs, expected) {
+		t.Errorf("expected %v but %v", expected, msgs)
+	}
+}
+
+func TestCreateAndOpen(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "test.bag")
+	w, err := Create(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteMessage(w.AddConnection("/chatter", chatterHeader), ros.NewTime(1, 0), []byte("a")); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The bag header is padded as rosbag does.
+	if !bytes.HasPrefix(data, []byte(magic)) || data[len(magic)+bagHeaderSize-1] != ' ' {
+		t.Error("unexpected bag header")
+	}
+
+	r, err := Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	expected := []readMessage{{"/chatter", ros.NewTime(1, 0), "a"}}
+	if msgs := readAll(t, r); !reflect.DeepEqual(msgs, expected) {
+		t.Errorf("expected %v but %v", expected, msgs)
+	}
+}
+
+func TestEmptyBag(t *testing.T) {
+	f := &memFile{}
+	w, err := NewWriter(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	r, err := NewReader(bytes.NewReader(f.data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msgs := readAll(t, r); len(msgs) != 0 || r.StartTime() != (ros.Time{}) {
+		t.Errorf("unexpected messages %v", msgs)
+	}
+}
+
+func TestReadInvalidBag(t *testing.T) {
+	if _, err := NewReader(strings.NewReader("#ROSBAG V1.2\n")); err == nil {
+		t.Error("bag of version 1.2 is accepted")
+	}
+	// A bag which is not closed has no index.
+	f := &memFile{}
+	w, err := NewWriter(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.WriteMessage(w.AddConnection("/chatter", chatterHeader), ros.NewTime(1, 0), []byte("a"))
+	w.flushChunk()
+	if _, err := NewReader(bytes.NewReader(f.data)); err == nil {
+		t.Error("bag without index is accepted")
+	}
+}
+
+// Chunk data of the connection of /chatter and a message "hi" at 1s,
+// compressed by Python's bz2 module.
+const bz2Chunk = "425a683931415926535919bb290d000023fb807da304000180bfe20a00bee3de2020009409553c68a6ca347a6900320f50f536906a69343431001a000015839c3c4a59c3620944214b0c4830014444659d2c4485d69b39da46b9b71c3146ebf487749a1643e89f8694678bdfa9d416e52af273940de4ada656b23062177160f8e91d2ab639405d480d2c1866a370ced1e55b9a3f46780b44fe2ee48a70a1203376521a"
+
+// Build a bag of a single chunk as rosbag writes it compressed.
+func compressedBag(compression string, compressed []byte, size int) []byte {
+	conn := &Connection{ID: 0, Topic: "/chatter", Header: map[string]string{
+		"topic": "/chatter", "type": "std_msgs/String", "md5sum": "992ce8a1687cec8c8bd883ec73ca41d1",
+	}}
+	var chunk bytes.Buffer
+	writeRecord(&chunk, []field{
+		{"op", []byte{opChunk}},
+		{"compression", []byte(compression)},
+		uint32Field("size", uint32(size)),
+	}, compressed)
+	// The message follows the connection record in the chunk.
+	var connRecord bytes.Buffer
+	connectionRecord(&connRecord, conn)
+	var index bytes.Buffer
+	index.Write(encodeTime(ros.NewTime(1, 0)))
+	writeUint32(&index, uint32(connRecord.Len()))
+	writeRecord(&chunk, []field{
+		{"op", []byte{opIndexData}},
+		uint32Field("ver", 1),
+		uint32Field("conn", 0),
+		uint32Field("count", 1),
+	}, index.Bytes())
+
+	chunkPos := uint64(len(magic) + bagHeaderSize)
+	var bag bytes.Buffer
+	bag.WriteString(magic)
+	w := &Writer{w: &memFile{}, connections: []*Connection{conn}, chunkInfos: []*chunkInfo{{}}}
+	w.writeBagHeader(chunkPos + uint64(chunk.Len()))
+	bag.Write(w.w.(*memFile).data)
+	bag.Write(chunk.Bytes())
+	connectionRecord(&bag, conn)
+	var counts bytes.Buffer
+	writeUint32(&counts, 0)
+	writeUint32(&counts, 1)
+	writeRecord(&bag, []field{
+		{"op", []byte{opChunkInfo}},
+		uint32Field("ver", 1),
+		uint64Field("chunk_pos", chunkPos),
+		timeField("start_time", ros.NewTime(1, 0)),
+		timeField("end_time", ros.NewTime(1, 0)),
+		uint32Field("count", 1),
+	}, counts.Bytes())
+	return bag.Bytes()
+}
+
+func TestReadCompressed(t *testing.T) {
+	compressed, err := hex.DecodeString(bz2Chunk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := NewReader(bytes.NewReader(compressedBag("bz2", compressed, 184)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []readMessage{{"/chatter", ros.NewTime(1, 0), "\x02\x00\x00\x00hi"}}
+	if msgs := readAll(t, r); !reflect.DeepEqual(msgs, expected) {
+		t.Errorf("expected %v but %v", expected, msgs)
+	}
+
+	r, err = NewReader(bytes.NewReader(compressedBag("lz4", compressed, 184)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = r.ReadMessages(nil, func(*Message) error { return nil })
+	if err == nil || !strings.Contains(err.Error(), "lz4") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
@@ -0,0 +1,195 @@
+package bag
+
+import (
+	"errors"
+	"time"
+
+	"github.com/akio/rosgo/msgs/rosgraph_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+type playOptions struct {
+	rate    float64
+	loop    bool
+	clockHz float64
+	delay   time.Duration
+	topics  []string
+}
+
+// PlayOption configures how Play publishes messages.
+type PlayOption func(*playOptions)
+
+// WithRate scales the time between messages by 1/rate. The default rate is
+// 1.
+func WithRate(rate float64) PlayOption {
+	return func(o *playOptions) {
+		o.rate = rate
+	}
+}
+
+// WithLoop plays the bag again after the last message until the node is
+// shut down.
+func WithLoop() PlayOption {
+	return func(o *playOptions) {
+		o.loop = true
+	}
+}
+
+// WithClock publishes the time of the bag on /clock at hz, so nodes using
+// simulated time follow the bag.
+func WithClock(hz float64) PlayOption {
+	return func(o *playOptions) {
+		o.clockHz = hz
+	}
+}
+
+// WithDelay waits after advertising the topics, so that subscribers can
+// connect before the first messages are published.
+func WithDelay(delay time.Duration) PlayOption {
+	return func(o *playOptions) {
+		o.delay = delay
+	}
+}
+
+// WithTopics plays only the topics.
+func WithTopics(topics ...string) PlayOption {
+	return func(o *playOptions) {
+		o.topics = topics
+	}
+}
+
+// The longest sleep between checks of whether the node is shut down.
+const playPollInterval = 100 * time.Millisecond
+
+// Publishers drop queued messages when shut down, so they are kept for this
+// long after the last message.
+const playLinger = 500 * time.Millisecond
+
+var errNodeShutdown = errors.New("node is shut down")
+
+type player struct {
+	node     ros.Node
+	options  playOptions
+	clockPub ros.Publisher
+	// Period of publishing the clock.
+	clockPeriod time.Duration
+	// Time of the bag played at wallStart.
+	bagStart  ros.Time
+	wallStart time.Time
+	lastClock time.Time
+}
+
+// Play publishes the messages of the bag with the time between them, like
+// rosbag play. Each topic is advertised with the type of its first
+// connection, and latched if it was recorded from a latched publisher.
+// Play returns when the messages are published or the node is shut down.
+func Play(node ros.Node, reader *Reader, options ...PlayOption) error {
+	p := &player{node: node, options: playOptions{rate: 1}, bagStart: reader.StartTime()}
+	for _, option := range options {
+		option(&p.options)
+	}
+	if p.options.rate <= 0 {
+		return errors.New("rate must be positive")
+	}
+	publishers := make(map[string]ros.Publisher)
+	for _, conn := range reader.Connections() {
+		if _, ok := publishers[conn.Topic]; ok || !p.selected(conn.Topic) {
+			continue
+		}
+		if conn.Latching() {
+			publishers[conn.Topic] = node.NewLatchedPublisher(conn.Topic, conn.MessageType())
+		} else {
+			publishers[conn.Topic] = node.NewPublisher(conn.Topic, conn.MessageType())
+		}
+	}
+	defer func() {
+		p.waitUntil(time.Now().Add(playLinger), false)
+		for _, pub := range publishers {
+			pub.Shutdown()
+		}
+	}()
+	if p.options.clockHz > 0 {
+		p.clockPub = node.NewPublisher("/clock", rosgraph_msgs.MsgClock)
+		p.clockPeriod = time.Duration(float64(time.Second) / p.options.clockHz)
+		defer p.clockPub.Shutdown()
+	}
+	p.wallStart = time.Now()
+	if !p.waitUntil(p.wallStart.Add(p.options.delay), false) {
+		return nil
+	}
+
+	for {
+		p.wallStart = time.Now()
+		published := false
+		err := reader.ReadMessages(p.options.topics, func(msg *Message) error {
+			if !p.waitUntil(p.wallTime(msg.Time), true) {
+				return errNodeShutdown
+			}
+			raw := msg.Connection.MessageType().NewMessage().(*ros.RawMessage)
+			raw.Data = msg.Data
+			publishers[msg.Connection.Topic].Publish(raw)
+			published = true
+			return nil
+		})
+		if err == errNodeShutdown {
+			return nil
+		}
+		// Looping over no messages would never sleep.
+		if err != nil || !published || !p.options.loop || !node.OK() {
+			return err
+		}
+	}
+}
+
+func (p *player) selected(topic string) bool {
+	for _, t := range p.options.topics {
+		if t == topic {
+			return true
+		}
+	}
+	return len(p.options.topics) == 0
+}
+
+// Wall time when a message of the time is published.
+func (p *player) wallTime(t ros.Time) time.Time {
+	offset := float64(t.ToNSec()) - float64(p.bagStart.ToNSec())
+	return p.wallStart.Add(time.Duration(offset / p.options.rate))
+}
+
+// Time of the bag played at the wall time.
+func (p *player) bagTime(wall time.Time) ros.Time {
+	var t ros.Time
+	t.FromNSec(p.bagStart.ToNSec() + uint64(float64(wall.Sub(p.wallStart))*p.options.rate))
+	return t
+}
+
+// Sleep until the wall time, publishing the clock if playing. It returns
+// false if the node is shut down.
+func (p *player) waitUntil(wall time.Time, playing bool) bool {
+	publishClock := playing && p.clockPub != nil
+	for p.node.OK() {
+		now := time.Now()
+		remaining := wall.Sub(now)
+		if publishClock && (remaining <= 0 || now.Sub(p.lastClock) >= p.clockPeriod) {
+			// The clock is not ahead of the message, even if it is late.
+			clock := wall
+			if remaining > 0 {
+				clock = now
+			}
+			p.clockPub.Publish(&rosgraph_msgs.Clock{Clock: p.bagTime(clock)})
+			p.lastClock = now
+		}
+		if remaining <= 0 {
+			return true
+		}
+		step := playPollInterval
+		if publishClock && p.clockPeriod < step {
+			step = p.clockPeriod
+		}
+		if remaining < step {
+			step = remaining
+		}
+		time.Sleep(step)
+	}
+	return false
+}
@@ -0,0 +1,127 @@
+package bag
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/akio/rosgo/internal/rostest"
+	"github.com/akio/rosgo/msgs/rosgraph_msgs"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+func TestPlayerTimes(t *testing.T) {
+	p := &player{options: playOptions{rate: 2}, bagStart: ros.NewTime(10, 0), wallStart: time.Unix(100, 0)}
+	if wall := p.wallTime(ros.NewTime(13, 0)); !wall.Equal(time.Unix(101, 500000000)) {
+		t.Errorf("unexpected wall time %v", wall)
+	}
+	if bagTime := p.bagTime(time.Unix(101, 0)); bagTime != ros.NewTime(12, 0) {
+		t.Errorf("unexpected bag time %v", bagTime)
+	}
+}
+
+// Number of messages recorded so far.
+func (r *Recorder) count() int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	count := 0
+	for _, info := range r.writer.chunkInfos {
+		for _, n := range info.counts {
+			count += int(n)
+		}
+	}
+	if info := r.writer.chunkInfo; info != nil {
+		for _, n := range info.counts {
+			count += int(n)
+		}
+	}
+	return count
+}
+
+func TestRecordAndPlay(t *testing.T) {
+	master := rostest.StartMaster(t)
+	name := filepath.Join(t.TempDir(), "chatter.bag")
+
+	// Record
+	talker := master.NewNode(t, "/talker")
+	pub := talker.NewPublisher("/chatter", std_msgs.MsgString)
+	recorderNode := master.NewNode(t, "/recorder")
+	writer, err := Create(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorder := NewRecorder(recorderNode, writer, []string{"/chatter"})
+	go recorderNode.Spin()
+	// Messages published before the connection are lost, so publish
+	// until enough are recorded.
+	deadline := time.Now().Add(5 * time.Second)
+	for recorder.count() < 3 {
+		if time.Now().After(deadline) {
+			t.Fatal("messages are not recorded")
+		}
+		pub.Publish(&std_msgs.String{Data: "hello"})
+		time.Sleep(20 * time.Millisecond)
+	}
+	if err := recorder.Shutdown(); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+	conns := reader.Connections()
+	if len(conns) != 1 || conns[0].Topic != "/chatter" || !strings.HasSuffix(conns[0].Header["callerid"], "/talker") ||
+		conns[0].MessageType().MD5Sum() != std_msgs.MsgString.MD5Sum() {
+		t.Fatalf("unexpected connection %s %v", conns[0].Topic, conns[0].Header)
+	}
+	recorded := 0
+	err = reader.ReadMessages(nil, func(msg *Message) error {
+		recorded++
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Play
+	listener := master.NewNode(t, "/listener")
+	received, _ := listener.SubscribeChan("/chatter", std_msgs.MsgString, recorded)
+	clocks, _ := listener.SubscribeChan("/clock", rosgraph_msgs.MsgClock, 1000)
+	playStart := time.Now()
+	err = Play(master.NewNode(t, "/player"), reader, WithRate(2), WithClock(100), WithDelay(500*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	start, end := reader.StartTime(), reader.EndTime()
+	duration := end.Diff(start)
+	if elapsed := time.Since(playStart); elapsed < time.Duration(duration.ToNSec())/2 {
+		t.Errorf("played %v of messages in %v", duration, elapsed)
+	}
+	for i := 0; i < recorded; i++ {
+		select {
+		case msg := <-received:
+			if data := msg.Message.(*std_msgs.String).Data; data != "hello" {
+				t.Errorf("unexpected message %q", data)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%d of %d messages are received", i, recorded)
+		}
+	}
+	// The clock follows the bag.
+	select {
+	case msg := <-clocks:
+		clock := msg.Message.(*rosgraph_msgs.Clock).Clock
+		if clock.Cmp(start) < 0 || clock.Cmp(end) > 0 {
+			t.Errorf("clock %v is out of %v and %v", clock, start, end)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no clock is received")
+	}
+}
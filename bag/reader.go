@@ -0,0 +1,368 @@
+package bag
+
+import (
+	"bytes"
+	"compress/bzip2"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+
+	"github.com/akio/rosgo/ros"
+)
+
+// A message found in the index of a chunk.
+type readerEntry struct {
+	time     ros.Time
+	chunkPos uint64
+	offset   uint32
+}
+
+// Reader reads an indexed bag. Chunks compressed with bz2 are read, lz4 is
+// not supported.
+type Reader struct {
+	r           io.ReadSeeker
+	closer      io.Closer
+	connections []*Connection
+	byID        map[uint32]*Connection
+	chunkInfos  []*chunkInfo
+	// The last chunk read, as messages are mostly read chunk by chunk.
+	chunkPos  uint64
+	chunkData []byte
+}
+
+// NewReader reads the index of the bag.
+func NewReader(r io.ReadSeeker) (*Reader, error) {
+	reader := &Reader{r: r, byID: make(map[uint32]*Connection)}
+	if err := reader.readIndex(); err != nil {
+		return nil, err
+	}
+	return reader, nil
+}
+
+// Open opens a bag file.
+func Open(name string) (*Reader, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := NewReader(f)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	reader.closer = f
+	return reader, nil
+}
+
+// Close closes the file if the reader was made by Open.
+func (r *Reader) Close() error {
+	if r.closer != nil {
+		return r.closer.Close()
+	}
+	return nil
+}
+
+func (r *Reader) skip(rec *recordHeader) error {
+	_, err := r.r.Seek(int64(rec.dataLen), io.SeekCurrent)
+	return err
+}
+
+func (r *Reader) readData(rec *recordHeader) ([]byte, error) {
+	data := make([]byte, rec.dataLen)
+	_, err := io.ReadFull(r.r, data)
+	return data, err
+}
+
+func (r *Reader) readIndex() error {
+	version := make([]byte, len(magic))
+	if _, err := io.ReadFull(r.r, version); err != nil {
+		return err
+	}
+	if string(version) != magic {
+		return fmt.Errorf("not a bag of version 2.0")
+	}
+	rec, err := readRecordHeader(r.r)
+	if err != nil {
+		return err
+	}
+	if err := rec.expect(opBagHeader); err != nil {
+		return err
+	}
+	indexPos, err := rec.uint64("index_pos")
+	if err != nil {
+		return err
+	}
+	connCount, err := rec.uint32("conn_count")
+	if err != nil {
+		return err
+	}
+	chunkCount, err := rec.uint32("chunk_count")
+	if err != nil {
+		return err
+	}
+	if indexPos == 0 {
+		return fmt.Errorf("bag is not indexed")
+	}
+	if _, err := r.r.Seek(int64(indexPos), io.SeekStart); err != nil {
+		return err
+	}
+	for i := uint32(0); i < connCount; i++ {
+		conn, err := r.readConnection()
+		if err != nil {
+			return err
+		}
+		r.connections = append(r.connections, conn)
+		r.byID[conn.ID] = conn
+	}
+	for i := uint32(0); i < chunkCount; i++ {
+		info, err := r.readChunkInfo()
+		if err != nil {
+			return err
+		}
+		r.chunkInfos = append(r.chunkInfos, info)
+	}
+	return nil
+}
+
+func (r *Reader) readConnection() (*Connection, error) {
+	rec, err := readRecordHeader(r.r)
+	if err != nil {
+		return nil, err
+	}
+	if err := rec.expect(opConnection); err != nil {
+		return nil, err
+	}
+	conn := &Connection{}
+	if conn.ID, err = rec.uint32("conn"); err != nil {
+		return nil, err
+	}
+	if conn.Topic, err = rec.string("topic"); err != nil {
+		return nil, err
+	}
+	data, err := r.readData(rec)
+	if err != nil {
+		return nil, err
+	}
+	if conn.Header, err = decodeConnectionHeader(data); err != nil {
+		return nil, err
+	}
+	return conn, nil
+}
+
+func (r *Reader) readChunkInfo() (*chunkInfo, error) {
+	rec, err := readRecordHeader(r.r)
+	if err != nil {
+		return nil, err
+	}
+	if err := rec.expect(opChunkInfo); err != nil {
+		return nil, err
+	}
+	info := &chunkInfo{counts: make(map[uint32]uint32)}
+	if info.pos, err = rec.uint64("chunk_pos"); err != nil {
+		return nil, err
+	}
+	if info.startTime, err = rec.time("start_time"); err != nil {
+		return nil, err
+	}
+	if info.endTime, err = rec.time("end_time"); err != nil {
+		return nil, err
+	}
+	data, err := r.readData(rec)
+	if err != nil {
+		return nil, err
+	}
+	for ; len(data) >= 8; data = data[8:] {
+		info.counts[binary.LittleEndian.Uint32(data)] = binary.LittleEndian.Uint32(data[4:])
+	}
+	return info, nil
+}
+
+// Connections returns the connections in the bag.
+func (r *Reader) Connections() []*Connection {
+	return r.connections
+}
+
+// StartTime returns the time of the first message, or the zero time if the
+// bag has none.
+func (r *Reader) StartTime() ros.Time {
+	var start ros.Time
+	for i, info := range r.chunkInfos {
+		if i == 0 || info.startTime.Cmp(start) < 0 {
+			start = info.startTime
+		}
+	}
+	return start
+}
+
+// EndTime returns the time of the last message, or the zero time if the bag
+// has none.
+func (r *Reader) EndTime() ros.Time {
+	var end ros.Time
+	for _, info := range r.chunkInfos {
+		if info.endTime.Cmp(end) > 0 {
+			end = info.endTime
+		}
+	}
+	return end
+}
+
+// Read the index records following the chunk at the position.
+func (r *Reader) readChunkIndex(info *chunkInfo, topics map[uint32]bool) ([]readerEntry, error) {
+	if _, err := r.r.Seek(int64(info.pos), io.SeekStart); err != nil {
+		return nil, err
+	}
+	rec, err := readRecordHeader(r.r)
+	if err != nil {
+		return nil, err
+	}
+	if err := rec.expect(opChunk); err != nil {
+		return nil, err
+	}
+	if err := r.skip(rec); err != nil {
+		return nil, err
+	}
+	var entries []readerEntry
+	for range info.counts {
+		rec, err := readRecordHeader(r.r)
+		if err != nil {
+			return nil, err
+		}
+		if err := rec.expect(opIndexData); err != nil {
+			return nil, err
+		}
+		id, err := rec.uint32("conn")
+		if err != nil {
+			return nil, err
+		}
+		if !topics[id] {
+			if err := r.skip(rec); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		data, err := r.readData(rec)
+		if err != nil {
+			return nil, err
+		}
+		for ; len(data) >= 12; data = data[12:] {
+			entries = append(entries, readerEntry{decodeTime(data), info.pos, binary.LittleEndian.Uint32(data[8:])})
+		}
+	}
+	return entries, nil
+}
+
+// Read the data of the chunk at the position, decompressed.
+func (r *Reader) readChunk(pos uint64) ([]byte, error) {
+	if r.chunkData != nil && r.chunkPos == pos {
+		return r.chunkData, nil
+	}
+	if _, err := r.r.Seek(int64(pos), io.SeekStart); err != nil {
+		return nil, err
+	}
+	rec, err := readRecordHeader(r.r)
+	if err != nil {
+		return nil, err
+	}
+	if err := rec.expect(opChunk); err != nil {
+		return nil, err
+	}
+	compression, err := rec.string("compression")
+	if err != nil {
+		return nil, err
+	}
+	size, err := rec.uint32("size")
+	if err != nil {
+		return nil, err
+	}
+	data, err := r.readData(rec)
+	if err != nil {
+		return nil, err
+	}
+	switch compression {
+	case "none":
+	case "bz2":
+		if data, err = ioutil.ReadAll(bzip2.NewReader(bytes.NewReader(data))); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("chunks compressed with %s are not supported", compression)
+	}
+	if uint32(len(data)) != size {
+		return nil, fmt.Errorf("chunk of %d bytes is %d bytes", size, len(data))
+	}
+	r.chunkPos, r.chunkData = pos, data
+	return data, nil
+}
+
+func (r *Reader) readMessage(entry readerEntry) (*Message, error) {
+	chunk, err := r.readChunk(entry.chunkPos)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(entry.offset) >= uint64(len(chunk)) {
+		return nil, fmt.Errorf("message at %d is out of the chunk", entry.offset)
+	}
+	reader := bytes.NewReader(chunk[entry.offset:])
+	rec, err := readRecordHeader(reader)
+	if err != nil {
+		return nil, err
+	}
+	if err := rec.expect(opMsgData); err != nil {
+		return nil, err
+	}
+	id, err := rec.uint32("conn")
+	if err != nil {
+		return nil, err
+	}
+	msg := &Message{Connection: r.byID[id]}
+	if msg.Connection == nil {
+		return nil, fmt.Errorf("message of unknown connection %d", id)
+	}
+	if msg.Time, err = rec.time("time"); err != nil {
+		return nil, err
+	}
+	if uint64(rec.dataLen) > uint64(reader.Len()) {
+		return nil, fmt.Errorf("message of %d bytes exceeds the chunk", rec.dataLen)
+	}
+	msg.Data = make([]byte, rec.dataLen)
+	reader.Read(msg.Data)
+	return msg, nil
+}
+
+// ReadMessages calls the callback with the messages of the topics in order
+// of time, or with all messages if no topic is given. It stops at the first
+// error of the callback and returns it.
+func (r *Reader) ReadMessages(topics []string, callback func(*Message) error) error {
+	ids := make(map[uint32]bool)
+	for _, conn := range r.connections {
+		selected := len(topics) == 0
+		for _, topic := range topics {
+			selected = selected || conn.Topic == topic
+		}
+		ids[conn.ID] = selected
+	}
+	var entries []readerEntry
+	for _, info := range r.chunkInfos {
+		chunkEntries, err := r.readChunkIndex(info, ids)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, chunkEntries...)
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].time.Cmp(entries[j].time) < 0
+	})
+	for _, entry := range entries {
+		msg, err := r.readMessage(entry)
+		if err != nil {
+			return err
+		}
+		if err := callback(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
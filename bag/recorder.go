@@ -0,0 +1,70 @@
+package bag
+
+import (
+	"sync"
+
+	"github.com/akio/rosgo/ros"
+)
+
+// Recorder writes the messages of topics to a bag as they are received,
+// like rosbag record. Messages of any type are recorded serialized, and
+// callbacks are called by Spin of the node.
+type Recorder struct {
+	writer      *Writer
+	subscribers []ros.Subscriber
+	mutex       sync.Mutex
+	// Connections by topic, publisher and MD5 sum.
+	connections map[[3]string]*Connection
+	err         error
+	// Messages still queued when shut down are not written.
+	shutdown bool
+}
+
+// NewRecorder subscribes to the topics and writes their messages to the
+// writer.
+func NewRecorder(node ros.Node, writer *Writer, topics []string) *Recorder {
+	r := &Recorder{writer: writer, connections: make(map[[3]string]*Connection)}
+	for _, topic := range topics {
+		topic := topic
+		r.subscribers = append(r.subscribers, node.NewSubscriber(topic, ros.AnyMessageType,
+			func(msg *ros.RawMessage, event ros.MessageEvent) {
+				r.record(topic, msg, event)
+			}))
+	}
+	return r
+}
+
+func (r *Recorder) record(topic string, msg *ros.RawMessage, event ros.MessageEvent) {
+	header := event.ConnectionHeader
+	// Publishers tell the resolved name of the topic.
+	if name := header["topic"]; name != "" {
+		topic = name
+	}
+	var t ros.Time
+	t.FromNSec(uint64(event.ReceiptTime.UnixNano()))
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.err != nil || r.shutdown {
+		return
+	}
+	key := [3]string{topic, header["callerid"], header["md5sum"]}
+	conn, ok := r.connections[key]
+	if !ok {
+		conn = r.writer.AddConnection(topic, header)
+		r.connections[key] = conn
+	}
+	r.err = r.writer.WriteMessage(conn, t, msg.Data)
+}
+
+// Shutdown unsubscribes the topics and returns the first error of writing
+// messages. The writer is left open.
+func (r *Recorder) Shutdown() error {
+	for _, sub := range r.subscribers {
+		sub.Shutdown()
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.shutdown = true
+	return r.err
+}
@@ -0,0 +1,233 @@
+package bag
+
+import (
+	"bytes"
+	"io"
+	"os"
+
+	"github.com/akio/rosgo/ros"
+)
+
+// Messages are written in chunks of about this size.
+const DefaultChunkThreshold = 768 * 1024
+
+type indexEntry struct {
+	time   ros.Time
+	offset uint32
+}
+
+type chunkInfo struct {
+	pos       uint64
+	startTime ros.Time
+	endTime   ros.Time
+	// Number of messages of each connection in the chunk.
+	counts map[uint32]uint32
+}
+
+// Writer writes a bag. Chunks are not compressed. The index is written when
+// the writer is closed, and readers reject bags without it.
+type Writer struct {
+	w      io.WriteSeeker
+	closer io.Closer
+	// Position where the next record is written.
+	pos         uint64
+	connections []*Connection
+	// ChunkThreshold is the size of the chunk data from which the chunk
+	// is written.
+	ChunkThreshold int
+	chunk          bytes.Buffer
+	// Connections whose records are in the current chunk.
+	chunkConns map[uint32]bool
+	index      map[uint32][]indexEntry
+	// Order in which connections appear in the current chunk.
+	indexOrder []uint32
+	chunkInfo  *chunkInfo
+	chunkInfos []*chunkInfo
+}
+
+// NewWriter starts writing a bag to w.
+func NewWriter(w io.WriteSeeker) (*Writer, error) {
+	writer := &Writer{w: w, ChunkThreshold: DefaultChunkThreshold}
+	if _, err := io.WriteString(w, magic); err != nil {
+		return nil, err
+	}
+	writer.pos = uint64(len(magic))
+	// Written again with the position of the index when closed.
+	if err := writer.writeBagHeader(0); err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+// Create creates a bag file, truncating an existing one.
+func Create(name string) (*Writer, error) {
+	f, err := os.Create(name)
+	if err != nil {
+		return nil, err
+	}
+	writer, err := NewWriter(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	writer.closer = f
+	return writer, nil
+}
+
+func (w *Writer) writeBagHeader(indexPos uint64) error {
+	fields := []field{
+		{"op", []byte{opBagHeader}},
+		uint64Field("index_pos", indexPos),
+		uint32Field("conn_count", uint32(len(w.connections))),
+		uint32Field("chunk_count", uint32(len(w.chunkInfos))),
+	}
+	// The lengths of the header and the data take 8 bytes.
+	padding := bytes.Repeat([]byte{' '}, bagHeaderSize-8-len(encodeFields(fields)))
+	var buf bytes.Buffer
+	writeRecord(&buf, fields, padding)
+	_, err := w.w.Write(buf.Bytes())
+	w.pos += uint64(buf.Len())
+	return err
+}
+
+// AddConnection adds a connection of the topic with the connection header
+// of its publisher. The topic in the header is set to the topic.
+func (w *Writer) AddConnection(topic string, header map[string]string) *Connection {
+	conn := &Connection{ID: uint32(len(w.connections)), Topic: topic, Header: make(map[string]string)}
+	for name, value := range header {
+		conn.Header[name] = value
+	}
+	conn.Header["topic"] = topic
+	w.connections = append(w.connections, conn)
+	return conn
+}
+
+func connectionRecord(w io.Writer, conn *Connection) error {
+	return writeRecord(w, []field{
+		{"op", []byte{opConnection}},
+		uint32Field("conn", conn.ID),
+		{"topic", []byte(conn.Topic)},
+	}, encodeConnectionHeader(conn.Header))
+}
+
+// WriteMessage writes serialized message data received at t.
+func (w *Writer) WriteMessage(conn *Connection, t ros.Time, data []byte) error {
+	if w.chunkInfo == nil {
+		w.chunkInfo = &chunkInfo{pos: w.pos, startTime: t, endTime: t, counts: make(map[uint32]uint32)}
+		w.chunkConns = make(map[uint32]bool)
+		w.index = make(map[uint32][]indexEntry)
+		w.indexOrder = nil
+	}
+	if !w.chunkConns[conn.ID] {
+		if err := connectionRecord(&w.chunk, conn); err != nil {
+			return err
+		}
+		w.chunkConns[conn.ID] = true
+		w.indexOrder = append(w.indexOrder, conn.ID)
+	}
+	w.index[conn.ID] = append(w.index[conn.ID], indexEntry{t, uint32(w.chunk.Len())})
+	err := writeRecord(&w.chunk, []field{
+		{"op", []byte{opMsgData}},
+		uint32Field("conn", conn.ID),
+		timeField("time", t),
+	}, data)
+	if err != nil {
+		return err
+	}
+	info := w.chunkInfo
+	info.counts[conn.ID]++
+	if t.Cmp(info.startTime) < 0 {
+		info.startTime = t
+	}
+	if t.Cmp(info.endTime) > 0 {
+		info.endTime = t
+	}
+	if w.chunk.Len() >= w.ChunkThreshold {
+		return w.flushChunk()
+	}
+	return nil
+}
+
+// Write the current chunk and its index.
+func (w *Writer) flushChunk() error {
+	if w.chunkInfo == nil {
+		return nil
+	}
+	var buf bytes.Buffer
+	writeRecord(&buf, []field{
+		{"op", []byte{opChunk}},
+		{"compression", []byte("none")},
+		uint32Field("size", uint32(w.chunk.Len())),
+	}, w.chunk.Bytes())
+	for _, id := range w.indexOrder {
+		entries := w.index[id]
+		var data bytes.Buffer
+		for _, entry := range entries {
+			data.Write(encodeTime(entry.time))
+			writeUint32(&data, entry.offset)
+		}
+		writeRecord(&buf, []field{
+			{"op", []byte{opIndexData}},
+			uint32Field("ver", 1),
+			uint32Field("conn", id),
+			uint32Field("count", uint32(len(entries))),
+		}, data.Bytes())
+	}
+	if _, err := w.w.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	w.pos += uint64(buf.Len())
+	w.chunkInfos = append(w.chunkInfos, w.chunkInfo)
+	w.chunkInfo = nil
+	w.chunk.Reset()
+	return nil
+}
+
+// Close writes the rest of the messages and the index. The file is closed
+// if the writer was made by Create.
+func (w *Writer) Close() error {
+	err := w.close()
+	if w.closer != nil {
+		if closeErr := w.closer.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+func (w *Writer) close() error {
+	if err := w.flushChunk(); err != nil {
+		return err
+	}
+	indexPos := w.pos
+	var buf bytes.Buffer
+	for _, conn := range w.connections {
+		connectionRecord(&buf, conn)
+	}
+	for _, info := range w.chunkInfos {
+		var data bytes.Buffer
+		var ids []uint32
+		for _, conn := range w.connections {
+			if count, ok := info.counts[conn.ID]; ok {
+				writeUint32(&data, conn.ID)
+				writeUint32(&data, count)
+				ids = append(ids, conn.ID)
+			}
+		}
+		writeRecord(&buf, []field{
+			{"op", []byte{opChunkInfo}},
+			uint32Field("ver", 1),
+			uint64Field("chunk_pos", info.pos),
+			timeField("start_time", info.startTime),
+			timeField("end_time", info.endTime),
+			uint32Field("count", uint32(len(ids))),
+		}, data.Bytes())
+	}
+	if _, err := w.w.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	if _, err := w.w.Seek(int64(len(magic)), io.SeekStart); err != nil {
+		return err
+	}
+	return w.writeBagHeader(indexPos)
+}
@@ -0,0 +1,134 @@
+// Command rosgo provides ROS command line tools for Go-only deployments.
+//
+//	rosgo bag record [-O <BAG>] <TOPIC>...
+//	rosgo bag play [-r <RATE>] [-l] [-clock] [-hz <HZ>] [-d <SEC>] [-topics <TOPIC,...>] <BAG>
+//
+// Options come before the other arguments. Remapping arguments such as
+// __master:=http://robot:11311 are passed to the node.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/akio/rosgo/bag"
+	"github.com/akio/rosgo/ros"
+)
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "USAGE: rosgo bag record [<OPTIONS>] <TOPIC>...")
+	fmt.Fprintln(os.Stderr, "       rosgo bag play [<OPTIONS>] <BAG>")
+}
+
+// Split arguments into remapping ones for the node and the others.
+func splitRosArgs(args []string) ([]string, []string) {
+	var rosArgs, rest []string
+	for _, arg := range args {
+		if strings.Contains(arg, ":=") {
+			rosArgs = append(rosArgs, arg)
+		} else {
+			rest = append(rest, arg)
+		}
+	}
+	return rosArgs, rest
+}
+
+func record(args []string) error {
+	flags := flag.NewFlagSet("record", flag.ExitOnError)
+	output := flags.String("O", "", "name of the bag (default: <TIME>.bag)")
+	flags.Parse(args)
+	rosArgs, topics := splitRosArgs(flags.Args())
+	if len(topics) == 0 {
+		flags.Usage()
+		os.Exit(-1)
+	}
+	name := *output
+	if name == "" {
+		name = time.Now().Format("2006-01-02-15-04-05") + ".bag"
+	}
+
+	node, err := ros.NewNode(fmt.Sprintf("/record_%d", os.Getpid()), rosArgs)
+	if err != nil {
+		return err
+	}
+	defer node.Shutdown()
+	writer, err := bag.Create(name)
+	if err != nil {
+		return err
+	}
+	recorder := bag.NewRecorder(node, writer, topics)
+	node.Logger().Infof("Recording to %s", name)
+	// Until interrupted.
+	node.Spin()
+	err = recorder.Shutdown()
+	if closeErr := writer.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+func play(args []string) error {
+	flags := flag.NewFlagSet("play", flag.ExitOnError)
+	rate := flags.Float64("r", 1, "multiply the publish rate by the factor")
+	loop := flags.Bool("l", false, "loop playback")
+	clock := flags.Bool("clock", false, "publish the clock time")
+	hz := flags.Float64("hz", 100, "publish the clock time at the frequency")
+	delay := flags.Float64("d", 0.2, "sleep seconds after every advertise call")
+	topics := flags.String("topics", "", "comma-separated topics to play (default: all)")
+	flags.Parse(args)
+	rosArgs, rest := splitRosArgs(flags.Args())
+	if len(rest) != 1 {
+		flags.Usage()
+		os.Exit(-1)
+	}
+
+	reader, err := bag.Open(rest[0])
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	node, err := ros.NewNode(fmt.Sprintf("/play_%d", os.Getpid()), rosArgs)
+	if err != nil {
+		return err
+	}
+	defer node.Shutdown()
+	options := []bag.PlayOption{
+		bag.WithRate(*rate),
+		bag.WithDelay(time.Duration(*delay * float64(time.Second))),
+	}
+	if *loop {
+		options = append(options, bag.WithLoop())
+	}
+	if *clock {
+		options = append(options, bag.WithClock(*hz))
+	}
+	if *topics != "" {
+		options = append(options, bag.WithTopics(strings.Split(*topics, ",")...))
+	}
+	return bag.Play(node, reader, options...)
+}
+
+func main() {
+	args := os.Args[1:]
+	if len(args) < 2 || args[0] != "bag" {
+		usage()
+		os.Exit(-1)
+	}
+	var err error
+	switch args[1] {
+	case "record":
+		err = record(args[2:])
+	case "play":
+		err = play(args[2:])
+	default:
+		usage()
+		os.Exit(-1)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
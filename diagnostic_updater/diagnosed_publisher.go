@@ -0,0 +1,60 @@
+package diagnostic_updater
+
+import (
+	"github.com/akio/rosgo/ros"
+)
+
+// DiagnosedPublisher is a publisher which monitors the rate of published
+// messages and the stamps of their headers. The task named
+// "<name> topic status" is added to the updater.
+type DiagnosedPublisher struct {
+	ros.Publisher
+	freq  *FrequencyStatus
+	stamp *TimeStampStatus
+	task  *CompositeTask
+}
+
+func NewDiagnosedPublisher(pub ros.Publisher, updater *Updater, name string, freq FrequencyStatusParam, stamp TimeStampStatusParam) *DiagnosedPublisher {
+	p := &DiagnosedPublisher{
+		Publisher: pub,
+		freq:      NewFrequencyStatus(freq),
+		stamp:     NewTimeStampStatus(stamp),
+	}
+	p.task = NewCompositeTask(name+" topic status", p.freq, p.stamp)
+	updater.AddTask(p.task)
+	return p
+}
+
+// NewHeaderlessDiagnosedPublisher creates a publisher which monitors only
+// the rate, for messages without a header.
+func NewHeaderlessDiagnosedPublisher(pub ros.Publisher, updater *Updater, name string, freq FrequencyStatusParam) *DiagnosedPublisher {
+	p := &DiagnosedPublisher{
+		Publisher: pub,
+		freq:      NewFrequencyStatus(freq),
+	}
+	p.task = NewCompositeTask(name+" topic status", p.freq)
+	updater.AddTask(p.task)
+	return p
+}
+
+// Publish records the message and publishes it.
+func (p *DiagnosedPublisher) Publish(msg ros.Message) {
+	p.freq.Tick()
+	if p.stamp != nil {
+		if stamp, ok := ros.HeaderStamp(msg); ok {
+			p.stamp.Tick(stamp)
+		}
+	}
+	p.Publisher.Publish(msg)
+}
+
+// FrequencyStatus returns the task checking the rate.
+func (p *DiagnosedPublisher) FrequencyStatus() *FrequencyStatus {
+	return p.freq
+}
+
+// TimeStampStatus returns the task checking the stamps, or nil for a
+// headerless publisher.
+func (p *DiagnosedPublisher) TimeStampStatus() *TimeStampStatus {
+	return p.stamp
+}
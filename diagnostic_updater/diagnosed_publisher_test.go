@@ -0,0 +1,64 @@
+package diagnostic_updater
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/akio/rosgo/msgs/diagnostic_msgs"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+type stampedMsg struct {
+	Header std_msgs.Header `rosmsg:"header:Header"`
+}
+
+func (m *stampedMsg) Type() ros.MessageType               { return nil }
+func (m *stampedMsg) Serialize(buf *bytes.Buffer) error   { return nil }
+func (m *stampedMsg) Deserialize(buf *bytes.Reader) error { return nil }
+
+func TestDiagnosedPublisher(t *testing.T) {
+	node := newTestNode()
+	updater := NewUpdater(node)
+	defer updater.Shutdown()
+	updater.SetHardwareID("none")
+	pub := NewDiagnosedPublisher(node.NewPublisher("/points", nil), updater, "/points",
+		NewFrequencyStatusParam(10, 10), TimeStampStatusParam{MinAcceptable: -1, MaxAcceptable: 5})
+	msg := &stampedMsg{}
+	msg.Header.Stamp = ros.Now()
+	pub.Publish(msg)
+	if published := node.Published("/points"); len(published) != 1 {
+		t.Fatalf("published %d messages", len(published))
+	}
+
+	updater.Update()
+	stat := lastDiagnostics(t, node).Status[0]
+	if stat.Name != "test_node: /points topic status" {
+		t.Errorf("unexpected name %s", stat.Name)
+	}
+	// Published once in a moment.
+	if stat.Level != diagnostic_msgs.DiagnosticStatus_WARN || stat.Message != "Frequency too high." {
+		t.Errorf("unexpected status %d %q", stat.Level, stat.Message)
+	}
+	wrapped := &Status{stat}
+	if value(wrapped, "Events since startup") != "1" || value(wrapped, "Late diagnostic update count:") != "0" {
+		t.Errorf("unexpected values %v", stat.Values)
+	}
+}
+
+func TestHeaderlessDiagnosedPublisher(t *testing.T) {
+	node := newTestNode()
+	updater := NewUpdater(node)
+	defer updater.Shutdown()
+	updater.SetHardwareID("none")
+	pub := NewHeaderlessDiagnosedPublisher(node.NewPublisher("/count", std_msgs.MsgInt32), updater, "/count", NewFrequencyStatusParam(1, 1))
+	if pub.TimeStampStatus() != nil {
+		t.Error("headerless publisher checks stamps")
+	}
+	pub.Publish(&std_msgs.Int32{})
+	updater.Update()
+	stat := &Status{lastDiagnostics(t, node).Status[0]}
+	if value(stat, "Events since startup") != "1" || value(stat, "Earliest timestamp delay:") != "" {
+		t.Errorf("unexpected values %v", stat.Values)
+	}
+}
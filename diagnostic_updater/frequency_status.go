@@ -0,0 +1,122 @@
+package diagnostic_updater
+
+import (
+	"math"
+	"sync"
+
+	"github.com/akio/rosgo/msgs/diagnostic_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+// FrequencyStatusParam is the acceptable range of a frequency.
+type FrequencyStatusParam struct {
+	// In Hz. MaxFreq may be infinity.
+	MinFreq float64
+	MaxFreq float64
+	// The range is widened by MinFreq * Tolerance and MaxFreq * Tolerance.
+	Tolerance float64
+	// The frequency is averaged over this number of updates.
+	WindowSize int
+	// The time of updates, from the creation of the task on. Defaults to
+	// ros.Now.
+	Clock func() ros.Time
+}
+
+// NewFrequencyStatusParam returns the parameters with the defaults of
+// roscpp: 10% of tolerance over 5 updates.
+func NewFrequencyStatusParam(minFreq, maxFreq float64) FrequencyStatusParam {
+	return FrequencyStatusParam{MinFreq: minFreq, MaxFreq: maxFreq, Tolerance: 0.1, WindowSize: 5}
+}
+
+// FrequencyStatus is a task which checks the rate of Tick calls.
+type FrequencyStatus struct {
+	clock    func() ros.Time
+	mutex    sync.Mutex
+	name     string
+	param    FrequencyStatusParam
+	count    int
+	times    []float64
+	seqNums  []int
+	histIndx int
+}
+
+// NewFrequencyStatus creates the task named "Frequency Status".
+func NewFrequencyStatus(param FrequencyStatusParam) *FrequencyStatus {
+	return NewFrequencyStatusWithName(param, "Frequency Status")
+}
+
+func NewFrequencyStatusWithName(param FrequencyStatusParam, name string) *FrequencyStatus {
+	if param.WindowSize < 1 {
+		param.WindowSize = 1
+	}
+	s := &FrequencyStatus{clock: param.Clock, name: name, param: param}
+	if s.clock == nil {
+		s.clock = ros.Now
+	}
+	s.Clear()
+	return s
+}
+
+func (s *FrequencyStatus) Name() string {
+	return s.name
+}
+
+// Clear resets the history, starting the window now.
+func (s *FrequencyStatus) Clear() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	now := s.clock()
+	s.count = 0
+	s.times = make([]float64, s.param.WindowSize)
+	s.seqNums = make([]int, s.param.WindowSize)
+	for i := range s.times {
+		s.times[i] = now.ToSec()
+	}
+	s.histIndx = 0
+}
+
+// Tick records an event.
+func (s *FrequencyStatus) Tick() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.count++
+}
+
+func (s *FrequencyStatus) Run(stat *Status) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	now := s.clock()
+	events := s.count - s.seqNums[s.histIndx]
+	window := now.ToSec() - s.times[s.histIndx]
+	freq := float64(events) / window
+	s.seqNums[s.histIndx] = s.count
+	s.times[s.histIndx] = now.ToSec()
+	s.histIndx = (s.histIndx + 1) % s.param.WindowSize
+
+	minFreq := s.param.MinFreq * (1 - s.param.Tolerance)
+	maxFreq := s.param.MaxFreq * (1 + s.param.Tolerance)
+	switch {
+	case events == 0:
+		stat.Summary(diagnostic_msgs.DiagnosticStatus_ERROR, "No events recorded.")
+	case freq < minFreq:
+		stat.Summary(diagnostic_msgs.DiagnosticStatus_WARN, "Frequency too low.")
+	case freq > maxFreq:
+		stat.Summary(diagnostic_msgs.DiagnosticStatus_WARN, "Frequency too high.")
+	default:
+		stat.Summary(diagnostic_msgs.DiagnosticStatus_OK, "Desired frequency met")
+	}
+
+	stat.Add("Events in window", events)
+	stat.Add("Events since startup", s.count)
+	stat.Add("Duration of window (s)", window)
+	stat.Add("Actual frequency (Hz)", freq)
+	if s.param.MinFreq == s.param.MaxFreq {
+		stat.Add("Target frequency (Hz)", s.param.MinFreq)
+	}
+	if s.param.MinFreq > 0 {
+		stat.Add("Minimum acceptable frequency (Hz)", minFreq)
+	}
+	if !math.IsInf(s.param.MaxFreq, 1) {
+		stat.Add("Maximum acceptable frequency (Hz)", maxFreq)
+	}
+}
@@ -0,0 +1,83 @@
+package diagnostic_updater
+
+import (
+	"math"
+	"testing"
+
+	"github.com/akio/rosgo/msgs/diagnostic_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+func TestFrequencyStatus(t *testing.T) {
+	now := ros.NewTime(100, 0)
+	status := NewFrequencyStatus(FrequencyStatusParam{MinFreq: 10, MaxFreq: 20, Tolerance: 0.1, WindowSize: 2,
+		Clock: func() ros.Time { return now }})
+
+	run := func(ticks int) *Status {
+		for i := 0; i < ticks; i++ {
+			status.Tick()
+		}
+		now = now.Add(ros.NewDuration(1, 0))
+		stat := &Status{}
+		status.Run(stat)
+		return stat
+	}
+	var tests = []struct {
+		ticks   int
+		level   uint8
+		message string
+		freq    string
+	}{
+		{0, diagnostic_msgs.DiagnosticStatus_ERROR, "No events recorded.", "0"},
+		// Averaged over the window including the first update.
+		{15, diagnostic_msgs.DiagnosticStatus_WARN, "Frequency too low.", "7.5"},
+		{15, diagnostic_msgs.DiagnosticStatus_OK, "Desired frequency met", "15"},
+		{8, diagnostic_msgs.DiagnosticStatus_OK, "Desired frequency met", "11.5"},
+		{8, diagnostic_msgs.DiagnosticStatus_WARN, "Frequency too low.", "8"},
+		{30, diagnostic_msgs.DiagnosticStatus_OK, "Desired frequency met", "19"},
+		{30, diagnostic_msgs.DiagnosticStatus_WARN, "Frequency too high.", "30"},
+	}
+	for i, test := range tests {
+		stat := run(test.ticks)
+		if stat.Level != test.level || stat.Message != test.message || value(stat, "Actual frequency (Hz)") != test.freq {
+			t.Errorf("%d: expected %d %q %s Hz, got %d %q %s Hz", i, test.level, test.message, test.freq,
+				stat.Level, stat.Message, value(stat, "Actual frequency (Hz)"))
+		}
+	}
+	stat := run(0)
+	if value(stat, "Minimum acceptable frequency (Hz)") != "9" || value(stat, "Maximum acceptable frequency (Hz)") != "22" {
+		t.Errorf("unexpected values %v", stat.Values)
+	}
+	if value(stat, "Target frequency (Hz)") != "" {
+		t.Error("target frequency is reported for a range")
+	}
+}
+
+func TestFrequencyStatusUnbounded(t *testing.T) {
+	status := NewFrequencyStatus(NewFrequencyStatusParam(5, math.Inf(1)))
+	stat := &Status{}
+	status.Run(stat)
+	if value(stat, "Maximum acceptable frequency (Hz)") != "" {
+		t.Errorf("unexpected values %v", stat.Values)
+	}
+	if status.Name() != "Frequency Status" {
+		t.Errorf("unexpected name %s", status.Name())
+	}
+}
+
+func TestFrequencyStatusClear(t *testing.T) {
+	now := ros.NewTime(100, 0)
+	status := NewFrequencyStatus(FrequencyStatusParam{MinFreq: 1, MaxFreq: 1, WindowSize: 1,
+		Clock: func() ros.Time { return now }})
+	status.Tick()
+	now = now.Add(ros.NewDuration(10, 0))
+	// The window starts at the time of the clock, not of ros.Now.
+	status.Clear()
+	now = now.Add(ros.NewDuration(2, 0))
+	status.Tick()
+	stat := &Status{}
+	status.Run(stat)
+	if value(stat, "Duration of window (s)") != "2" || value(stat, "Events since startup") != "1" {
+		t.Errorf("unexpected values %v", stat.Values)
+	}
+}
@@ -0,0 +1,70 @@
+package diagnostic_updater
+
+import (
+	"fmt"
+
+	"github.com/akio/rosgo/msgs/diagnostic_msgs"
+)
+
+// Status is a diagnostic_msgs/DiagnosticStatus with helpers to fill it,
+// like DiagnosticStatusWrapper of roscpp.
+type Status struct {
+	diagnostic_msgs.DiagnosticStatus
+}
+
+// Summary sets the level and the message.
+func (s *Status) Summary(level uint8, message string) {
+	s.Level = level
+	s.Message = message
+}
+
+func (s *Status) Summaryf(level uint8, format string, args ...interface{}) {
+	s.Summary(level, fmt.Sprintf(format, args...))
+}
+
+// MergeSummary raises the level to the given one if it is higher. Messages
+// of the same severity, OK or not, are joined with "; ". Otherwise the
+// message of the higher level is kept.
+func (s *Status) MergeSummary(level uint8, message string) {
+	if (level > diagnostic_msgs.DiagnosticStatus_OK) == (s.Level > diagnostic_msgs.DiagnosticStatus_OK) {
+		if s.Message != "" && message != "" {
+			s.Message += "; "
+		}
+		s.Message += message
+	} else if level > s.Level {
+		s.Message = message
+	}
+	if level > s.Level {
+		s.Level = level
+	}
+}
+
+func (s *Status) MergeSummaryf(level uint8, format string, args ...interface{}) {
+	s.MergeSummary(level, fmt.Sprintf(format, args...))
+}
+
+// ClearSummary sets the level to OK and the message to empty.
+func (s *Status) ClearSummary() {
+	s.Summary(diagnostic_msgs.DiagnosticStatus_OK, "")
+}
+
+// Add appends a key-value pair. Booleans are written as True or False as
+// roscpp does.
+func (s *Status) Add(key string, value interface{}) {
+	var text string
+	switch v := value.(type) {
+	case bool:
+		if v {
+			text = "True"
+		} else {
+			text = "False"
+		}
+	default:
+		text = fmt.Sprint(v)
+	}
+	s.Values = append(s.Values, diagnostic_msgs.KeyValue{Key: key, Value: text})
+}
+
+func (s *Status) Addf(key string, format string, args ...interface{}) {
+	s.Values = append(s.Values, diagnostic_msgs.KeyValue{Key: key, Value: fmt.Sprintf(format, args...)})
+}
@@ -0,0 +1,64 @@
+package diagnostic_updater
+
+import (
+	"testing"
+
+	"github.com/akio/rosgo/msgs/diagnostic_msgs"
+)
+
+func TestMergeSummary(t *testing.T) {
+	var tests = []struct {
+		level    uint8
+		message  string
+		expected uint8
+		text     string
+	}{
+		{diagnostic_msgs.DiagnosticStatus_OK, "fine", diagnostic_msgs.DiagnosticStatus_OK, "fine"},
+		{diagnostic_msgs.DiagnosticStatus_OK, "good", diagnostic_msgs.DiagnosticStatus_OK, "fine; good"},
+		{diagnostic_msgs.DiagnosticStatus_WARN, "slow", diagnostic_msgs.DiagnosticStatus_WARN, "slow"},
+		{diagnostic_msgs.DiagnosticStatus_OK, "ok", diagnostic_msgs.DiagnosticStatus_WARN, "slow"},
+		{diagnostic_msgs.DiagnosticStatus_ERROR, "lost", diagnostic_msgs.DiagnosticStatus_ERROR, "slow; lost"},
+	}
+	stat := Status{}
+	for _, test := range tests {
+		stat.MergeSummary(test.level, test.message)
+		if stat.Level != test.expected || stat.Message != test.text {
+			t.Errorf("after merging %d %q: expected %d %q, got %d %q", test.level, test.message, test.expected, test.text, stat.Level, stat.Message)
+		}
+	}
+	stat.ClearSummary()
+	if stat.Level != diagnostic_msgs.DiagnosticStatus_OK || stat.Message != "" {
+		t.Errorf("not cleared: %d %q", stat.Level, stat.Message)
+	}
+}
+
+func TestAdd(t *testing.T) {
+	stat := Status{}
+	stat.Add("int", 3)
+	stat.Add("float", 1.5)
+	stat.Add("bool", true)
+	stat.Addf("formatted", "%.2f", 0.125)
+	expected := []diagnostic_msgs.KeyValue{
+		{Key: "int", Value: "3"},
+		{Key: "float", Value: "1.5"},
+		{Key: "bool", Value: "True"},
+		{Key: "formatted", Value: "0.12"},
+	}
+	if len(stat.Values) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, stat.Values)
+	}
+	for i := range expected {
+		if stat.Values[i] != expected[i] {
+			t.Errorf("expected %v, got %v", expected[i], stat.Values[i])
+		}
+	}
+}
+
+func value(stat *Status, key string) string {
+	for _, kv := range stat.Values {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,106 @@
+package diagnostic_updater
+
+import (
+	"sync"
+
+	"github.com/akio/rosgo/msgs/diagnostic_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+// TimeStampStatusParam is the acceptable range of the delay from a stamp
+// to the time it is checked.
+type TimeStampStatusParam struct {
+	// In seconds. Negative values allow stamps in the future.
+	MinAcceptable float64
+	MaxAcceptable float64
+}
+
+// NewTimeStampStatusParam returns the defaults of roscpp: from 1 second in
+// the future to 5 seconds in the past.
+func NewTimeStampStatusParam() TimeStampStatusParam {
+	return TimeStampStatusParam{MinAcceptable: -1, MaxAcceptable: 5}
+}
+
+// TimeStampStatus is a task which checks the delays of stamps passed to
+// Tick since the last run.
+type TimeStampStatus struct {
+	// Defaults to ros.Now.
+	Clock       func() ros.Time
+	mutex       sync.Mutex
+	name        string
+	param       TimeStampStatusParam
+	earlyCount  int
+	lateCount   int
+	zeroCount   int
+	zeroSeen    bool
+	maxDelta    float64
+	minDelta    float64
+	deltasValid bool
+}
+
+// NewTimeStampStatus creates the task named "Timestamp Status".
+func NewTimeStampStatus(param TimeStampStatusParam) *TimeStampStatus {
+	return NewTimeStampStatusWithName(param, "Timestamp Status")
+}
+
+func NewTimeStampStatusWithName(param TimeStampStatusParam, name string) *TimeStampStatus {
+	return &TimeStampStatus{Clock: ros.Now, name: name, param: param}
+}
+
+func (s *TimeStampStatus) Name() string {
+	return s.name
+}
+
+// Tick records the stamp of an event.
+func (s *TimeStampStatus) Tick(stamp ros.Time) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if stamp.IsZero() {
+		s.zeroSeen = true
+		return
+	}
+	now := s.Clock()
+	delta := now.ToSec() - stamp.ToSec()
+	if !s.deltasValid || delta > s.maxDelta {
+		s.maxDelta = delta
+	}
+	if !s.deltasValid || delta < s.minDelta {
+		s.minDelta = delta
+	}
+	s.deltasValid = true
+}
+
+func (s *TimeStampStatus) Run(stat *Status) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	stat.Summary(diagnostic_msgs.DiagnosticStatus_OK, "Timestamps are reasonable.")
+	if !s.deltasValid {
+		stat.Summary(diagnostic_msgs.DiagnosticStatus_WARN, "No data since last update.")
+	} else {
+		if s.minDelta < s.param.MinAcceptable {
+			stat.Summary(diagnostic_msgs.DiagnosticStatus_ERROR, "Timestamps too far in future seen.")
+			s.earlyCount++
+		}
+		if s.maxDelta > s.param.MaxAcceptable {
+			stat.Summary(diagnostic_msgs.DiagnosticStatus_ERROR, "Timestamps too far in past seen.")
+			s.lateCount++
+		}
+	}
+	if s.zeroSeen {
+		stat.Summary(diagnostic_msgs.DiagnosticStatus_ERROR, "Zero timestamp seen.")
+		s.zeroCount++
+	}
+
+	stat.Add("Earliest timestamp delay:", s.minDelta)
+	stat.Add("Latest timestamp delay:", s.maxDelta)
+	stat.Add("Earliest acceptable timestamp delay:", s.param.MinAcceptable)
+	stat.Add("Latest acceptable timestamp delay:", s.param.MaxAcceptable)
+	stat.Add("Late diagnostic update count:", s.lateCount)
+	stat.Add("Early diagnostic update count:", s.earlyCount)
+	stat.Add("Zero seen diagnostic update count:", s.zeroCount)
+
+	s.deltasValid = false
+	s.minDelta = 0
+	s.maxDelta = 0
+	s.zeroSeen = false
+}
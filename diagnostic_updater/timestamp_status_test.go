@@ -0,0 +1,43 @@
+package diagnostic_updater
+
+import (
+	"testing"
+
+	"github.com/akio/rosgo/msgs/diagnostic_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+func TestTimeStampStatus(t *testing.T) {
+	status := NewTimeStampStatus(NewTimeStampStatusParam())
+	status.Clock = func() ros.Time { return ros.NewTime(100, 0) }
+	var tests = []struct {
+		stamps  []ros.Time
+		level   uint8
+		message string
+	}{
+		{nil, diagnostic_msgs.DiagnosticStatus_WARN, "No data since last update."},
+		{[]ros.Time{ros.NewTime(99, 0), ros.NewTime(100, 500000000)}, diagnostic_msgs.DiagnosticStatus_OK, "Timestamps are reasonable."},
+		{[]ros.Time{ros.NewTime(102, 0)}, diagnostic_msgs.DiagnosticStatus_ERROR, "Timestamps too far in future seen."},
+		{[]ros.Time{ros.NewTime(90, 0)}, diagnostic_msgs.DiagnosticStatus_ERROR, "Timestamps too far in past seen."},
+		{[]ros.Time{ros.NewTime(100, 0), {}}, diagnostic_msgs.DiagnosticStatus_ERROR, "Zero timestamp seen."},
+	}
+	for i, test := range tests {
+		for _, stamp := range test.stamps {
+			status.Tick(stamp)
+		}
+		stat := &Status{}
+		status.Run(stat)
+		if stat.Level != test.level || stat.Message != test.message {
+			t.Errorf("%d: expected %d %q, got %d %q", i, test.level, test.message, stat.Level, stat.Message)
+		}
+		if i == 1 && (value(stat, "Earliest timestamp delay:") != "-0.5" || value(stat, "Latest timestamp delay:") != "1") {
+			t.Errorf("unexpected values %v", stat.Values)
+		}
+	}
+	stat := &Status{}
+	status.Run(stat)
+	if value(stat, "Late diagnostic update count:") != "1" || value(stat, "Early diagnostic update count:") != "1" ||
+		value(stat, "Zero seen diagnostic update count:") != "1" {
+		t.Errorf("unexpected values %v", stat.Values)
+	}
+}
@@ -0,0 +1,236 @@
+// Package diagnostic_updater publishes the status of a node on
+// /diagnostics for the diagnostic aggregator, like diagnostic_updater of
+// roscpp.
+//
+// An Updater runs its tasks every period and publishes their statuses.
+// FrequencyStatus and TimeStampStatus are tasks which monitor the rate and
+// the header stamps of events, and DiagnosedPublisher applies them to a
+// publisher.
+//
+//	updater := diagnostic_updater.NewUpdater(node)
+//	updater.SetHardwareID("lidar-0001")
+//	updater.Add("Connection", func(stat *diagnostic_updater.Status) {
+//		stat.Summary(diagnostic_msgs.DiagnosticStatus_OK, "Connected")
+//		stat.Add("Retries", retries)
+//	})
+package diagnostic_updater
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/akio/rosgo/msgs/diagnostic_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+// Task fills a status when the updater runs it.
+type Task interface {
+	Name() string
+	Run(stat *Status)
+}
+
+type funcTask struct {
+	name string
+	fn   func(stat *Status)
+}
+
+func (t *funcTask) Name() string {
+	return t.name
+}
+
+func (t *funcTask) Run(stat *Status) {
+	t.fn(stat)
+}
+
+// NewTask creates a task from a function.
+func NewTask(name string, fn func(stat *Status)) Task {
+	return &funcTask{name, fn}
+}
+
+// CompositeTask runs its tasks on the same status. The status ends up with
+// the values of all tasks and the merged summary of them.
+type CompositeTask struct {
+	name  string
+	tasks []Task
+}
+
+func NewCompositeTask(name string, tasks ...Task) *CompositeTask {
+	return &CompositeTask{name, tasks}
+}
+
+func (t *CompositeTask) Name() string {
+	return t.name
+}
+
+// AddTask adds a task to run after the others.
+func (t *CompositeTask) AddTask(task Task) {
+	t.tasks = append(t.tasks, task)
+}
+
+func (t *CompositeTask) Run(stat *Status) {
+	original := Status{}
+	original.Summary(stat.Level, stat.Message)
+	combined := Status{}
+	for _, task := range t.tasks {
+		stat.Summary(original.Level, original.Message)
+		task.Run(stat)
+		combined.MergeSummary(stat.Level, stat.Message)
+	}
+	stat.Summary(combined.Level, combined.Message)
+}
+
+// DefaultPeriod is the period of an Updater unless the private parameter
+// ~diagnostic_period says otherwise.
+const DefaultPeriod = time.Second
+
+// Updater runs tasks periodically and publishes their statuses on
+// /diagnostics. It is safe for concurrent use.
+type Updater struct {
+	mutex      sync.Mutex
+	pub        ros.Publisher
+	logger     ros.Logger
+	nodeName   string
+	hardwareID string
+	tasks      []Task
+	warned     bool
+	quitChan   chan struct{}
+	quitOnce   sync.Once
+}
+
+// NewUpdater creates an updater which runs every ~diagnostic_period
+// seconds.
+func NewUpdater(node ros.Node) *Updater {
+	u := &Updater{
+		pub:      node.NewPublisher("/diagnostics", diagnostic_msgs.MsgDiagnosticArray),
+		logger:   node.Logger(),
+		nodeName: strings.TrimPrefix(node.Name(), "/"),
+		quitChan: make(chan struct{}),
+	}
+	period := DefaultPeriod
+	if value, err := node.GetParam("~diagnostic_period"); err == nil {
+		if seconds, ok := value.(float64); ok && seconds > 0 {
+			period = time.Duration(seconds * float64(time.Second))
+		}
+	}
+	go u.run(period)
+	return u
+}
+
+func (u *Updater) run(period time.Duration) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			u.Update()
+		case <-u.quitChan:
+			return
+		}
+	}
+}
+
+// SetHardwareID sets the hardware id of all statuses. The diagnostic
+// aggregator groups statuses by it.
+func (u *Updater) SetHardwareID(id string) {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+	u.hardwareID = id
+}
+
+// Add adds a task from a function.
+func (u *Updater) Add(name string, fn func(stat *Status)) {
+	u.AddTask(NewTask(name, fn))
+}
+
+// AddTask adds the task and publishes that it is starting up.
+func (u *Updater) AddTask(task Task) {
+	u.mutex.Lock()
+	u.tasks = append(u.tasks, task)
+	hardwareID := u.hardwareID
+	u.mutex.Unlock()
+	stat := u.newStatus(task.Name(), hardwareID)
+	stat.Summary(diagnostic_msgs.DiagnosticStatus_OK, "Node starting up")
+	u.publish([]Status{stat})
+}
+
+// RemoveByName removes the first task of the name. It returns false if
+// there is none.
+func (u *Updater) RemoveByName(name string) bool {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+	for i, task := range u.tasks {
+		if task.Name() == name {
+			u.tasks = append(u.tasks[:i], u.tasks[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (u *Updater) newStatus(name string, hardwareID string) Status {
+	stat := Status{}
+	stat.Name = name
+	stat.HardwareId = hardwareID
+	return stat
+}
+
+// Update runs all tasks and publishes their statuses. It is called every
+// period, but can be called at any time.
+func (u *Updater) Update() {
+	u.mutex.Lock()
+	tasks := make([]Task, len(u.tasks))
+	copy(tasks, u.tasks)
+	hardwareID := u.hardwareID
+	warn := hardwareID == "" && !u.warned && len(tasks) > 0
+	if warn {
+		u.warned = true
+	}
+	u.mutex.Unlock()
+
+	var statuses []Status
+	for _, task := range tasks {
+		stat := u.newStatus(task.Name(), hardwareID)
+		stat.Summary(diagnostic_msgs.DiagnosticStatus_ERROR, "No message was set")
+		task.Run(&stat)
+		statuses = append(statuses, stat)
+	}
+	if warn {
+		u.logger.Warn("diagnostic_updater: No HW_ID was set. This is probably a bug. Please report it. For devices that do not have a HW_ID, set this value to 'none'.")
+	}
+	u.publish(statuses)
+}
+
+// Broadcast publishes a status of the level and the message for all tasks
+// without running them, e.g. to report that the node is stopping.
+func (u *Updater) Broadcast(level uint8, message string) {
+	u.mutex.Lock()
+	var statuses []Status
+	for _, task := range u.tasks {
+		stat := u.newStatus(task.Name(), u.hardwareID)
+		stat.Summary(level, message)
+		statuses = append(statuses, stat)
+	}
+	u.mutex.Unlock()
+	u.publish(statuses)
+}
+
+func (u *Updater) publish(statuses []Status) {
+	msg := &diagnostic_msgs.DiagnosticArray{}
+	msg.Header.Stamp = ros.Now()
+	for _, stat := range statuses {
+		if u.nodeName != "" {
+			stat.Name = u.nodeName + ": " + stat.Name
+		}
+		msg.Status = append(msg.Status, stat.DiagnosticStatus)
+	}
+	u.pub.Publish(msg)
+}
+
+// Shutdown stops running the tasks and the publisher.
+func (u *Updater) Shutdown() {
+	u.quitOnce.Do(func() {
+		close(u.quitChan)
+		u.pub.Shutdown()
+	})
+}
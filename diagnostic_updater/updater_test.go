@@ -0,0 +1,141 @@
+package diagnostic_updater
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/akio/rosgo/internal/rostest"
+	"github.com/akio/rosgo/msgs/diagnostic_msgs"
+)
+
+// A node whose updaters only update when tests call Update.
+func newTestNode() *rostest.Node {
+	node := rostest.NewNode("/test_node")
+	node.SetParam("~diagnostic_period", 3600.0)
+	return node
+}
+
+func lastDiagnostics(t *testing.T, node *rostest.Node) *diagnostic_msgs.DiagnosticArray {
+	t.Helper()
+	published := node.Published("/diagnostics")
+	if len(published) == 0 {
+		t.Fatal("no diagnostics published")
+	}
+	return published[len(published)-1].(*diagnostic_msgs.DiagnosticArray)
+}
+
+func TestUpdater(t *testing.T) {
+	node := newTestNode()
+	updater := NewUpdater(node)
+	defer updater.Shutdown()
+	if !node.Advertised("/diagnostics") {
+		t.Error("/diagnostics is not advertised")
+	}
+	updater.SetHardwareID("hw0")
+	updater.Add("Battery", func(stat *Status) {
+		stat.Summary(diagnostic_msgs.DiagnosticStatus_WARN, "Low")
+		stat.Add("Voltage", 11.2)
+	})
+	starting := lastDiagnostics(t, node).Status
+	if len(starting) != 1 || starting[0].Name != "test_node: Battery" || starting[0].Message != "Node starting up" {
+		t.Errorf("unexpected status %v", starting)
+	}
+	updater.Add("Silent", func(stat *Status) {})
+
+	updater.Update()
+	statuses := lastDiagnostics(t, node).Status
+	if len(statuses) != 2 {
+		t.Fatalf("expected 2 statuses, got %v", statuses)
+	}
+	battery := statuses[0]
+	if battery.Name != "test_node: Battery" || battery.HardwareId != "hw0" || battery.Level != diagnostic_msgs.DiagnosticStatus_WARN ||
+		battery.Message != "Low" || len(battery.Values) != 1 || battery.Values[0].Value != "11.2" {
+		t.Errorf("unexpected status %v", battery)
+	}
+	if statuses[1].Level != diagnostic_msgs.DiagnosticStatus_ERROR || statuses[1].Message != "No message was set" {
+		t.Errorf("unexpected status %v", statuses[1])
+	}
+
+	updater.Broadcast(diagnostic_msgs.DiagnosticStatus_STALE, "Stopping")
+	for _, stat := range lastDiagnostics(t, node).Status {
+		if stat.Level != diagnostic_msgs.DiagnosticStatus_STALE || stat.Message != "Stopping" {
+			t.Errorf("unexpected status %v", stat)
+		}
+	}
+
+	if !updater.RemoveByName("Silent") || updater.RemoveByName("Silent") {
+		t.Error("unexpected result of RemoveByName")
+	}
+	updater.Update()
+	if statuses := lastDiagnostics(t, node).Status; len(statuses) != 1 {
+		t.Errorf("expected 1 status, got %v", statuses)
+	}
+
+	updater.Shutdown()
+	if node.Advertised("/diagnostics") {
+		t.Error("publisher is not shut down")
+	}
+}
+
+func TestUpdaterPeriod(t *testing.T) {
+	node := newTestNode()
+	node.SetParam("~diagnostic_period", 0.001)
+	updater := NewUpdater(node)
+	defer updater.Shutdown()
+	updater.SetHardwareID("none")
+	done := make(chan struct{})
+	var once sync.Once
+	updater.Add("Tick", func(stat *Status) {
+		stat.Summary(diagnostic_msgs.DiagnosticStatus_OK, "")
+		once.Do(func() { close(done) })
+	})
+	<-done
+}
+
+func TestCompositeTask(t *testing.T) {
+	task := NewCompositeTask("Both",
+		NewTask("Good", func(stat *Status) {
+			stat.Summary(diagnostic_msgs.DiagnosticStatus_OK, "good")
+			stat.Add("a", 1)
+		}),
+		NewTask("Bad", func(stat *Status) {
+			stat.MergeSummary(diagnostic_msgs.DiagnosticStatus_ERROR, "bad")
+			stat.Add("b", 2)
+		}))
+	stat := Status{}
+	stat.Summary(diagnostic_msgs.DiagnosticStatus_OK, "")
+	task.Run(&stat)
+	if task.Name() != "Both" || stat.Level != diagnostic_msgs.DiagnosticStatus_ERROR || stat.Message != "bad" || len(stat.Values) != 2 {
+		t.Errorf("unexpected status %v", stat)
+	}
+}
+
+func TestUpdaterLoopback(t *testing.T) {
+	master := rostest.StartMaster(t)
+	node := master.NewNode(t, "/updater")
+	if err := node.SetParam("~diagnostic_period", 0.01); err != nil {
+		t.Fatal(err)
+	}
+	updater := NewUpdater(node)
+	defer updater.Shutdown()
+	updater.SetHardwareID("none")
+	updater.Add("Tick", func(stat *Status) {
+		stat.Summary(diagnostic_msgs.DiagnosticStatus_OK, "ticking")
+	})
+
+	ch, _ := master.NewNode(t, "/monitor").SubscribeChan("/diagnostics", diagnostic_msgs.MsgDiagnosticArray, 1)
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case received := <-ch:
+			statuses := received.Message.(*diagnostic_msgs.DiagnosticArray).Status
+			// The first updates may still be those of starting up.
+			if len(statuses) == 1 && statuses[0].Name == "updater: Tick" && statuses[0].Message == "ticking" {
+				return
+			}
+		case <-timeout:
+			t.Fatal("no diagnostics received")
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package dynamic_reconfigure
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	drmsgs "github.com/akio/rosgo/msgs/dynamic_reconfigure"
+	"github.com/akio/rosgo/ros"
+)
+
+// Client changes the parameters of a remote server, named by the node or
+// namespace serving them. It follows the latched topics of the server in
+// its own goroutines.
+type Client struct {
+	mutex          sync.Mutex
+	name           string
+	service        ros.ServiceClient
+	updateSub      ros.Subscriber
+	descriptionSub ros.Subscriber
+	config         Config
+	description    *drmsgs.ConfigDescription
+	types          map[string]string
+	updatedChan    chan struct{}
+	wg             sync.WaitGroup
+}
+
+func NewClient(node ros.Node, name string) *Client {
+	c := &Client{
+		name:        name,
+		service:     node.NewServiceClient(name+"/set_parameters", drmsgs.SrvReconfigure),
+		updatedChan: make(chan struct{}),
+	}
+	// Only the latest messages matter.
+	updateChan, updateSub := node.SubscribeChan(name+"/parameter_updates", drmsgs.MsgConfig, 1)
+	descriptionChan, descriptionSub := node.SubscribeChan(name+"/parameter_descriptions", drmsgs.MsgConfigDescription, 1)
+	c.updateSub, c.descriptionSub = updateSub, descriptionSub
+	c.wg.Add(2)
+	go c.listen(updateChan)
+	go c.listen(descriptionChan)
+	return c
+}
+
+func (c *Client) listen(ch <-chan ros.ReceivedMessage) {
+	defer c.wg.Done()
+	for received := range ch {
+		c.mutex.Lock()
+		switch msg := received.Message.(type) {
+		case *drmsgs.Config:
+			c.config = decodeConfig(msg)
+		case *drmsgs.ConfigDescription:
+			c.description = msg
+			c.types = map[string]string{}
+			for _, group := range msg.Groups {
+				for _, param := range group.Parameters {
+					c.types[param.Name] = param.Type_
+				}
+			}
+		}
+		close(c.updatedChan)
+		c.updatedChan = make(chan struct{})
+		c.mutex.Unlock()
+	}
+}
+
+// Wait until the getter returns true or the timeout expires. The zero
+// timeout does not wait.
+func (c *Client) wait(timeout ros.Duration, get func() bool) bool {
+	var timer *time.Timer
+	for {
+		c.mutex.Lock()
+		ok := get()
+		// Take the channel with the lock not to miss an update.
+		updated := c.updatedChan
+		c.mutex.Unlock()
+		if ok || timeout.IsZero() {
+			return ok
+		}
+		if timer == nil {
+			timer = time.NewTimer(time.Duration(timeout.ToNSec()))
+			defer timer.Stop()
+		}
+		select {
+		case <-updated:
+		case <-timer.C:
+			return false
+		}
+	}
+}
+
+// GetConfiguration returns the latest config published by the server,
+// waiting for it until the timeout.
+func (c *Client) GetConfiguration(timeout ros.Duration) (Config, error) {
+	var config Config
+	if !c.wait(timeout, func() bool {
+		config = c.config.Copy()
+		return c.config != nil
+	}) {
+		return nil, fmt.Errorf("no configuration received from %s", c.name)
+	}
+	return config, nil
+}
+
+// GetDescription returns the description of the parameters published by
+// the server, waiting for it until the timeout.
+func (c *Client) GetDescription(timeout ros.Duration) (*drmsgs.ConfigDescription, error) {
+	var description *drmsgs.ConfigDescription
+	if !c.wait(timeout, func() bool {
+		description = c.description
+		return description != nil
+	}) {
+		return nil, fmt.Errorf("no description received from %s", c.name)
+	}
+	return description, nil
+}
+
+// UpdateConfiguration asks the server to change the parameters in the
+// changes and returns the resulting config. Once the description is
+// received, values are converted to the types of the parameters, so that
+// e.g. an int can be given for a double parameter.
+func (c *Client) UpdateConfiguration(changes Config) (Config, error) {
+	c.mutex.Lock()
+	converted := changes.Copy()
+	for name, value := range changes {
+		if paramType, ok := c.types[name]; ok {
+			param := Param{Type: paramType}
+			if v, ok := param.convert(value); ok {
+				converted[name] = v
+			}
+		}
+	}
+	c.mutex.Unlock()
+	srv := &drmsgs.Reconfigure{}
+	srv.Request.Config = encodeConfig(converted)
+	if err := c.service.Call(srv); err != nil {
+		return nil, err
+	}
+	return decodeConfig(&srv.Response.Config), nil
+}
+
+// Shutdown unsubscribes the topics of the server.
+func (c *Client) Shutdown() {
+	c.updateSub.Shutdown()
+	c.descriptionSub.Shutdown()
+	c.wg.Wait()
+	c.service.Shutdown()
+}
@@ -0,0 +1,84 @@
+package dynamic_reconfigure
+
+import (
+	"testing"
+
+	"github.com/akio/rosgo/internal/rostest"
+	"github.com/akio/rosgo/ros"
+)
+
+func TestClient(t *testing.T) {
+	node := newTestNode()
+	server := NewServer(node, newTestDescription(), nil)
+	client := NewClient(node, "/test_node")
+
+	// The latched messages are received on subscription.
+	config, err := client.GetConfiguration(ros.NewDuration(1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Int("rate") != 10 || config.String("frame") != "base_link" {
+		t.Errorf("unexpected config %v", config)
+	}
+	description, err := client.GetDescription(ros.NewDuration(1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(description.Groups) != 2 {
+		t.Errorf("unexpected description %v", description)
+	}
+
+	// An int is converted for the double parameter.
+	config, err = client.UpdateConfiguration(Config{"gain": 1, "rate": 50})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Double("gain") != 1 || config.Int("rate") != 50 || server.Config().Int("rate") != 50 {
+		t.Errorf("unexpected config %v", config)
+	}
+	if _, err := client.UpdateConfiguration(Config{"unknown": true}); err == nil {
+		t.Error("unknown parameter is accepted")
+	}
+
+	client.Shutdown()
+}
+
+func TestClientLoopback(t *testing.T) {
+	master := rostest.StartMaster(t)
+	serverNode := master.NewNode(t, "/server")
+	server := NewServer(serverNode, newTestDescription(), nil)
+	defer server.Shutdown()
+	// The service is handled by Spin.
+	go serverNode.Spin()
+
+	client := NewClient(master.NewNode(t, "/client"), "/server")
+	defer client.Shutdown()
+	config, err := client.GetConfiguration(ros.NewDuration(5, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Int("rate") != 10 {
+		t.Errorf("unexpected config %v", config)
+	}
+	config, err = client.UpdateConfiguration(Config{"rate": 50})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Int("rate") != 50 || server.Config().Int("rate") != 50 {
+		t.Errorf("unexpected config %v", config)
+	}
+	if value, err := serverNode.GetParam("~rate"); err != nil || value != int32(50) {
+		t.Errorf("parameter is not mirrored: %v, %v", value, err)
+	}
+}
+
+func TestClientTimeout(t *testing.T) {
+	client := NewClient(newTestNode(), "/missing")
+	defer client.Shutdown()
+	if _, err := client.GetConfiguration(ros.NewDuration(0, 10000000)); err == nil {
+		t.Error("configuration is received")
+	}
+	if _, err := client.GetDescription(ros.Duration{}); err == nil {
+		t.Error("description is received")
+	}
+}
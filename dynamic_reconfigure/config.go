@@ -0,0 +1,90 @@
+package dynamic_reconfigure
+
+import (
+	"sort"
+
+	drmsgs "github.com/akio/rosgo/msgs/dynamic_reconfigure"
+)
+
+// Config maps parameter names to values. Values are int, float64, bool or
+// string for the int, double, bool and str parameters.
+type Config map[string]interface{}
+
+// Copy returns a copy of the config which can be modified freely.
+func (c Config) Copy() Config {
+	copied := make(Config, len(c))
+	for name, value := range c {
+		copied[name] = value
+	}
+	return copied
+}
+
+// Int returns the value of an int parameter, or 0 if there is none.
+func (c Config) Int(name string) int {
+	value, _ := c[name].(int)
+	return value
+}
+
+// Double returns the value of a double parameter, or 0 if there is none.
+func (c Config) Double(name string) float64 {
+	value, _ := c[name].(float64)
+	return value
+}
+
+// Bool returns the value of a bool parameter, or false if there is none.
+func (c Config) Bool(name string) bool {
+	value, _ := c[name].(bool)
+	return value
+}
+
+// String returns the value of a str parameter, or "" if there is none.
+func (c Config) String(name string) string {
+	value, _ := c[name].(string)
+	return value
+}
+
+// Encode the values by their Go types. Values of other types are skipped.
+func encodeConfig(config Config) drmsgs.Config {
+	names := make([]string, 0, len(config))
+	for name := range config {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	msg := drmsgs.Config{
+		Bools:   []drmsgs.BoolParameter{},
+		Ints:    []drmsgs.IntParameter{},
+		Strs:    []drmsgs.StrParameter{},
+		Doubles: []drmsgs.DoubleParameter{},
+		Groups:  []drmsgs.GroupState{},
+	}
+	for _, name := range names {
+		switch value := config[name].(type) {
+		case bool:
+			msg.Bools = append(msg.Bools, drmsgs.BoolParameter{Name: name, Value: value})
+		case int:
+			msg.Ints = append(msg.Ints, drmsgs.IntParameter{Name: name, Value: int32(value)})
+		case string:
+			msg.Strs = append(msg.Strs, drmsgs.StrParameter{Name: name, Value: value})
+		case float64:
+			msg.Doubles = append(msg.Doubles, drmsgs.DoubleParameter{Name: name, Value: value})
+		}
+	}
+	return msg
+}
+
+func decodeConfig(msg *drmsgs.Config) Config {
+	config := Config{}
+	for _, param := range msg.Bools {
+		config[param.Name] = param.Value
+	}
+	for _, param := range msg.Ints {
+		config[param.Name] = int(param.Value)
+	}
+	for _, param := range msg.Strs {
+		config[param.Name] = param.Value
+	}
+	for _, param := range msg.Doubles {
+		config[param.Name] = param.Value
+	}
+	return config
+}
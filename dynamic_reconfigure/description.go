@@ -0,0 +1,338 @@
+package dynamic_reconfigure
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	drmsgs "github.com/akio/rosgo/msgs/dynamic_reconfigure"
+)
+
+// Types of parameters, as in the .cfg files of dynamic_reconfigure.
+const (
+	IntType    = "int"
+	DoubleType = "double"
+	BoolType   = "bool"
+	StrType    = "str"
+)
+
+// Param describes a parameter. Default, Min and Max have the Go type of
+// the parameter: int, float64, bool or string. Nil Min and Max mean the
+// limits of the type.
+type Param struct {
+	Name        string
+	Type        string
+	Level       uint32
+	Description string
+	Default     interface{}
+	Min         interface{}
+	Max         interface{}
+	// The values which the parameter may take, shown as a drop-down list
+	// by rqt_reconfigure.
+	Enum            []EnumValue
+	EnumDescription string
+}
+
+// EnumValue is a named value of an enum parameter.
+type EnumValue struct {
+	Name        string
+	Value       interface{}
+	Description string
+}
+
+// Group is a group of parameters shown together by rqt_reconfigure.
+type Group struct {
+	Name string
+	// One of "", "collapse", "tab", "hide" and "apply".
+	Type string
+	// Whether the group is expanded or shown.
+	State  bool
+	Params []Param
+	Groups []*Group
+}
+
+// Description is the root group of parameters, named "Default". It must
+// not be modified after a server is created from it.
+type Description struct {
+	Group
+}
+
+func NewDescription() *Description {
+	return &Description{Group{Name: "Default", State: true}}
+}
+
+// AddGroup adds a subgroup.
+func (g *Group) AddGroup(name string) *Group {
+	group := &Group{Name: name, State: true}
+	g.Groups = append(g.Groups, group)
+	return group
+}
+
+// Add adds a parameter. It panics if the values do not match the type.
+func (g *Group) Add(param Param) {
+	zero, min, max, ok := typeLimits(param.Type)
+	if !ok {
+		panic(fmt.Sprintf("dynamic_reconfigure: parameter %s has unknown type %q", param.Name, param.Type))
+	}
+	if param.Default == nil {
+		param.Default = zero
+	}
+	if param.Min == nil {
+		param.Min = min
+	}
+	if param.Max == nil {
+		param.Max = max
+	}
+	for _, value := range []interface{}{param.Default, param.Min, param.Max} {
+		if !hasType(value, param.Type) {
+			panic(fmt.Sprintf("dynamic_reconfigure: value %v of parameter %s is not %s", value, param.Name, param.Type))
+		}
+	}
+	for _, value := range param.Enum {
+		if !hasType(value.Value, param.Type) {
+			panic(fmt.Sprintf("dynamic_reconfigure: enum value %v of parameter %s is not %s", value.Value, param.Name, param.Type))
+		}
+	}
+	g.Params = append(g.Params, param)
+}
+
+func (g *Group) AddInt(name string, level uint32, description string, dflt, min, max int) {
+	g.Add(Param{Name: name, Type: IntType, Level: level, Description: description, Default: dflt, Min: min, Max: max})
+}
+
+func (g *Group) AddDouble(name string, level uint32, description string, dflt, min, max float64) {
+	g.Add(Param{Name: name, Type: DoubleType, Level: level, Description: description, Default: dflt, Min: min, Max: max})
+}
+
+func (g *Group) AddBool(name string, level uint32, description string, dflt bool) {
+	g.Add(Param{Name: name, Type: BoolType, Level: level, Description: description, Default: dflt})
+}
+
+func (g *Group) AddString(name string, level uint32, description string, dflt string) {
+	g.Add(Param{Name: name, Type: StrType, Level: level, Description: description, Default: dflt})
+}
+
+// AddEnum adds an int parameter which takes one of the values.
+func (g *Group) AddEnum(name string, level uint32, description string, dflt int, values []EnumValue) {
+	g.Add(Param{Name: name, Type: IntType, Level: level, Description: description, Default: dflt,
+		Enum: values, EnumDescription: description})
+}
+
+func typeLimits(paramType string) (zero, min, max interface{}, ok bool) {
+	switch paramType {
+	case IntType:
+		return 0, math.MinInt32, math.MaxInt32, true
+	case DoubleType:
+		return 0.0, math.Inf(-1), math.Inf(1), true
+	case BoolType:
+		return false, false, true, true
+	case StrType:
+		return "", "", "", true
+	}
+	return nil, nil, nil, false
+}
+
+func hasType(value interface{}, paramType string) bool {
+	switch value.(type) {
+	case int:
+		return paramType == IntType
+	case float64:
+		return paramType == DoubleType
+	case bool:
+		return paramType == BoolType
+	case string:
+		return paramType == StrType
+	}
+	return false
+}
+
+// convert converts a value from the parameter server or a client to the
+// Go type of the parameter.
+func (p *Param) convert(value interface{}) (interface{}, bool) {
+	switch v := value.(type) {
+	case int32:
+		value = int(v)
+	case int64:
+		value = int(v)
+	case float32:
+		value = float64(v)
+	}
+	switch v := value.(type) {
+	case int:
+		if p.Type == DoubleType {
+			return float64(v), true
+		}
+	case float64:
+		if p.Type == IntType && v == math.Trunc(v) && v >= math.MinInt32 && v <= math.MaxInt32 {
+			return int(v), true
+		}
+	}
+	return value, hasType(value, p.Type)
+}
+
+// clamp limits a value of the parameter to its range.
+func (p *Param) clamp(value interface{}) interface{} {
+	switch v := value.(type) {
+	case int:
+		if v < p.Min.(int) {
+			return p.Min
+		}
+		if v > p.Max.(int) {
+			return p.Max
+		}
+	case float64:
+		if v < p.Min.(float64) {
+			return p.Min
+		}
+		if v > p.Max.(float64) {
+			return p.Max
+		}
+	}
+	return value
+}
+
+// inEnum reports whether the value is one of the enum, if the parameter
+// is an enum.
+func (p *Param) inEnum(value interface{}) bool {
+	if len(p.Enum) == 0 {
+		return true
+	}
+	for _, enumValue := range p.Enum {
+		if enumValue.Value == value {
+			return true
+		}
+	}
+	return false
+}
+
+// A group with the ids in the description message.
+type flatGroup struct {
+	*Group
+	id     int32
+	parent int32
+}
+
+// flatten returns the groups in depth-first order and the parameters of
+// all groups. It panics if names are not unique.
+func (d *Description) flatten() ([]flatGroup, []*Param) {
+	var groups []flatGroup
+	var params []*Param
+	groupNames := map[string]bool{}
+	paramNames := map[string]bool{}
+	var visit func(group *Group, parent int32)
+	visit = func(group *Group, parent int32) {
+		if groupNames[group.Name] {
+			panic(fmt.Sprintf("dynamic_reconfigure: duplicate group %s", group.Name))
+		}
+		groupNames[group.Name] = true
+		id := int32(len(groups))
+		groups = append(groups, flatGroup{group, id, parent})
+		for i := range group.Params {
+			param := &group.Params[i]
+			if paramNames[param.Name] {
+				panic(fmt.Sprintf("dynamic_reconfigure: duplicate parameter %s", param.Name))
+			}
+			paramNames[param.Name] = true
+			params = append(params, param)
+		}
+		for _, subgroup := range group.Groups {
+			visit(subgroup, id)
+		}
+	}
+	visit(&d.Group, 0)
+	return groups, params
+}
+
+func encodeGroupStates(groups []flatGroup, states map[string]bool) []drmsgs.GroupState {
+	result := make([]drmsgs.GroupState, len(groups))
+	for i, group := range groups {
+		result[i] = drmsgs.GroupState{Name: group.Name, State: states[group.Name], Id: group.id, Parent: group.parent}
+	}
+	return result
+}
+
+func encodeDescription(groups []flatGroup, params []*Param, states map[string]bool) *drmsgs.ConfigDescription {
+	msg := &drmsgs.ConfigDescription{}
+	dflt, min, max := Config{}, Config{}, Config{}
+	for _, param := range params {
+		dflt[param.Name] = param.Default
+		min[param.Name] = param.Min
+		max[param.Name] = param.Max
+	}
+	msg.Dflt = encodeConfig(dflt)
+	msg.Min = encodeConfig(min)
+	msg.Max = encodeConfig(max)
+	for _, config := range []*drmsgs.Config{&msg.Dflt, &msg.Min, &msg.Max} {
+		config.Groups = encodeGroupStates(groups, states)
+	}
+	msg.Groups = make([]drmsgs.Group, len(groups))
+	for i, group := range groups {
+		descriptions := make([]drmsgs.ParamDescription, len(group.Params))
+		for j := range group.Params {
+			param := &group.Params[j]
+			descriptions[j] = drmsgs.ParamDescription{
+				Name:        param.Name,
+				Type_:       param.Type,
+				Level:       param.Level,
+				Description: param.Description,
+				EditMethod:  param.editMethod(),
+			}
+		}
+		msg.Groups[i] = drmsgs.Group{Name: group.Name, Type_: group.Type, Parameters: descriptions,
+			Parent: group.parent, Id: group.id}
+	}
+	return msg
+}
+
+var cTypes = map[string][2]string{
+	IntType:    {"int", "const int"},
+	DoubleType: {"double", "const double"},
+	BoolType:   {"bool", "const bool"},
+	StrType:    {"std::string", "const char * const"},
+}
+
+// editMethod returns the enum as a Python literal, which rqt_reconfigure
+// evaluates, or "" if the parameter is not an enum.
+func (p *Param) editMethod() string {
+	if len(p.Enum) == 0 {
+		return ""
+	}
+	var buf bytes.Buffer
+	buf.WriteString("{'enum': [")
+	for i, value := range p.Enum {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(&buf, "{'name': %s, 'type': %s, 'value': %s, 'srcline': 0, 'srcfile': '', 'cconsttype': %s, 'ctype': %s, 'description': %s}",
+			pythonLiteral(value.Name), pythonLiteral(p.Type), pythonLiteral(value.Value),
+			pythonLiteral(cTypes[p.Type][1]), pythonLiteral(cTypes[p.Type][0]), pythonLiteral(value.Description))
+	}
+	fmt.Fprintf(&buf, "], 'enum_description': %s}", pythonLiteral(p.EnumDescription))
+	return buf.String()
+}
+
+func pythonLiteral(value interface{}) string {
+	switch v := value.(type) {
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		if math.IsInf(v, 0) || math.IsNaN(v) {
+			return fmt.Sprintf("float('%v')", v)
+		}
+		s := strconv.FormatFloat(v, 'g', -1, 64)
+		if !strings.ContainsAny(s, ".e") {
+			s += ".0"
+		}
+		return s
+	case bool:
+		if v {
+			return "True"
+		}
+		return "False"
+	case string:
+		return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`, "\n", `\n`).Replace(v) + "'"
+	}
+	return "None"
+}
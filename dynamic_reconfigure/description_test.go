@@ -0,0 +1,102 @@
+package dynamic_reconfigure
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFlatten(t *testing.T) {
+	description := newTestDescription()
+	description.Groups[0].AddGroup("Nested").AddInt("depth", 0, "", 0, 0, 1)
+	description.AddGroup("Other")
+	groups, params := description.flatten()
+	var expected = []struct {
+		name   string
+		id     int32
+		parent int32
+	}{
+		{"Default", 0, 0},
+		{"Advanced", 1, 0},
+		{"Nested", 2, 1},
+		{"Other", 3, 0},
+	}
+	if len(groups) != len(expected) {
+		t.Fatalf("unexpected groups %v", groups)
+	}
+	for i, group := range groups {
+		if group.Name != expected[i].name || group.id != expected[i].id || group.parent != expected[i].parent {
+			t.Errorf("expected %v, got %s %d %d", expected[i], group.Name, group.id, group.parent)
+		}
+	}
+	if len(params) != 6 || params[5].Name != "depth" {
+		t.Errorf("unexpected params %v", params)
+	}
+}
+
+func TestFlattenDuplicate(t *testing.T) {
+	description := newTestDescription()
+	description.AddGroup("Other").AddInt("rate", 0, "", 0, 0, 1)
+	defer func() {
+		if recover() == nil {
+			t.Error("duplicate parameter is accepted")
+		}
+	}()
+	description.flatten()
+}
+
+func TestAddInvalid(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("invalid default is accepted")
+		}
+	}()
+	NewDescription().Add(Param{Name: "rate", Type: IntType, Default: 1.5})
+}
+
+func TestAddDefaults(t *testing.T) {
+	description := NewDescription()
+	description.Add(Param{Name: "gain", Type: DoubleType})
+	param := description.Params[0]
+	if param.Default != 0.0 || param.Min != math.Inf(-1) || param.Max != math.Inf(1) {
+		t.Errorf("unexpected param %v", param)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	var tests = []struct {
+		paramType string
+		value     interface{}
+		expected  interface{}
+		ok        bool
+	}{
+		{IntType, int32(3), 3, true},
+		{IntType, 3.0, 3, true},
+		{IntType, 3.5, nil, false},
+		{DoubleType, int32(3), 3.0, true},
+		{DoubleType, 0.5, 0.5, true},
+		{BoolType, 1, nil, false},
+		{StrType, "a", "a", true},
+	}
+	for _, test := range tests {
+		param := Param{Type: test.paramType}
+		value, ok := param.convert(test.value)
+		if ok != test.ok || ok && value != test.expected {
+			t.Errorf("%s %v: expected %v %v, got %v %v", test.paramType, test.value, test.expected, test.ok, value, ok)
+		}
+	}
+}
+
+func TestEditMethod(t *testing.T) {
+	param := Param{Type: StrType, EnumDescription: "Size's", Enum: []EnumValue{
+		{Name: "Small", Value: "s", Description: "A small one"},
+	}}
+	expected := "{'enum': [{'name': 'Small', 'type': 'str', 'value': 's', 'srcline': 0, 'srcfile': '', " +
+		"'cconsttype': 'const char * const', 'ctype': 'std::string', 'description': 'A small one'}], " +
+		"'enum_description': 'Size\\'s'}"
+	if method := param.editMethod(); method != expected {
+		t.Errorf("expected %s, got %s", expected, method)
+	}
+	if method := (&Param{Type: IntType}).editMethod(); method != "" {
+		t.Errorf("unexpected edit method %s", method)
+	}
+}
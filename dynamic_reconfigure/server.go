@@ -0,0 +1,196 @@
+// Package dynamic_reconfigure lets parameters of a node be changed while it
+// runs, with the protocol of dynamic_reconfigure, so that they can be tuned
+// with rqt_reconfigure.
+//
+// A Server serves the parameters of a description on ~set_parameters and
+// publishes them on ~parameter_descriptions and ~parameter_updates. A
+// Client changes the parameters of a remote server.
+//
+//	description := dynamic_reconfigure.NewDescription()
+//	description.AddDouble("gain", 0, "Gain of the controller", 0.5, 0, 1)
+//	description.AddEnum("mode", 1, "Control mode", 0, []dynamic_reconfigure.EnumValue{
+//		{Name: "Position", Value: 0}, {Name: "Velocity", Value: 1}})
+//	server := dynamic_reconfigure.NewServer(node, description,
+//		func(config dynamic_reconfigure.Config, level uint32) dynamic_reconfigure.Config {
+//			controller.SetGain(config.Double("gain"))
+//			return config
+//		})
+package dynamic_reconfigure
+
+import (
+	"fmt"
+	"sync"
+
+	drmsgs "github.com/akio/rosgo/msgs/dynamic_reconfigure"
+	"github.com/akio/rosgo/ros"
+)
+
+// Callback is called with a new config and the levels of the changed
+// parameters ORed together. It returns the config to use, which is usually
+// the given one, possibly modified.
+type Callback func(config Config, level uint32) Config
+
+// Server serves the parameters of a description. Values set on the
+// parameter server under the private namespace of the node override the
+// defaults, and the current values are mirrored there.
+type Server struct {
+	mutex          sync.Mutex
+	node           ros.Node
+	logger         ros.Logger
+	callback       Callback
+	groups         []flatGroup
+	params         []*Param
+	paramsByName   map[string]*Param
+	config         Config
+	groupStates    map[string]bool
+	descriptionPub ros.Publisher
+	updatePub      ros.Publisher
+	service        ros.ServiceServer
+}
+
+// NewServer starts serving the parameters and calls the callback with the
+// initial config at all levels. It panics if names in the description are
+// not unique.
+func NewServer(node ros.Node, description *Description, callback Callback) *Server {
+	s := &Server{
+		node:         node,
+		logger:       node.Logger(),
+		callback:     callback,
+		paramsByName: map[string]*Param{},
+		config:       Config{},
+		groupStates:  map[string]bool{},
+	}
+	s.groups, s.params = description.flatten()
+	for _, group := range s.groups {
+		s.groupStates[group.Name] = group.State
+	}
+	for _, param := range s.params {
+		s.paramsByName[param.Name] = param
+		s.config[param.Name] = param.Default
+	}
+	s.loadParams()
+
+	// Clients expect both topics to be latched.
+	s.descriptionPub = node.NewLatchedPublisher("~parameter_descriptions", drmsgs.MsgConfigDescription)
+	s.updatePub = node.NewLatchedPublisher("~parameter_updates", drmsgs.MsgConfig)
+	s.descriptionPub.Publish(encodeDescription(s.groups, s.params, s.groupStates))
+	s.mutex.Lock()
+	s.apply(s.config, ^uint32(0))
+	s.mutex.Unlock()
+	s.service = node.NewServiceServer("~set_parameters", drmsgs.SrvReconfigure, s.setParameters)
+	return s
+}
+
+// Take the values already on the parameter server.
+func (s *Server) loadParams() {
+	for _, param := range s.params {
+		value, err := s.node.GetParam("~" + param.Name)
+		if err != nil {
+			continue
+		}
+		converted, ok := param.convert(value)
+		if !ok {
+			s.logger.Warnf("dynamic_reconfigure: ignoring value %v of parameter %s, which is not %s", value, param.Name, param.Type)
+			continue
+		}
+		s.config[param.Name] = param.clamp(converted)
+	}
+}
+
+func (s *Server) setParameters(req *drmsgs.ReconfigureRequest, res *drmsgs.ReconfigureResponse) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, group := range req.Config.Groups {
+		if _, ok := s.groupStates[group.Name]; ok {
+			s.groupStates[group.Name] = group.State
+		}
+	}
+	if err := s.update(decodeConfig(&req.Config)); err != nil {
+		return err
+	}
+	res.Config = s.encodeConfig()
+	return nil
+}
+
+// Config returns a copy of the current config.
+func (s *Server) Config() Config {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.config.Copy()
+}
+
+// UpdateConfiguration changes the parameters in the changes like a
+// request from a client. It returns the new config.
+func (s *Server) UpdateConfiguration(changes Config) (Config, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if err := s.update(changes); err != nil {
+		return nil, err
+	}
+	return s.config.Copy(), nil
+}
+
+// update validates the changes and applies them. Numbers out of range are
+// clamped, but unknown parameters, values of wrong types and values not in
+// enums are rejected.
+func (s *Server) update(changes Config) error {
+	config := s.config.Copy()
+	for name, value := range changes {
+		param, ok := s.paramsByName[name]
+		if !ok {
+			return fmt.Errorf("unknown parameter %s", name)
+		}
+		converted, ok := param.convert(value)
+		if !ok {
+			return fmt.Errorf("value %v of parameter %s is not %s", value, name, param.Type)
+		}
+		if !param.inEnum(converted) {
+			return fmt.Errorf("value %v of parameter %s is not in the enum", value, name)
+		}
+		config[name] = param.clamp(converted)
+	}
+	var level uint32
+	for _, param := range s.params {
+		if config[param.Name] != s.config[param.Name] {
+			level |= param.Level
+		}
+	}
+	s.apply(config, level)
+	return nil
+}
+
+// Call the callback, take the config and publish it. Called with the
+// mutex locked.
+func (s *Server) apply(config Config, level uint32) {
+	if s.callback != nil {
+		if result := s.callback(config.Copy(), level); result != nil {
+			// Keep the parameters the callback dropped or broke.
+			for _, param := range s.params {
+				if value, ok := param.convert(result[param.Name]); ok {
+					config[param.Name] = value
+				}
+			}
+		}
+	}
+	s.config = config
+	for _, param := range s.params {
+		if err := s.node.SetParam("~"+param.Name, s.config[param.Name]); err != nil {
+			s.logger.Warnf("dynamic_reconfigure: failed to set parameter %s: %v", param.Name, err)
+		}
+	}
+	msg := s.encodeConfig()
+	s.updatePub.Publish(&msg)
+}
+
+func (s *Server) encodeConfig() drmsgs.Config {
+	msg := encodeConfig(s.config)
+	msg.Groups = encodeGroupStates(s.groups, s.groupStates)
+	return msg
+}
+
+// Shutdown stops serving the parameters.
+func (s *Server) Shutdown() {
+	s.service.Shutdown()
+	s.descriptionPub.Shutdown()
+	s.updatePub.Shutdown()
+}
@@ -0,0 +1,147 @@
+package dynamic_reconfigure
+
+import (
+	"testing"
+
+	"github.com/akio/rosgo/internal/rostest"
+	drmsgs "github.com/akio/rosgo/msgs/dynamic_reconfigure"
+	"github.com/akio/rosgo/ros"
+)
+
+func newTestNode() *rostest.Node {
+	return rostest.NewNode("/test_node")
+}
+
+func lastPublished(t *testing.T, node *rostest.Node, topic string) ros.Message {
+	t.Helper()
+	published := node.Published(topic)
+	if len(published) == 0 {
+		t.Fatalf("nothing published on %s", topic)
+	}
+	return published[len(published)-1]
+}
+
+func param(node *rostest.Node, name string) interface{} {
+	value, _ := node.GetParam(name)
+	return value
+}
+
+func newTestDescription() *Description {
+	description := NewDescription()
+	description.AddInt("rate", 1, "Rate", 10, 1, 100)
+	description.AddDouble("gain", 2, "Gain", 0.5, 0, 1)
+	advanced := description.AddGroup("Advanced")
+	advanced.AddBool("enabled", 4, "Enabled", true)
+	advanced.AddString("frame", 4, "Frame", "base_link")
+	advanced.AddEnum("mode", 8, "Mode", 0, []EnumValue{{Name: "Fast", Value: 0}, {Name: "Slow", Value: 1}})
+	return description
+}
+
+type call struct {
+	config Config
+	level  uint32
+}
+
+func TestServer(t *testing.T) {
+	node := newTestNode()
+	// Values on the parameter server override the defaults.
+	node.SetParam("~rate", int32(20))
+	node.SetParam("~gain", 2.0)
+	node.SetParam("~frame", 1.0)
+	var calls []call
+	server := NewServer(node, newTestDescription(), func(config Config, level uint32) Config {
+		calls = append(calls, call{config, level})
+		return config
+	})
+	if len(calls) != 1 || calls[0].level != ^uint32(0) {
+		t.Fatalf("unexpected calls %v", calls)
+	}
+	initial := calls[0].config
+	if initial.Int("rate") != 20 || initial.Double("gain") != 1 || initial.String("frame") != "base_link" ||
+		!initial.Bool("enabled") || initial.Int("mode") != 0 {
+		t.Errorf("unexpected initial config %v", initial)
+	}
+	if !node.Served("/test_node/set_parameters") {
+		t.Error("/test_node/set_parameters is not served")
+	}
+	if param(node, "~gain") != 1.0 || param(node, "~enabled") != true {
+		t.Errorf("parameters are not mirrored: %v", node.Params())
+	}
+	description := lastPublished(t, node, "/test_node/parameter_descriptions").(*drmsgs.ConfigDescription)
+	if len(description.Groups) != 2 || len(description.Groups[1].Parameters) != 3 || len(description.Dflt.Ints) != 2 {
+		t.Errorf("unexpected description %v", description)
+	}
+	update := lastPublished(t, node, "/test_node/parameter_updates").(*drmsgs.Config)
+	if decodeConfig(update).Int("rate") != 20 || len(update.Groups) != 2 {
+		t.Errorf("unexpected update %v", update)
+	}
+
+	srv := &drmsgs.Reconfigure{}
+	req, res := &srv.Request, &srv.Response
+	req.Config.Ints = []drmsgs.IntParameter{{Name: "rate", Value: 1000}}
+	req.Config.Bools = []drmsgs.BoolParameter{{Name: "enabled", Value: true}}
+	req.Config.Groups = []drmsgs.GroupState{{Name: "Advanced", State: false, Id: 1}}
+	if err := node.NewServiceClient("/test_node/set_parameters", drmsgs.SrvReconfigure).Call(srv); err != nil {
+		t.Fatal(err)
+	}
+	// Only the changed parameter counts for the level.
+	if len(calls) != 2 || calls[1].level != 1 || calls[1].config.Int("rate") != 100 {
+		t.Errorf("unexpected calls %v", calls)
+	}
+	if decodeConfig(&res.Config).Int("rate") != 100 || res.Config.Groups[1].State {
+		t.Errorf("unexpected response %v", res.Config)
+	}
+	if param(node, "~rate") != 100 {
+		t.Errorf("parameter is not mirrored: %v", param(node, "~rate"))
+	}
+
+	for _, changes := range []Config{{"unknown": 1}, {"enabled": "yes"}, {"mode": 2}} {
+		if _, err := server.UpdateConfiguration(changes); err == nil {
+			t.Errorf("%v is accepted", changes)
+		}
+	}
+	if len(calls) != 2 {
+		t.Errorf("callback is called for invalid changes")
+	}
+	config, err := server.UpdateConfiguration(Config{"gain": 0, "mode": 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Double("gain") != 0 || config.Int("mode") != 1 || calls[2].level != 2|8 {
+		t.Errorf("unexpected config %v at level %d", config, calls[2].level)
+	}
+
+	server.Shutdown()
+	if node.Served("/test_node/set_parameters") || node.Advertised("/test_node/parameter_updates") {
+		t.Error("server is not shut down")
+	}
+}
+
+func TestServerCallbackModifiesConfig(t *testing.T) {
+	node := newTestNode()
+	server := NewServer(node, newTestDescription(), func(config Config, level uint32) Config {
+		config["rate"] = config.Int("rate") / 10 * 10
+		delete(config, "gain")
+		return config
+	})
+	config, err := server.UpdateConfiguration(Config{"rate": 37, "gain": 0.25})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Int("rate") != 30 || config.Double("gain") != 0.25 || server.Config().Int("rate") != 30 {
+		t.Errorf("unexpected config %v", config)
+	}
+}
+
+func TestServerLatchesUpdates(t *testing.T) {
+	node := newTestNode()
+	NewServer(node, newTestDescription(), nil)
+	if !node.Latched("/test_node/parameter_updates") || !node.Latched("/test_node/parameter_descriptions") {
+		t.Error("topics are not latched")
+	}
+	ch, _ := node.SubscribeChan("/test_node/parameter_updates", drmsgs.MsgConfig, 1)
+	received := <-ch
+	if decodeConfig(received.Message.(*drmsgs.Config)).Int("rate") != 10 {
+		t.Errorf("unexpected message %v", received.Message)
+	}
+}
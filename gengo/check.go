@@ -0,0 +1,180 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// CheckResult is the result of checking a definition file. The goal,
+// result and feedback of an action are reported separately.
+type CheckResult struct {
+	Name   string   `json:"name"`
+	Kind   string   `json:"kind"`
+	File   string   `json:"file"`
+	MD5Sum string   `json:"md5sum,omitempty"`
+	Errors []string `json:"errors,omitempty"`
+}
+
+func (r *CheckResult) OK() bool {
+	return len(r.Errors) == 0
+}
+
+type definitionFile struct {
+	fullname string
+	kind     string
+	path     string
+}
+
+// The ROS package of a definition file is the one containing its msg, srv
+// or action directory. Files outside of them belong to the directory.
+func definitionPackage(filePath string) string {
+	dir := filepath.Dir(filePath)
+	switch filepath.Base(dir) {
+	case MsgDir, SrvDir, ActionDir:
+		return filepath.Base(filepath.Dir(dir))
+	}
+	return filepath.Base(dir)
+}
+
+func findDefinitionFiles(dir string) ([]definitionFile, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []definitionFile
+	err = filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		var kind string
+		switch filepath.Ext(p) {
+		case ExtMsg:
+			kind = "msg"
+		case ExtSrv:
+			kind = "srv"
+		case ExtAction:
+			kind = "action"
+		default:
+			return nil
+		}
+		shortName := strings.TrimSuffix(filepath.Base(p), filepath.Ext(p))
+		fullname := definitionPackage(p) + Sep + shortName
+		files = append(files, definitionFile{fullname, kind, p})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].path < files[j].path
+	})
+	return files, nil
+}
+
+func checkName(fullname string) error {
+	pkg, shortName, _ := packageResourceName(fullname)
+	if !isLegalResourceBaseName(pkg) {
+		return fmt.Errorf("%s is not a legal package name", pkg)
+	}
+	if !isLegalResourceBaseName(shortName) {
+		return fmt.Errorf("%s is not a legal message name", shortName)
+	}
+	return nil
+}
+
+func (ctx *MsgContext) checkFile(file definitionFile) []CheckResult {
+	result := CheckResult{Name: file.fullname, Kind: file.kind, File: file.path}
+	if err := checkName(file.fullname); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+		return []CheckResult{result}
+	}
+	switch file.kind {
+	case "msg":
+		spec, err := ctx.LoadMsg(file.fullname)
+		if err != nil {
+			result.Errors = append(result.Errors, err.Error())
+		} else {
+			result.MD5Sum = spec.MD5Sum
+		}
+	case "srv":
+		spec, err := ctx.LoadSrv(file.fullname)
+		if err != nil {
+			result.Errors = append(result.Errors, err.Error())
+		} else {
+			result.MD5Sum = spec.MD5Sum
+		}
+	case "action":
+		text, err := ioutil.ReadFile(file.path)
+		if err != nil {
+			result.Errors = append(result.Errors, err.Error())
+			break
+		}
+		spec, err := ctx.LoadActionFromString(string(text), file.fullname)
+		if err != nil {
+			result.Errors = append(result.Errors, err.Error())
+			break
+		}
+		var results []CheckResult
+		for _, part := range []*MsgSpec{spec.Goal, spec.Result, spec.Feedback} {
+			results = append(results, CheckResult{Name: part.FullName, Kind: file.kind, File: file.path, MD5Sum: part.MD5Sum})
+		}
+		return results
+	}
+	return []CheckResult{result}
+}
+
+// Create a context where the definitions under the directory take
+// precedence over the ROS package paths and the definitions shipped with
+// rosgo.
+func newTreeContext(rosPkgPaths []string, dir string) (*MsgContext, []definitionFile, error) {
+	files, err := findDefinitionFiles(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(files) == 0 {
+		return nil, nil, fmt.Errorf("No message, service or action definitions are found in %s", dir)
+	}
+	ctx, err := NewMsgContext(rosPkgPaths)
+	if err != nil {
+		return nil, nil, err
+	}
+	msgPaths := make(map[string]map[string]string)
+	srvPaths := make(map[string]map[string]string)
+	for _, file := range files {
+		pkg := strings.Split(file.fullname, Sep)[0]
+		if msgPaths[pkg] == nil {
+			msgPaths[pkg] = make(map[string]string)
+			srvPaths[pkg] = make(map[string]string)
+		}
+		switch file.kind {
+		case "msg":
+			msgPaths[pkg][file.fullname] = file.path
+		case "srv":
+			srvPaths[pkg][file.fullname] = file.path
+		}
+	}
+	for pkg := range msgPaths {
+		ctx.overridePackage(pkg, msgPaths[pkg], srvPaths[pkg])
+	}
+	return ctx, files, nil
+}
+
+// CheckDefinitions loads all .msg, .srv and .action files under the
+// directory and reports their MD5 sums or what is wrong with them.
+// Dependencies are searched in the directory first, then in the ROS package
+// paths and the definitions shipped with rosgo.
+func CheckDefinitions(rosPkgPaths []string, dir string) ([]CheckResult, error) {
+	ctx, files, err := newTreeContext(rosPkgPaths, dir)
+	if err != nil {
+		return nil, err
+	}
+	var results []CheckResult
+	for _, file := range files {
+		results = append(results, ctx.checkFile(file)...)
+	}
+	return results, nil
+}
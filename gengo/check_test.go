@@ -0,0 +1,136 @@
+package main
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func checkOne(t *testing.T, files map[string]string) map[string]CheckResult {
+	root := t.TempDir()
+	writeRosPackage(t, root, "foo_msgs", files)
+	results, err := CheckDefinitions(nil, root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	byName := make(map[string]CheckResult)
+	for _, r := range results {
+		byName[r.Name] = r
+	}
+	return byName
+}
+
+func expectCheckError(t *testing.T, results map[string]CheckResult, name string, message string) {
+	r, ok := results[name]
+	if !ok {
+		t.Errorf("%s is not checked", name)
+		return
+	}
+	for _, e := range r.Errors {
+		if strings.Contains(e, message) {
+			return
+		}
+	}
+	t.Errorf("%s: expected an error containing %q but %v", name, message, r.Errors)
+}
+
+func TestCheckDefinitions(t *testing.T) {
+	results := checkOne(t, map[string]string{
+		"msg/Point.msg":      "float64 x\nfloat64 y\nfloat64 z\n",
+		"msg/Stamped.msg":    "Header header\nPoint point\n",
+		"srv/GetPoint.srv":   "string name\n---\nPoint point\n",
+		"action/Move.action": "Point target\n---\nbool ok\n---\nfloat32 progress\n",
+	})
+	expected := map[string]string{
+		// Same as geometry_msgs/Point
+		"foo_msgs/Point":        "4a842b65f413084dc2b10fb484ea7f17",
+		"foo_msgs/Stamped":      "",
+		"foo_msgs/GetPoint":     "",
+		"foo_msgs/MoveGoal":     "",
+		"foo_msgs/MoveResult":   "",
+		"foo_msgs/MoveFeedback": "",
+	}
+	if len(results) != len(expected) {
+		t.Errorf("expected %d results but %d", len(expected), len(results))
+	}
+	for name, md5sum := range expected {
+		r, ok := results[name]
+		if !ok {
+			t.Errorf("%s is not checked", name)
+			continue
+		}
+		if !r.OK() {
+			t.Errorf("%s: unexpected errors %v", name, r.Errors)
+		}
+		if len(r.MD5Sum) != 32 || (md5sum != "" && r.MD5Sum != md5sum) {
+			t.Errorf("%s: unexpected MD5 sum %q", name, r.MD5Sum)
+		}
+	}
+	if file := results["foo_msgs/MoveGoal"].File; filepath.Base(file) != "Move.action" {
+		t.Errorf("unexpected file %s", file)
+	}
+}
+
+func TestCheckErrors(t *testing.T) {
+	results := checkOne(t, map[string]string{
+		"msg/1Bad.msg":       "int32 x\n",
+		"msg/BadField.msg":   "int32 1x\n",
+		"msg/BadConst.msg":   "int32 1X = 1\n",
+		"msg/Unknown.msg":    "int32 x\nNothing y\n",
+		"msg/A.msg":          "B b\n",
+		"msg/B.msg":          "A[] a\n",
+		"msg/Self.msg":       "Self[] children\n",
+		"msg/Duplicate.msg":  "int32 x\nfloat64 x\n",
+		"msg/DuplicateC.msg": "int32 X = 1\nint32 X = 2\n",
+		"msg/Overflow.msg":   "int32 x\nuint8 MAX = 256\n",
+		"msg/Negative.msg":   "uint16 MIN = -1\n",
+		"srv/BadSrv.srv":     "int32 x\n",
+		"srv/BadDepend.srv":  "int32 x\n---\nMissing m\n",
+		"action/Bad.action":  "int32 goal\n---\nint32 result\n",
+	})
+	expectCheckError(t, results, "foo_msgs/1Bad", "not a legal message name")
+	expectCheckError(t, results, "foo_msgs/BadField", "not a legal message field name")
+	expectCheckError(t, results, "foo_msgs/BadConst", "not a legal constant name")
+	expectCheckError(t, results, "foo_msgs/Unknown", "`foo_msgs/Nothing` is not found")
+	expectCheckError(t, results, "foo_msgs/A", "Circular dependency: foo_msgs/A -> foo_msgs/B -> foo_msgs/A")
+	expectCheckError(t, results, "foo_msgs/B", "Circular dependency: foo_msgs/B -> foo_msgs/A -> foo_msgs/B")
+	expectCheckError(t, results, "foo_msgs/Self", "Circular dependency: foo_msgs/Self -> foo_msgs/Self")
+	expectCheckError(t, results, "foo_msgs/Duplicate", "[foo_msgs/Duplicate@2] Duplicate name x")
+	expectCheckError(t, results, "foo_msgs/DuplicateC", "[foo_msgs/DuplicateC@2] Duplicate name X")
+	expectCheckError(t, results, "foo_msgs/Overflow", "[foo_msgs/Overflow@2] Invalid value for uint8 constant MAX")
+	expectCheckError(t, results, "foo_msgs/Negative", "Invalid value for uint16 constant MIN")
+	expectCheckError(t, results, "foo_msgs/BadSrv", "missing '---'")
+	expectCheckError(t, results, "foo_msgs/BadDepend", "`foo_msgs/Missing` is not found")
+	expectCheckError(t, results, "foo_msgs/Bad", "requires two '---'")
+}
+
+// Definitions under the checked directory take precedence over the ROS
+// package paths and the embedded ones.
+func TestCheckOverridesPackage(t *testing.T) {
+	root := t.TempDir()
+	writeRosPackage(t, root, "std_msgs", map[string]string{
+		"msg/Bool.msg":   "bool data\n",
+		"msg/Header.msg": "uint32 seq\ntime stamp\nstring frame_id\nstring extra\n",
+	})
+	results, err := CheckDefinitions(nil, filepath.Join(root, "std_msgs"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range results {
+		if !r.OK() {
+			t.Errorf("%s: unexpected errors %v", r.Name, r.Errors)
+		}
+		if r.Name == "std_msgs/Header" && r.MD5Sum == "2176decaecbce78abc3b96ef049fabed" {
+			t.Errorf("embedded std_msgs/Header is checked")
+		}
+	}
+	if len(results) != 2 {
+		t.Errorf("expected 2 results but %d", len(results))
+	}
+}
+
+func TestCheckNoDefinitions(t *testing.T) {
+	if _, err := CheckDefinitions(nil, t.TempDir()); err == nil {
+		t.Error("expected an error for a directory without definitions")
+	}
+}
@@ -0,0 +1,392 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// How data of the old version of a message can be converted to the new one.
+const (
+	// The MD5 sums are the same.
+	MigrationNone = "none"
+	// All data is kept. Added fields are zero.
+	MigrationAutomatic = "automatic"
+	// Conversion is possible but loses removed fields or narrows values.
+	MigrationLossy = "lossy"
+	// Some fields cannot be converted without a hand-written rule.
+	MigrationManual = "manual"
+)
+
+var migrationOrder = []string{MigrationNone, MigrationAutomatic, MigrationLossy, MigrationManual}
+
+func worseMigration(a string, b string) string {
+	for _, m := range migrationOrder {
+		if a == m || b == m {
+			if a == m {
+				return b
+			}
+			return a
+		}
+	}
+	return a
+}
+
+// FieldChange is an added, removed or retyped field.
+type FieldChange struct {
+	Name      string `json:"name"`
+	OldType   string `json:"old_type,omitempty"`
+	NewType   string `json:"new_type,omitempty"`
+	Migration string `json:"migration"`
+}
+
+// ConstantChange is an added, removed or modified constant. Constants do
+// not affect the data but change the MD5 sum.
+type ConstantChange struct {
+	Name string `json:"name"`
+	Old  string `json:"old,omitempty"`
+	New  string `json:"new,omitempty"`
+}
+
+// CompatResult compares two versions of a message or service.
+type CompatResult struct {
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+	// added, removed, unchanged, changed, or depends_changed if only
+	// messages it depends on are changed.
+	Status    string           `json:"status"`
+	OldMD5Sum string           `json:"old_md5sum,omitempty"`
+	NewMD5Sum string           `json:"new_md5sum,omitempty"`
+	Fields    []FieldChange    `json:"fields,omitempty"`
+	Constants []ConstantChange `json:"constants,omitempty"`
+	// Whether the fields kept in the new version are in a different order.
+	Reordered bool `json:"reordered,omitempty"`
+	// Messages it depends on, directly or not, whose MD5 sums are changed.
+	ChangedDepends []string `json:"changed_depends,omitempty"`
+	Migration      string   `json:"migration,omitempty"`
+	Errors         []string `json:"errors,omitempty"`
+}
+
+type compatComparison struct {
+	oldCtx  *MsgContext
+	newCtx  *MsgContext
+	results map[string]*CompatResult
+}
+
+func fieldTypeName(f *Field) string {
+	name := f.Type
+	if f.Package != "" {
+		name = f.Package + "/" + f.Type
+	}
+	if f.IsArray {
+		if f.ArrayLen < 0 {
+			return name + "[]"
+		}
+		return fmt.Sprintf("%s[%d]", name, f.ArrayLen)
+	}
+	return name
+}
+
+func constantText(c *Constant) string {
+	return fmt.Sprintf("%s %s=%s", c.Type, c.Name, c.ValueText)
+}
+
+// Signedness and bits of integer types. byte and char are aliases of int8
+// and uint8.
+var integerTypes = map[string]struct {
+	signed bool
+	bits   int
+}{
+	"byte": {true, 8}, "int8": {true, 8}, "int16": {true, 16}, "int32": {true, 32}, "int64": {true, 64},
+	"char": {false, 8}, "uint8": {false, 8}, "uint16": {false, 16}, "uint32": {false, 32}, "uint64": {false, 64},
+}
+
+// Bits of the mantissa of floating point types.
+var floatTypes = map[string]int{"float32": 24, "float64": 53}
+
+func builtinMigration(oldType string, newType string) string {
+	if oldType == newType {
+		return MigrationNone
+	}
+	oldInt, oldIsInt := integerTypes[oldType]
+	newInt, newIsInt := integerTypes[newType]
+	oldFloat, oldIsFloat := floatTypes[oldType]
+	newFloat, newIsFloat := floatTypes[newType]
+	switch {
+	case oldIsInt && newIsInt:
+		if oldInt.signed == newInt.signed && oldInt.bits == newInt.bits {
+			return MigrationNone
+		}
+		if (oldInt.signed == newInt.signed && newInt.bits > oldInt.bits) || (!oldInt.signed && newInt.signed && newInt.bits > oldInt.bits) {
+			return MigrationAutomatic
+		}
+		return MigrationLossy
+	case oldIsInt && newIsFloat:
+		if oldInt.bits <= newFloat {
+			return MigrationAutomatic
+		}
+		return MigrationLossy
+	case oldIsFloat && newIsFloat:
+		if newFloat > oldFloat {
+			return MigrationAutomatic
+		}
+		return MigrationLossy
+	case oldIsFloat && newIsInt:
+		return MigrationLossy
+	}
+	return MigrationManual
+}
+
+func (c *compatComparison) fieldMigration(oldField *Field, newField *Field) string {
+	if oldField.IsArray != newField.IsArray {
+		return MigrationManual
+	}
+	migration := MigrationNone
+	if oldField.IsArray && oldField.ArrayLen != newField.ArrayLen {
+		if newField.ArrayLen < 0 {
+			// Fixed-size to variable-length keeps all elements.
+			migration = MigrationAutomatic
+		} else {
+			migration = MigrationLossy
+		}
+	}
+	switch {
+	case oldField.IsBuiltin && newField.IsBuiltin:
+		return worseMigration(migration, builtinMigration(oldField.Type, newField.Type))
+	case oldField.IsBuiltin || newField.IsBuiltin:
+		return MigrationManual
+	}
+	oldName := oldField.Package + "/" + oldField.Type
+	newName := newField.Package + "/" + newField.Type
+	if oldName == newName {
+		depend := c.compareMsg(oldName)
+		if depend.Migration == "" {
+			return MigrationManual
+		}
+		return worseMigration(migration, depend.Migration)
+	}
+	// A message moved or renamed without changes.
+	oldSpec, oldErr := c.oldCtx.LoadMsg(oldName)
+	newSpec, newErr := c.newCtx.LoadMsg(newName)
+	if oldErr == nil && newErr == nil && oldSpec.MD5Sum == newSpec.MD5Sum {
+		return worseMigration(migration, MigrationAutomatic)
+	}
+	return MigrationManual
+}
+
+func (c *compatComparison) changedDepends(spec *MsgSpec) []string {
+	allDepends, err := c.newCtx.getAllDepends(spec)
+	if err != nil {
+		return nil
+	}
+	var changed []string
+	seen := make(map[string]bool)
+	for _, d := range allDepends {
+		if seen[d] {
+			continue
+		}
+		seen[d] = true
+		r := c.compareMsg(d)
+		if r.Status == "changed" || r.Status == "depends_changed" {
+			changed = append(changed, d)
+		}
+	}
+	return changed
+}
+
+func (c *compatComparison) compareSpecs(result *CompatResult, oldSpec *MsgSpec, newSpec *MsgSpec) {
+	result.OldMD5Sum = oldSpec.MD5Sum
+	result.NewMD5Sum = newSpec.MD5Sum
+	if oldSpec.MD5Sum == newSpec.MD5Sum {
+		result.Status = "unchanged"
+		result.Migration = MigrationNone
+		return
+	}
+
+	migration := MigrationNone
+	newFields := make(map[string]*Field)
+	for i := range newSpec.Fields {
+		newFields[newSpec.Fields[i].Name] = &newSpec.Fields[i]
+	}
+	oldFields := make(map[string]*Field)
+	var oldOrder, newOrder []string
+	for i := range oldSpec.Fields {
+		oldField := &oldSpec.Fields[i]
+		oldFields[oldField.Name] = oldField
+		newField, ok := newFields[oldField.Name]
+		if !ok {
+			result.Fields = append(result.Fields, FieldChange{oldField.Name, fieldTypeName(oldField), "", MigrationLossy})
+			migration = worseMigration(migration, MigrationLossy)
+			continue
+		}
+		oldOrder = append(oldOrder, oldField.Name)
+		fieldMigration := c.fieldMigration(oldField, newField)
+		if fieldTypeName(oldField) != fieldTypeName(newField) {
+			result.Fields = append(result.Fields, FieldChange{oldField.Name, fieldTypeName(oldField), fieldTypeName(newField), fieldMigration})
+		}
+		migration = worseMigration(migration, fieldMigration)
+	}
+	for i := range newSpec.Fields {
+		newField := &newSpec.Fields[i]
+		if _, ok := oldFields[newField.Name]; ok {
+			newOrder = append(newOrder, newField.Name)
+			continue
+		}
+		result.Fields = append(result.Fields, FieldChange{newField.Name, "", fieldTypeName(newField), MigrationAutomatic})
+		migration = worseMigration(migration, MigrationAutomatic)
+	}
+	if strings.Join(oldOrder, " ") != strings.Join(newOrder, " ") {
+		result.Reordered = true
+		migration = worseMigration(migration, MigrationAutomatic)
+	}
+
+	newConstants := make(map[string]*Constant)
+	for i := range newSpec.Constants {
+		newConstants[newSpec.Constants[i].Name] = &newSpec.Constants[i]
+	}
+	oldConstants := make(map[string]bool)
+	for i := range oldSpec.Constants {
+		oldConstant := &oldSpec.Constants[i]
+		oldConstants[oldConstant.Name] = true
+		if newConstant, ok := newConstants[oldConstant.Name]; !ok {
+			result.Constants = append(result.Constants, ConstantChange{oldConstant.Name, constantText(oldConstant), ""})
+		} else if constantText(oldConstant) != constantText(newConstant) {
+			result.Constants = append(result.Constants, ConstantChange{oldConstant.Name, constantText(oldConstant), constantText(newConstant)})
+		}
+	}
+	for i := range newSpec.Constants {
+		if newConstant := &newSpec.Constants[i]; !oldConstants[newConstant.Name] {
+			result.Constants = append(result.Constants, ConstantChange{newConstant.Name, "", constantText(newConstant)})
+		}
+	}
+
+	result.ChangedDepends = c.changedDepends(newSpec)
+	if len(result.Fields) == 0 && len(result.Constants) == 0 && !result.Reordered {
+		result.Status = "depends_changed"
+	} else {
+		result.Status = "changed"
+	}
+	// Constants alone change the MD5 sum but not the data.
+	result.Migration = worseMigration(migration, MigrationAutomatic)
+}
+
+func (c *compatComparison) compareMsg(fullname string) *CompatResult {
+	if result, ok := c.results[fullname]; ok {
+		return result
+	}
+	result := &CompatResult{Name: fullname, Kind: "msg"}
+	c.results[fullname] = result
+	oldSpec, oldErr := c.oldCtx.LoadMsg(fullname)
+	newSpec, newErr := c.newCtx.LoadMsg(fullname)
+	_, oldFound := c.oldCtx.msgPathMap[fullname]
+	_, newFound := c.newCtx.msgPathMap[fullname]
+	if !c.compareLoaded(result, oldFound, oldErr, newFound, newErr) {
+		return result
+	}
+	switch {
+	case !oldFound:
+		result.NewMD5Sum = newSpec.MD5Sum
+	case !newFound:
+		result.OldMD5Sum = oldSpec.MD5Sum
+	default:
+		c.compareSpecs(result, oldSpec, newSpec)
+	}
+	return result
+}
+
+// Set the status if the definition is invalid, added or removed. It returns
+// false if the definition is invalid.
+func (c *compatComparison) compareLoaded(result *CompatResult, oldFound bool, oldErr error, newFound bool, newErr error) bool {
+	switch {
+	case !oldFound && !newFound:
+		result.Errors = append(result.Errors, fmt.Sprintf("Definition of `%s` is not found", result.Name))
+	case oldFound && oldErr != nil:
+		result.Errors = append(result.Errors, "old: "+oldErr.Error())
+	case newFound && newErr != nil:
+		result.Errors = append(result.Errors, "new: "+newErr.Error())
+	case !oldFound:
+		result.Status = "added"
+	case !newFound:
+		result.Status = "removed"
+	}
+	if len(result.Errors) > 0 {
+		result.Status = "invalid"
+		return false
+	}
+	return true
+}
+
+// A service is compared as its request and response, which are reported
+// as messages.
+func (c *compatComparison) compareSrv(fullname string) []*CompatResult {
+	result := &CompatResult{Name: fullname, Kind: "srv"}
+	oldSpec, oldErr := c.oldCtx.LoadSrv(fullname)
+	newSpec, newErr := c.newCtx.LoadSrv(fullname)
+	_, oldFound := c.oldCtx.srvPathMap[fullname]
+	_, newFound := c.newCtx.srvPathMap[fullname]
+	if !c.compareLoaded(result, oldFound, oldErr, newFound, newErr) {
+		return []*CompatResult{result}
+	}
+	if !oldFound {
+		result.NewMD5Sum = newSpec.MD5Sum
+		return []*CompatResult{result}
+	} else if !newFound {
+		result.OldMD5Sum = oldSpec.MD5Sum
+		return []*CompatResult{result}
+	}
+	results := []*CompatResult{result}
+	result.OldMD5Sum = oldSpec.MD5Sum
+	result.NewMD5Sum = newSpec.MD5Sum
+	result.Status = "unchanged"
+	result.Migration = MigrationNone
+	parts := [][2]*MsgSpec{{oldSpec.Request, newSpec.Request}, {oldSpec.Response, newSpec.Response}}
+	for _, part := range parts {
+		partResult := &CompatResult{Name: part[1].FullName, Kind: "srv"}
+		c.compareSpecs(partResult, part[0], part[1])
+		results = append(results, partResult)
+		if partResult.Status != "unchanged" {
+			result.Status = "changed"
+			result.ChangedDepends = append(result.ChangedDepends, partResult.Name)
+			result.Migration = worseMigration(result.Migration, partResult.Migration)
+		}
+	}
+	return results
+}
+
+// CompareDefinitions compares the messages and services of two directories,
+// typically two checkouts of the same packages. Actions are not compared
+// as they are not sent as they are.
+func CompareDefinitions(rosPkgPaths []string, oldDir string, newDir string) ([]CompatResult, error) {
+	oldCtx, oldFiles, err := newTreeContext(rosPkgPaths, oldDir)
+	if err != nil {
+		return nil, err
+	}
+	newCtx, newFiles, err := newTreeContext(rosPkgPaths, newDir)
+	if err != nil {
+		return nil, err
+	}
+	kinds := make(map[string]string)
+	for _, file := range append(oldFiles, newFiles...) {
+		if file.kind != "action" {
+			kinds[file.fullname] = file.kind
+		}
+	}
+	var names []string
+	for name := range kinds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	c := &compatComparison{oldCtx, newCtx, make(map[string]*CompatResult)}
+	var results []CompatResult
+	for _, name := range names {
+		if kinds[name] == "msg" {
+			results = append(results, *c.compareMsg(name))
+			continue
+		}
+		for _, r := range c.compareSrv(name) {
+			results = append(results, *r)
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func compareTrees(t *testing.T, oldFiles map[string]string, newFiles map[string]string) map[string]CompatResult {
+	root := t.TempDir()
+	writeRosPackage(t, filepath.Join(root, "old"), "foo_msgs", oldFiles)
+	writeRosPackage(t, filepath.Join(root, "new"), "foo_msgs", newFiles)
+	results, err := CompareDefinitions(nil, filepath.Join(root, "old"), filepath.Join(root, "new"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	byName := make(map[string]CompatResult)
+	for _, r := range results {
+		byName[r.Name] = r
+	}
+	return byName
+}
+
+func TestCompareDefinitions(t *testing.T) {
+	results := compareTrees(t, map[string]string{
+		"msg/Point.msg":   "float32 x\nfloat32 y\n",
+		"msg/Stamped.msg": "Header header\nPoint point\n",
+		"msg/Same.msg":    "bool b\n",
+		"msg/Gone.msg":    "string s\n",
+		"srv/Set.srv":     "Point point\n---\nbool ok\n",
+	}, map[string]string{
+		"msg/Point.msg":   "float64 x\nfloat64 y\nfloat64 z\n",
+		"msg/Stamped.msg": "Header header\nPoint point\n",
+		"msg/Same.msg":    "bool b\n",
+		"msg/New.msg":     "string s\n",
+		"srv/Set.srv":     "Point point\n---\nbool ok\n",
+	})
+	var tests = []struct {
+		name           string
+		status         string
+		migration      string
+		changedDepends []string
+	}{
+		{"foo_msgs/Point", "changed", MigrationAutomatic, nil},
+		{"foo_msgs/Stamped", "depends_changed", MigrationAutomatic, []string{"foo_msgs/Point"}},
+		{"foo_msgs/Same", "unchanged", MigrationNone, nil},
+		{"foo_msgs/Gone", "removed", "", nil},
+		{"foo_msgs/New", "added", "", nil},
+		{"foo_msgs/Set", "changed", MigrationAutomatic, []string{"foo_msgs/SetRequest"}},
+		{"foo_msgs/SetRequest", "depends_changed", MigrationAutomatic, []string{"foo_msgs/Point"}},
+		{"foo_msgs/SetResponse", "unchanged", MigrationNone, nil},
+	}
+	if len(results) != len(tests) {
+		t.Errorf("expected %d results but %d", len(tests), len(results))
+	}
+	for _, test := range tests {
+		r := results[test.name]
+		if r.Status != test.status || r.Migration != test.migration || !reflect.DeepEqual(r.ChangedDepends, test.changedDepends) {
+			t.Errorf("%s: expected %s, %q, %v but %s, %q, %v", test.name,
+				test.status, test.migration, test.changedDepends, r.Status, r.Migration, r.ChangedDepends)
+		}
+	}
+	expected := []FieldChange{
+		{"x", "float32", "float64", MigrationAutomatic},
+		{"y", "float32", "float64", MigrationAutomatic},
+		{"z", "", "float64", MigrationAutomatic},
+	}
+	if fields := results["foo_msgs/Point"].Fields; !reflect.DeepEqual(fields, expected) {
+		t.Errorf("expected %v but %v", expected, fields)
+	}
+	// Header is the embedded one in both.
+	if r := results["foo_msgs/Same"]; r.OldMD5Sum != r.NewMD5Sum || r.OldMD5Sum == "" {
+		t.Errorf("unexpected MD5 sums %s, %s", r.OldMD5Sum, r.NewMD5Sum)
+	}
+}
+
+func TestCompareFields(t *testing.T) {
+	results := compareTrees(t, map[string]string{
+		"msg/Const.msg":     "int32 A=1\nint32 B=2\nint32 x\n",
+		"msg/Order.msg":     "int32 a\nint32 b\n",
+		"msg/Removed.msg":   "int32 a\nint32 b\n",
+		"msg/Narrow.msg":    "int64 a\n",
+		"msg/Resized.msg":   "int32[] a\nint32[2] b\n",
+		"msg/Retyped.msg":   "string a\n",
+		"msg/Scalar.msg":    "int32 a\n",
+		"msg/Signed.msg":    "uint16 a\nint16 b\nint64 c\n",
+		"msg/Alias.msg":     "byte a\nchar b\n",
+		"msg/Nested.msg":    "Narrow n\n",
+		"msg/Nested2.msg":   "Nested[] n\n",
+		"msg/Inner.msg":     "int32 a\n",
+		"msg/Outer.msg":     "Inner i\n",
+		"msg/Unrelated.msg": "Inner i\n",
+	}, map[string]string{
+		"msg/Const.msg":     "int32 A=3\nint32 x\nint32 C=4\n",
+		"msg/Order.msg":     "int32 b\nint32 a\n",
+		"msg/Removed.msg":   "int32 a\n",
+		"msg/Narrow.msg":    "int32 a\n",
+		"msg/Resized.msg":   "int32[3] a\nint32[] b\n",
+		"msg/Retyped.msg":   "int32 a\n",
+		"msg/Scalar.msg":    "int32[] a\n",
+		"msg/Signed.msg":    "int32 a\nuint16 b\nfloat64 c\n",
+		"msg/Alias.msg":     "int8 a\nuint8 b\n",
+		"msg/Nested.msg":    "Narrow n\n",
+		"msg/Nested2.msg":   "Nested[] n\n",
+		"msg/Inner.msg":     "int32 a\n",
+		"msg/Inner2.msg":    "int32 a\n",
+		"msg/Outer.msg":     "Inner2 i\n",
+		"msg/Unrelated.msg": "Order i\n",
+	})
+	var tests = []struct {
+		name      string
+		status    string
+		migration string
+	}{
+		{"foo_msgs/Const", "changed", MigrationAutomatic},
+		{"foo_msgs/Order", "changed", MigrationAutomatic},
+		{"foo_msgs/Removed", "changed", MigrationLossy},
+		{"foo_msgs/Narrow", "changed", MigrationLossy},
+		{"foo_msgs/Resized", "changed", MigrationLossy},
+		{"foo_msgs/Retyped", "changed", MigrationManual},
+		{"foo_msgs/Scalar", "changed", MigrationManual},
+		{"foo_msgs/Signed", "changed", MigrationLossy},
+		// The text changes the MD5 sum though the data is the same.
+		{"foo_msgs/Alias", "changed", MigrationAutomatic},
+		{"foo_msgs/Nested", "depends_changed", MigrationLossy},
+		{"foo_msgs/Nested2", "depends_changed", MigrationLossy},
+		// MD5 sums of dependencies are used instead of their names.
+		{"foo_msgs/Outer", "unchanged", MigrationNone},
+		{"foo_msgs/Unrelated", "changed", MigrationManual},
+	}
+	for _, test := range tests {
+		r := results[test.name]
+		if r.Status != test.status || r.Migration != test.migration {
+			t.Errorf("%s: expected %s, %q but %s, %q", test.name, test.status, test.migration, r.Status, r.Migration)
+		}
+	}
+
+	expectedConstants := []ConstantChange{
+		{"A", "int32 A=1", "int32 A=3"},
+		{"B", "int32 B=2", ""},
+		{"C", "", "int32 C=4"},
+	}
+	if constants := results["foo_msgs/Const"].Constants; !reflect.DeepEqual(constants, expectedConstants) {
+		t.Errorf("expected %v but %v", expectedConstants, constants)
+	}
+	if !results["foo_msgs/Order"].Reordered || len(results["foo_msgs/Order"].Fields) != 0 {
+		t.Errorf("unexpected result %v", results["foo_msgs/Order"])
+	}
+	expectedSigned := []FieldChange{
+		{"a", "uint16", "int32", MigrationAutomatic},
+		{"b", "int16", "uint16", MigrationLossy},
+		{"c", "int64", "float64", MigrationLossy},
+	}
+	if fields := results["foo_msgs/Signed"].Fields; !reflect.DeepEqual(fields, expectedSigned) {
+		t.Errorf("expected %v but %v", expectedSigned, fields)
+	}
+	expectedAlias := []FieldChange{
+		{"a", "byte", "int8", MigrationNone},
+		{"b", "char", "uint8", MigrationNone},
+	}
+	if fields := results["foo_msgs/Alias"].Fields; !reflect.DeepEqual(fields, expectedAlias) {
+		t.Errorf("expected %v but %v", expectedAlias, fields)
+	}
+	expectedRemoved := []FieldChange{{"b", "int32", "", MigrationLossy}}
+	if fields := results["foo_msgs/Removed"].Fields; !reflect.DeepEqual(fields, expectedRemoved) {
+		t.Errorf("expected %v but %v", expectedRemoved, fields)
+	}
+}
+
+func TestCompareInvalid(t *testing.T) {
+	results := compareTrees(t, map[string]string{
+		"msg/Bad.msg": "int32 x\n",
+	}, map[string]string{
+		"msg/Bad.msg": "Missing x\n",
+	})
+	if r := results["foo_msgs/Bad"]; r.Status != "invalid" || len(r.Errors) != 1 {
+		t.Errorf("unexpected result %v", r)
+	}
+}
@@ -5,9 +5,14 @@ import (
 	"crypto/md5"
 	"encoding/hex"
 	"fmt"
+	"io/fs"
 	"io/ioutil"
+	"path"
 	"path/filepath"
+	"sort"
 	"strings"
+
+	"github.com/akio/rosgo/msgs"
 )
 
 func isRosPackage(dir string) bool {
@@ -26,6 +31,9 @@ func isRosPackage(dir string) bool {
 func findAllMessages(rosPkgPaths []string) (map[string]string, error) {
 	msgs := make(map[string]string)
 	for _, p := range rosPkgPaths {
+		if p == "" {
+			continue
+		}
 		files, err := ioutil.ReadDir(p)
 		if err != nil {
 			return nil, err
@@ -37,8 +45,8 @@ func findAllMessages(rosPkgPaths []string) (map[string]string, error) {
 			pkgPath := filepath.Join(p, f.Name())
 			if isRosPackage(pkgPath) {
 				pkgName := filepath.Base(pkgPath)
-				msgPath := filepath.Join(pkgPath, "msg")
-				msgPaths, err := filepath.Glob(msgPath + "/*.msg")
+				msgPath := filepath.Join(pkgPath, MsgDir)
+				msgPaths, err := filepath.Glob(msgPath + "/*" + ExtMsg)
 				if err != nil {
 					continue
 				}
@@ -57,6 +65,9 @@ func findAllMessages(rosPkgPaths []string) (map[string]string, error) {
 func findAllServices(rosPkgPaths []string) (map[string]string, error) {
 	srvs := make(map[string]string)
 	for _, p := range rosPkgPaths {
+		if p == "" {
+			continue
+		}
 		files, err := ioutil.ReadDir(p)
 		if err != nil {
 			return nil, err
@@ -68,8 +79,8 @@ func findAllServices(rosPkgPaths []string) (map[string]string, error) {
 			pkgPath := filepath.Join(p, f.Name())
 			if isRosPackage(pkgPath) {
 				pkgName := filepath.Base(pkgPath)
-				srvPath := filepath.Join(pkgPath, "srv")
-				srvPaths, err := filepath.Glob(srvPath + "/*.srv")
+				srvPath := filepath.Join(pkgPath, SrvDir)
+				srvPaths, err := filepath.Glob(srvPath + "/*" + ExtSrv)
 				if err != nil {
 					continue
 				}
@@ -89,6 +100,115 @@ type MsgContext struct {
 	msgPathMap  map[string]string
 	srvPathMap  map[string]string
 	msgRegistry map[string]*MsgSpec
+	// ROS packages whose definitions are read from msgs.Definitions.
+	embeddedPkgs map[string]bool
+	// Messages being loaded, used to detect circular dependencies.
+	loading []string
+}
+
+// Add definitions shipped with rosgo for ROS packages that are not found
+// in the ROS package paths. A package found there replaces the shipped one
+// as a whole, so its messages are generated and shipped ones it lacks are
+// not found.
+func (ctx *MsgContext) addEmbedded() error {
+	found := make(map[string]bool)
+	for _, pathMap := range []map[string]string{ctx.msgPathMap, ctx.srvPathMap} {
+		for fullname := range pathMap {
+			found[strings.Split(fullname, "/")[0]] = true
+		}
+	}
+	return fs.WalkDir(msgs.Definitions, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		pkgName := strings.Split(p, "/")[0]
+		if found[pkgName] {
+			return nil
+		}
+		ext := path.Ext(p)
+		fullname := pkgName + "/" + strings.TrimSuffix(path.Base(p), ext)
+		switch ext {
+		case ExtMsg:
+			ctx.msgPathMap[fullname] = p
+		case ExtSrv:
+			ctx.srvPathMap[fullname] = p
+		default:
+			return nil
+		}
+		ctx.embeddedPkgs[pkgName] = true
+		return nil
+	})
+}
+
+// Whether the definitions of the ROS package are the ones shipped with
+// rosgo. Go packages of them are under msgs.ImportPrefix.
+func (ctx *MsgContext) IsEmbedded(pkg string) bool {
+	return ctx.embeddedPkgs[pkg]
+}
+
+// EmbeddedConflicts returns the messages and services of ROS packages
+// shipped with rosgo whose definitions in the ROS package paths have other
+// MD5 sums than the shipped ones. Go types generated from them would not
+// match the packages under msgs.ImportPrefix, which rosgo itself uses.
+// Definitions which fail to load are skipped.
+func (ctx *MsgContext) EmbeddedConflicts() ([]string, error) {
+	shipped, err := NewMsgContext(nil)
+	if err != nil {
+		return nil, err
+	}
+	overridden := func(fullname string, shippedPaths map[string]string) bool {
+		pkg := strings.Split(fullname, "/")[0]
+		_, ok := shippedPaths[fullname]
+		return ok && !ctx.IsEmbedded(pkg)
+	}
+	var conflicts []string
+	for _, fullname := range sortedNames(ctx.msgPathMap, "") {
+		if !overridden(fullname, shipped.msgPathMap) {
+			continue
+		}
+		spec, err := ctx.LoadMsg(fullname)
+		if err != nil {
+			continue
+		}
+		shippedSpec, err := shipped.LoadMsg(fullname)
+		if err != nil {
+			return nil, err
+		}
+		if spec.MD5Sum != shippedSpec.MD5Sum {
+			conflicts = append(conflicts, fmt.Sprintf("%s: %s, shipped %s", fullname, spec.MD5Sum, shippedSpec.MD5Sum))
+		}
+	}
+	for _, fullname := range sortedNames(ctx.srvPathMap, "") {
+		if !overridden(fullname, shipped.srvPathMap) {
+			continue
+		}
+		spec, err := ctx.LoadSrv(fullname)
+		if err != nil {
+			continue
+		}
+		shippedSpec, err := shipped.LoadSrv(fullname)
+		if err != nil {
+			return nil, err
+		}
+		if spec.MD5Sum != shippedSpec.MD5Sum {
+			conflicts = append(conflicts, fmt.Sprintf("%s: %s, shipped %s", fullname, spec.MD5Sum, shippedSpec.MD5Sum))
+		}
+	}
+	return conflicts, nil
+}
+
+func (ctx *MsgContext) readDefinition(fullname string, filePath string) (string, error) {
+	var bytes []byte
+	var err error
+	if ctx.IsEmbedded(strings.Split(fullname, "/")[0]) {
+		bytes, err = fs.ReadFile(msgs.Definitions, filePath)
+	} else {
+		bytes, err = ioutil.ReadFile(filePath)
+	}
+	if err != nil {
+		return "", err
+	}
+	return string(bytes), nil
 }
 
 func NewMsgContext(rosPkgPaths []string) (*MsgContext, error) {
@@ -105,6 +225,10 @@ func NewMsgContext(rosPkgPaths []string) (*MsgContext, error) {
 	}
 	ctx.srvPathMap = srvs
 	ctx.msgRegistry = make(map[string]*MsgSpec)
+	ctx.embeddedPkgs = make(map[string]bool)
+	if err := ctx.addEmbedded(); err != nil {
+		return nil, err
+	}
 	return ctx, nil
 }
 
@@ -120,6 +244,7 @@ func (ctx *MsgContext) LoadMsgFromString(text string, fullname string) (*MsgSpec
 
 	var fields []Field
 	var constants []Constant
+	names := make(map[string]bool)
 	for lineno, origLine := range strings.Split(text, "\n") {
 		cleanLine := stripComment(origLine)
 		if len(cleanLine) == 0 {
@@ -128,20 +253,30 @@ func (ctx *MsgContext) LoadMsgFromString(text string, fullname string) (*MsgSpec
 		} else if strings.Contains(cleanLine, ConstChar) {
 			constant, e := loadConstantLine(origLine)
 			if e != nil {
-				return nil, NewSyntaxError(fullname, lineno, e.Error())
+				return nil, NewSyntaxError(fullname, lineno+1, e.Error())
+			}
+			if names[constant.Name] {
+				return nil, NewSyntaxError(fullname, lineno+1, fmt.Sprintf("Duplicate name %s", constant.Name))
 			}
+			names[constant.Name] = true
 			constants = append(constants, *constant)
 		} else {
 			field, e := loadFieldLine(origLine, packageName)
 			if e != nil {
-				return nil, NewSyntaxError(fullname, lineno, e.Error())
+				return nil, NewSyntaxError(fullname, lineno+1, e.Error())
 			}
+			if names[field.Name] {
+				return nil, NewSyntaxError(fullname, lineno+1, fmt.Sprintf("Duplicate name %s", field.Name))
+			}
+			names[field.Name] = true
 			fields = append(fields, *field)
 		}
 	}
 	spec, _ := NewMsgSpec(fields, constants, text, fullname, OptionPackageName(packageName), OptionShortName(shortName))
-	var err error
+	// Dependencies are loaded while computing the MD5 sum.
+	ctx.loading = append(ctx.loading, fullname)
 	md5sum, err := ctx.ComputeMsgMD5(spec)
+	ctx.loading = ctx.loading[:len(ctx.loading)-1]
 	if err != nil {
 		return nil, err
 	}
@@ -160,11 +295,21 @@ func (ctx *MsgContext) LoadMsgFromFile(filePath string, fullname string) (*MsgSp
 }
 
 func (ctx *MsgContext) LoadMsg(fullname string) (*MsgSpec, error) {
+	for i, name := range ctx.loading {
+		if name == fullname {
+			cycle := append(append([]string{}, ctx.loading[i:]...), fullname)
+			return nil, fmt.Errorf("Circular dependency: %s", strings.Join(cycle, " -> "))
+		}
+	}
 	if spec, ok := ctx.msgRegistry[fullname]; ok {
 		return spec, nil
 	} else {
 		if path, ok := ctx.msgPathMap[fullname]; ok {
-			spec, err := ctx.LoadMsgFromFile(path, fullname)
+			text, err := ctx.readDefinition(fullname, path)
+			if err != nil {
+				return nil, err
+			}
+			spec, err := ctx.LoadMsgFromString(text, fullname)
 			if err != nil {
 				return nil, err
 			} else {
@@ -223,7 +368,11 @@ func (ctx *MsgContext) LoadSrvFromFile(filePath string, fullname string) (*SrvSp
 
 func (ctx *MsgContext) LoadSrv(fullname string) (*SrvSpec, error) {
 	if path, ok := ctx.srvPathMap[fullname]; ok {
-		spec, err := ctx.LoadSrvFromFile(path, fullname)
+		text, err := ctx.readDefinition(fullname, path)
+		if err != nil {
+			return nil, err
+		}
+		spec, err := ctx.LoadSrvFromString(text, fullname)
 		if err != nil {
 			return nil, err
 		} else {
@@ -234,6 +383,153 @@ func (ctx *MsgContext) LoadSrv(fullname string) (*SrvSpec, error) {
 	}
 }
 
+// LoadActionFromString parses an action definition into the goal, result
+// and feedback messages as genaction does.
+func (ctx *MsgContext) LoadActionFromString(text string, fullname string) (*ActionSpec, error) {
+	_, shortName, err := packageResourceName(fullname)
+	if err != nil {
+		return nil, err
+	}
+	components := strings.Split(text, IoDelim)
+	if len(components) != 3 {
+		return nil, fmt.Errorf("Syntax error: an action requires two '---'")
+	}
+	goal, err := ctx.LoadMsgFromString(components[0], fullname+"Goal")
+	if err != nil {
+		return nil, err
+	}
+	result, err := ctx.LoadMsgFromString(components[1], fullname+"Result")
+	if err != nil {
+		return nil, err
+	}
+	feedback, err := ctx.LoadMsgFromString(components[2], fullname+"Feedback")
+	if err != nil {
+		return nil, err
+	}
+	return &ActionSpec{
+		ShortName: shortName,
+		FullName:  fullname,
+		Text:      text,
+		Goal:      goal,
+		Feedback:  feedback,
+		Result:    result,
+	}, nil
+}
+
+// Use the definition files in place of those found so far for the package.
+func (ctx *MsgContext) overridePackage(pkg string, msgPaths map[string]string, srvPaths map[string]string) {
+	for _, pathMap := range []map[string]string{ctx.msgPathMap, ctx.srvPathMap} {
+		for fullname := range pathMap {
+			if strings.HasPrefix(fullname, pkg+"/") {
+				delete(pathMap, fullname)
+			}
+		}
+	}
+	for fullname, p := range msgPaths {
+		ctx.msgPathMap[fullname] = p
+	}
+	for fullname, p := range srvPaths {
+		ctx.srvPathMap[fullname] = p
+	}
+	delete(ctx.embeddedPkgs, pkg)
+}
+
+func sortedNames(pathMap map[string]string, pkg string) []string {
+	var names []string
+	for fullname := range pathMap {
+		if pkg == "" || strings.HasPrefix(fullname, pkg+"/") {
+			names = append(names, fullname)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Names of the messages found in the ROS package paths. If pkg is not
+// empty, only messages of the package are listed.
+func (ctx *MsgContext) MsgNames(pkg string) []string {
+	return sortedNames(ctx.msgPathMap, pkg)
+}
+
+// Names of the services found in the ROS package paths. If pkg is not
+// empty, only services of the package are listed.
+func (ctx *MsgContext) SrvNames(pkg string) []string {
+	return sortedNames(ctx.srvPathMap, pkg)
+}
+
+// Direct dependencies of the message in order of appearance.
+func (ctx *MsgContext) getDepends(spec *MsgSpec) []string {
+	var depends []string
+	for _, f := range spec.Fields {
+		if f.Package == "" {
+			continue
+		}
+		fullname := f.Package + "/" + f.Type
+		found := false
+		for _, d := range depends {
+			if d == fullname {
+				found = true
+				break
+			}
+		}
+		if !found {
+			depends = append(depends, fullname)
+		}
+	}
+	return depends
+}
+
+// All dependencies of the message in depth-first order. A message may
+// appear more than once.
+func (ctx *MsgContext) getAllDepends(spec *MsgSpec) ([]string, error) {
+	var allDepends []string
+	for _, d := range ctx.getDepends(spec) {
+		subspec, err := ctx.LoadMsg(d)
+		if err != nil {
+			return nil, err
+		}
+		subDepends, err := ctx.getAllDepends(subspec)
+		if err != nil {
+			return nil, err
+		}
+		allDepends = append(allDepends, d)
+		allDepends = append(allDepends, subDepends...)
+	}
+	return allDepends, nil
+}
+
+// Compute the full message definition sent as `message_definition` in
+// connection headers. It is the text of the message followed by the text
+// of each dependency as genmsg does.
+func (ctx *MsgContext) ComputeFullText(spec *MsgSpec) (string, error) {
+	const sep = "================================================================================\n"
+	var buf bytes.Buffer
+	buf.WriteString(spec.Text)
+	buf.WriteString("\n")
+	allDepends, err := ctx.getAllDepends(spec)
+	if err != nil {
+		return "", err
+	}
+	written := make(map[string]bool)
+	for _, d := range allDepends {
+		if written[d] {
+			continue
+		}
+		written[d] = true
+		subspec, err := ctx.LoadMsg(d)
+		if err != nil {
+			return "", err
+		}
+		buf.WriteString(sep)
+		buf.WriteString(fmt.Sprintf("MSG: %s\n", d))
+		buf.WriteString(subspec.Text)
+		buf.WriteString("\n")
+	}
+	// Remove the trailing newline added by the concatenation.
+	text := buf.String()
+	return text[:len(text)-1], nil
+}
+
 func (ctx *MsgContext) ComputeMD5Text(spec *MsgSpec) (string, error) {
 	var buf bytes.Buffer
 	for _, c := range spec.Constants {
@@ -241,15 +537,15 @@ func (ctx *MsgContext) ComputeMD5Text(spec *MsgSpec) (string, error) {
 	}
 	for _, f := range spec.Fields {
 		if f.Package == "" {
-			buf.WriteString(fmt.Sprintf("%s %s\n", f.Type, f.Name))
+			buf.WriteString(fmt.Sprintf("%s %s\n", builtinMD5Type(f), f.Name))
 		} else {
 			subspec, err := ctx.LoadMsg(f.Package + "/" + f.Type)
 			if err != nil {
-				return "", nil
+				return "", err
 			}
 			submd5, err := ctx.ComputeMsgMD5(subspec)
 			if err != nil {
-				return "", nil
+				return "", err
 			}
 			buf.WriteString(fmt.Sprintf("%s %s\n", submd5, f.Name))
 		}
@@ -257,6 +553,55 @@ func (ctx *MsgContext) ComputeMD5Text(spec *MsgSpec) (string, error) {
 	return strings.Trim(buf.String(), "\n"), nil
 }
 
+// builtinMD5Type returns the type of a builtin field as genmsg writes it in
+// the MD5 text, with the array suffix. Unlike message fields, whose types
+// are replaced by their MD5 sums, builtin arrays keep "[]" or "[N]".
+func builtinMD5Type(f Field) string {
+	if f.IsArray && f.ArrayLen < 0 {
+		return f.Type + "[]"
+	} else if f.IsArray {
+		return fmt.Sprintf("%s[%d]", f.Type, f.ArrayLen)
+	}
+	return f.Type
+}
+
+// MinWireSize returns the least number of bytes which the message takes on
+// the wire, with empty strings and variable-length arrays.
+func (ctx *MsgContext) MinWireSize(spec *MsgSpec) (int, error) {
+	size := 0
+	for _, f := range spec.Fields {
+		if f.IsArray && f.ArrayLen < 0 {
+			size += 4
+			continue
+		}
+		n, err := ctx.MinElemSize(f)
+		if err != nil {
+			return 0, err
+		}
+		if f.IsArray {
+			n *= f.ArrayLen
+		}
+		size += n
+	}
+	return size, nil
+}
+
+// MinElemSize returns the least number of bytes which a value of the type
+// of the field, or an element of the array, takes on the wire.
+func (ctx *MsgContext) MinElemSize(f Field) (int, error) {
+	if f.Package == "" {
+		if f.Type == "string" {
+			return 4, nil
+		}
+		return elemSize(f), nil
+	}
+	spec, err := ctx.LoadMsg(f.Package + "/" + f.Type)
+	if err != nil {
+		return 0, err
+	}
+	return ctx.MinWireSize(spec)
+}
+
 func (ctx *MsgContext) ComputeMsgMD5(spec *MsgSpec) (string, error) {
 	md5text, err := ctx.ComputeMD5Text(spec)
 	if err != nil {
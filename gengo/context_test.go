@@ -0,0 +1,156 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestComputeFullText(t *testing.T) {
+	ctx, err := NewMsgContext([]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ctx.LoadMsgFromString("uint32 seq\ntime stamp\nstring frame_id\n", "std_msgs/Header"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ctx.LoadMsgFromString("float64 x\nfloat64 y\nfloat64 z\n", "geometry_msgs/Point"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ctx.LoadMsgFromString("Header header\nPoint point\n", "geometry_msgs/PointStamped"); err != nil {
+		t.Fatal(err)
+	}
+	spec, err := ctx.LoadMsgFromString("PointStamped[] points\nHeader header\n", "geometry_msgs/PointStampedArray")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const sep = "================================================================================\n"
+	expected := "PointStamped[] points\nHeader header\n\n" +
+		sep + "MSG: geometry_msgs/PointStamped\nHeader header\nPoint point\n\n" +
+		sep + "MSG: std_msgs/Header\nuint32 seq\ntime stamp\nstring frame_id\n\n" +
+		sep + "MSG: geometry_msgs/Point\nfloat64 x\nfloat64 y\nfloat64 z\n"
+	fullText, err := ctx.ComputeFullText(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fullText != expected {
+		t.Errorf("expected:\n%s\nbut:\n%s", expected, fullText)
+	}
+}
+
+func TestMinWireSize(t *testing.T) {
+	ctx, err := NewMsgContext([]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tests = []struct {
+		fullname string
+		text     string
+		size     int
+	}{
+		{"std_msgs/Header", "uint32 seq\ntime stamp\nstring frame_id\n", 16},
+		{"std_msgs/Empty", "", 0},
+		{"test_msgs/Fixed", "std_msgs/Header[2] headers\nint16[3] values\nstd_msgs/Empty e\n", 38},
+		{"test_msgs/Nested", "Fixed fixed\nFixed[] variable\nstring[2] names\n", 50},
+	}
+	for _, test := range tests {
+		spec, err := ctx.LoadMsgFromString(test.text, test.fullname)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx.Register(test.fullname, spec)
+		size, err := ctx.MinWireSize(spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if size != test.size {
+			t.Errorf("%s: expected %d but %d", test.fullname, test.size, size)
+		}
+	}
+}
+
+func TestComputeMD5TextArrays(t *testing.T) {
+	ctx, err := NewMsgContext([]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ctx.LoadMsgFromString("float64 x\nfloat64 y\nfloat64 z\n", "geometry_msgs/Point"); err != nil {
+		t.Fatal(err)
+	}
+	spec, err := ctx.LoadMsgFromString("uint8 KIND=1\nuint8[] data\nfloat64[9] covariance\nstring name\ngeometry_msgs/Point[] points\n", "test_msgs/Arrays")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Builtin arrays keep their suffixes, message arrays do not.
+	expected := "uint8 KIND=1\nuint8[] data\nfloat64[9] covariance\nstring name\n4a842b65f413084dc2b10fb484ea7f17 points"
+	text, err := ctx.ComputeMD5Text(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text != expected {
+		t.Errorf("expected:\n%s\nbut:\n%s", expected, text)
+	}
+}
+
+func TestComputeMsgMD5(t *testing.T) {
+	ctx, err := NewMsgContext([]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tests = []struct {
+		fullname string
+		text     string
+		md5sum   string
+	}{
+		{"std_msgs/Header", "uint32 seq\ntime stamp\nstring frame_id\n", "2176decaecbce78abc3b96ef049fabed"},
+		{"geometry_msgs/Point", "float64 x\nfloat64 y\nfloat64 z\n", "4a842b65f413084dc2b10fb484ea7f17"},
+		{"geometry_msgs/PointStamped", "Header header\nPoint point\n", "c63aecb41bfdfd6b7e1fac37c7cbe7bf"},
+		{"std_msgs/MultiArrayDimension", "string label\nuint32 size\nuint32 stride\n", "4cd0c83a8683deae40ecdac60e53bfa8"},
+		{"std_msgs/MultiArrayLayout", "MultiArrayDimension[] dim\nuint32 data_offset\n", "0fed2a11c13e11c5571b4e2a995a91a3"},
+		{"std_msgs/UInt8MultiArray", "std_msgs/MultiArrayLayout layout\nuint8[] data\n", "82373f1612381bb6ee473b5cd6f5d89c"},
+	}
+	for _, test := range tests {
+		spec, err := ctx.LoadMsgFromString(test.text, test.fullname)
+		if err != nil {
+			t.Errorf("%s: %v", test.fullname, err)
+			continue
+		}
+		if spec.MD5Sum != test.md5sum {
+			t.Errorf("%s: expected %s but %s", test.fullname, test.md5sum, spec.MD5Sum)
+		}
+	}
+}
+
+func TestEmbeddedConflicts(t *testing.T) {
+	root := t.TempDir()
+	writeRosPackage(t, root, "std_msgs", map[string]string{
+		"msg/String.msg": "string data\n",
+		"msg/Header.msg": "uint32 seq\ntime stamp\n",
+	})
+	writeRosPackage(t, root, "std_srvs", map[string]string{
+		"srv/SetBool.srv": "bool data\n---\nbool success\n",
+	})
+	writeRosPackage(t, root, "foo_msgs", map[string]string{
+		"msg/Header.msg": "uint32 seq\n",
+	})
+	ctx, err := NewMsgContext([]string{root})
+	if err != nil {
+		t.Fatal(err)
+	}
+	conflicts, err := ctx.EmbeddedConflicts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(conflicts) != 2 || !strings.HasPrefix(conflicts[0], "std_msgs/Header: ") || !strings.HasPrefix(conflicts[1], "std_srvs/SetBool: ") {
+		t.Errorf("unexpected conflicts %v", conflicts)
+	}
+
+	// Shipped definitions do not conflict with themselves.
+	ctx, err = NewMsgContext(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if conflicts, err := ctx.EmbeddedConflicts(); err != nil || len(conflicts) != 0 {
+		t.Errorf("unexpected conflicts %v: %v", conflicts, err)
+	}
+}
@@ -2,7 +2,14 @@ package main
 
 import (
 	"bytes"
+	"fmt"
+	"go/format"
+	"math"
+	"strconv"
+	"strings"
 	"text/template"
+
+	"github.com/akio/rosgo/msgs"
 )
 
 var msgTemplate = `
@@ -12,6 +19,12 @@ import (
     "bytes"
 {{- if .BinaryRequired }}
     "encoding/binary"
+{{- end }}
+{{- if .IoRequired }}
+    "io"
+{{- end }}
+{{- if .MathRequired }}
+    "math"
 {{- end }}
     "github.com/akio/rosgo/ros"
 {{- range .Imports }}
@@ -19,18 +32,40 @@ import (
 {{- end }}
 )
 
-{{- if gt (len .Constants) 0 }}
+{{- if .Consts }}
 const (
-{{- range .Constants }}
-	{{- if eq .Type "string" }}
-    {{ .GoName }} {{ .Type }} = "{{ .Value }}"
-	{{- else }}
-	{{ .GoName }} {{ .Type }} = {{ .Value }}
-	{{- end }}
+{{- range .Consts }}
+	{{ $.ShortName }}_{{ .Name }} {{ constType . }} = {{ constValue . }}
+{{- end }}
+)
+{{- end }}
+{{- if .Vars }}
+var (
+{{- range .Vars }}
+	{{ $.ShortName }}_{{ .Name }} {{ constType . }} = {{ constValue . }}
 {{- end }}
 )
 {{- end }}
+{{- if .LegacyConsts }}
 
+// Names of the constants before they were scoped by the message.
+const (
+{{- range .LegacyConsts }}
+	// Deprecated: Use {{ $.ShortName }}_{{ .Name }}.
+	{{ .GoName }} = {{ $.ShortName }}_{{ .Name }}
+{{- end }}
+)
+{{- end }}
+{{- if .LegacyVars }}
+
+// Names of the variables before they were scoped by the message.
+var (
+{{- range .LegacyVars }}
+	// Deprecated: Use {{ $.ShortName }}_{{ .Name }}.
+	{{ .GoName }} = {{ $.ShortName }}_{{ .Name }}
+{{- end }}
+)
+{{- end }}
 
 type _Msg{{ .ShortName }} struct {
     text string
@@ -70,12 +105,16 @@ func (t *_Msg{{ .ShortName }}) NewMessage() ros.Message {
 
 var (
     Msg{{ .ShortName }} = &_Msg{{ .ShortName }} {
-        ` + "`" + `{{ .Text }}` + "`" + `,
+        {{ rawString .FullText }},
         "{{ .FullName }}",
         "{{ .MD5Sum }}",
     }
 )
 
+func init() {
+    ros.RegisterMessageType(Msg{{ .ShortName }})
+}
+
 type {{ .ShortName }} struct {
 {{- range .Fields }}
 {{-     if .IsArray }}
@@ -94,148 +133,256 @@ func (m *{{ .ShortName }}) Type() ros.MessageType {
 	return Msg{{ .ShortName }}
 }
 
+func (m *{{ .ShortName }}) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *{{ .ShortName }}) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *{{ .ShortName }}) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *{{ .ShortName }}) Clone() *{{ .ShortName }} {
+	c := *m
+{{- range .Fields }}
+{{-     if and .IsArray (lt .ArrayLen 0) }}
+	if m.{{ .GoName }} != nil {
+		c.{{ .GoName }} = make([]{{ .GoType }}, len(m.{{ .GoName }}))
+{{-         if .IsBuiltin }}
+		copy(c.{{ .GoName }}, m.{{ .GoName }})
+{{-         else }}
+		for i := range m.{{ .GoName }} {
+			c.{{ .GoName }}[i] = *m.{{ .GoName }}[i].Clone()
+		}
+{{-         end }}
+	}
+{{-     else if .IsBuiltin }}
+{{-     else if .IsArray }}
+	for i := range m.{{ .GoName }} {
+		c.{{ .GoName }}[i] = *m.{{ .GoName }}[i].Clone()
+	}
+{{-     else }}
+	c.{{ .GoName }} = *m.{{ .GoName }}.Clone()
+{{-     end }}
+{{- end }}
+	return &c
+}
+
+func (m *{{ .ShortName }}) Equal(other *{{ .ShortName }}) bool {
+{{- range .Fields }}
+{{-     if and .IsArray (lt .ArrayLen 0) (isOctet .) }}
+	if !bytes.Equal(m.{{ .GoName }}, other.{{ .GoName }}) {
+		return false
+	}
+{{-     else if and .IsArray (lt .ArrayLen 0) }}
+	if len(m.{{ .GoName }}) != len(other.{{ .GoName }}) {
+		return false
+	}
+	for i := range m.{{ .GoName }} {
+{{-         if .IsBuiltin }}
+		if m.{{ .GoName }}[i] != other.{{ .GoName }}[i] {
+{{-         else }}
+		if !m.{{ .GoName }}[i].Equal(&other.{{ .GoName }}[i]) {
+{{-         end }}
+			return false
+		}
+	}
+{{-     else if .IsBuiltin }}
+	if m.{{ .GoName }} != other.{{ .GoName }} {
+		return false
+	}
+{{-     else if .IsArray }}
+	for i := range m.{{ .GoName }} {
+		if !m.{{ .GoName }}[i].Equal(&other.{{ .GoName }}[i]) {
+			return false
+		}
+	}
+{{-     else }}
+	if !m.{{ .GoName }}.Equal(&other.{{ .GoName }}) {
+		return false
+	}
+{{-     end }}
+{{- end }}
+	return true
+}
+
+func (m *{{ .ShortName }}) SerializedLength() int {
+    length := 0
+{{- range .Fields }}
+{{-     if .IsArray }}
+{{-         if lt .ArrayLen 0 }}
+    length += 4
+{{-         end }}
+{{-         if gt (elemSize .) 0 }}
+    length += {{ elemSize . }} * len(m.{{ .GoName }})
+{{-         else if eq .Type "string" }}
+    for _, e := range m.{{ .GoName }} {
+        length += 4 + len(e)
+    }
+{{-         else }}
+    for i := range m.{{ .GoName }} {
+        length += m.{{ .GoName }}[i].SerializedLength()
+    }
+{{-         end }}
+{{-     else if gt (elemSize .) 0 }}
+    length += {{ elemSize . }}
+{{-     else if eq .Type "string" }}
+    length += 4 + len(m.{{ .GoName }})
+{{-     else }}
+    length += m.{{ .GoName }}.SerializedLength()
+{{-     end }}
+{{- end }}
+    return length
+}
+
 func (m *{{ .ShortName }}) Serialize(buf *bytes.Buffer) error {
-    var err error = nil
+{{- if .SerializeScratch }}
+    var b [8]byte
+{{- end }}
 {{- range .Fields }}
 {{-     if .IsArray }}
-{{-        if lt .ArrayLen 0 }}
-    binary.Write(buf, binary.LittleEndian, uint32(len(m.{{ .GoName }})))
-{{-        end }}
+{{-         if lt .ArrayLen 0 }}
+    binary.LittleEndian.PutUint32(b[:4], uint32(len(m.{{ .GoName }})))
+    buf.Write(b[:4])
+{{-         end }}
+{{-         if isOctet . }}
+    buf.Write(m.{{ .GoName }}[:])
+{{-         else if gt (elemSize .) 0 }}
+    {
+        // Encode elements in place into the spare capacity of buf.
+        n := {{ elemSize . }} * len(m.{{ .GoName }})
+        buf.Grow(n)
+        data := buf.Bytes()
+        data = data[len(data) : len(data)+n]
+        for i, e := range m.{{ .GoName }} {
+            {{ encodeValue . (printf "data[%d*i:]" (elemSize .)) "e" }}
+        }
+        buf.Write(data)
+    }
+{{-         else if eq .Type "string" }}
     for _, e := range m.{{ .GoName }} {
-{{-         if .IsBuiltin }}
-{{-             if eq .Type "string" }}
-        binary.Write(buf, binary.LittleEndian, uint32(len([]byte(e))))
-        buf.Write([]byte(e))
-{{-            else }}
-{{-                if or (eq .Type "time") (eq .Type "duration") }}
-        binary.Write(buf, binary.LittleEndian, e.Sec)
-        binary.Write(buf, binary.LittleEndian, e.NSec)
-{{-                else }}
-        binary.Write(buf, binary.LittleEndian, e)
-{{-                end }}
-{{-             end }}
+        binary.LittleEndian.PutUint32(b[:4], uint32(len(e)))
+        buf.Write(b[:4])
+        buf.WriteString(e)
+    }
 {{-         else }}
-        if err = e.Serialize(buf); err != nil {
+    for i := range m.{{ .GoName }} {
+        if err := m.{{ .GoName }}[i].Serialize(buf); err != nil {
             return err
         }
+    }
 {{-         end }}
+{{-     else if isOctet . }}
+    buf.WriteByte(m.{{ .GoName }})
+{{-     else if eq .Type "int8" }}
+    buf.WriteByte(byte(m.{{ .GoName }}))
+{{-     else if eq .Type "bool" }}
+    if m.{{ .GoName }} {
+        buf.WriteByte(1)
+    } else {
+        buf.WriteByte(0)
     }
+{{-     else if gt (elemSize .) 0 }}
+    {{ encodeValue . "b[:]" (printf "m.%s" .GoName) }}
+    buf.Write(b[:{{ elemSize . }}])
+{{-     else if eq .Type "string" }}
+    binary.LittleEndian.PutUint32(b[:4], uint32(len(m.{{ .GoName }})))
+    buf.Write(b[:4])
+    buf.WriteString(m.{{ .GoName }})
 {{-     else }}
-{{-         if .IsBuiltin }}
-{{-             if eq .Type "string" }}
-    binary.Write(buf, binary.LittleEndian, uint32(len([]byte(m.{{ .GoName }}))))
-    buf.Write([]byte(m.{{ .GoName }}))
-{{-             else }}
-{{-                 if or (eq .Type "time") (eq .Type "duration") }}
-    binary.Write(buf, binary.LittleEndian, m.{{ .GoName }}.Sec)
-    binary.Write(buf, binary.LittleEndian, m.{{ .GoName }}.NSec)
-{{-                 else }}
-    binary.Write(buf, binary.LittleEndian, m.{{ .GoName }})
-{{-                 end }}
-{{-             end }}
-{{-         else }}
-    if err = m.{{ .GoName }}.Serialize(buf); err != nil {
+    if err := m.{{ .GoName }}.Serialize(buf); err != nil {
         return err
     }
-{{-         end }}
 {{-     end }}
 {{- end }}
-    return err
+    return nil
 }
 
-
 func (m *{{ .ShortName }}) Deserialize(buf *bytes.Reader) error {
-    var err error = nil
+{{- if .DeserializeScratch }}
+    var b [8]byte
+{{- end }}
 {{- range .Fields }}
-{{-    if .IsArray }}
+{{-     if .IsArray }}
     {
-
-{{-        if lt .ArrayLen 0 }}
-        var size uint32
-        if err = binary.Read(buf, binary.LittleEndian, &size); err != nil {
+{{-         if lt .ArrayLen 0 }}
+        if _, err := io.ReadFull(buf, b[:4]); err != nil {
             return err
         }
-        m.{{ .GoName }} = make([]{{ .GoType }}, int(size))
-        for i := 0; i < int(size); i++ {
-{{-        else }}
-        for i :=0; i < {{ .ArrayLen }}; i++ {
-{{-        end }}
-{{-          if .IsBuiltin }}
-{{-              if eq .Type "string" }}
-            {
-                var size uint32
-                if err = binary.Read(buf, binary.LittleEndian, &size); err != nil {
-                    return err
-                }
-                data := make([]byte, int(size))
-                if err = binary.Read(buf, binary.LittleEndian, data); err != nil {
-                    return err
-                }
-                m.{{ .GoName }})[i] = string(data)
+        size := int(binary.LittleEndian.Uint32(b[:4]))
+{{-             if gt (index $.ArrayMinSizes .GoName) 0 }}
+        if uint64(size)*{{ index $.ArrayMinSizes .GoName }} > uint64(buf.Len()) {
+            return io.ErrUnexpectedEOF
+        }
+{{-             end }}
+        m.{{ .GoName }} = make([]{{ .GoType }}, size)
+{{-         end }}
+{{-         if isOctet . }}
+        if _, err := io.ReadFull(buf, m.{{ .GoName }}[:]); err != nil {
+            return err
+        }
+{{-         else if gt (elemSize .) 0 }}
+        data := make([]byte, {{ elemSize . }}*len(m.{{ .GoName }}))
+        if _, err := io.ReadFull(buf, data); err != nil {
+            return err
+        }
+        for i := range m.{{ .GoName }} {
+            {{ decodeValue . (printf "m.%s[i]" .GoName) (printf "data[%d*i:]" (elemSize .)) }}
+        }
+{{-         else if eq .Type "string" }}
+        for i := range m.{{ .GoName }} {
+            if _, err := io.ReadFull(buf, b[:4]); err != nil {
+                return err
             }
-{{-              else }}
-{{- 					if or (eq .Type "time") (eq .Type "duration") }}
-            {
-                if err = binary.Read(buf, binary.LittleEndian, &m.{{ .GoName }}[i].Sec); err != nil {
-                    return err
-                }
-
-                if err = binary.Read(buf, binary.LittleEndian, &m.{{ .GoName }}[i].NSec); err != nil {
-                    return err
-                }
+            n := int(binary.LittleEndian.Uint32(b[:4]))
+            if int64(n) > int64(buf.Len()) {
+                return io.ErrUnexpectedEOF
             }
-{{-                  else }}
-            if err = binary.Read(buf, binary.LittleEndian, &m.{{ .GoName }}[i]); err != nil {
+            data := make([]byte, n)
+            if _, err := io.ReadFull(buf, data); err != nil {
                 return err
             }
-{{-                  end }}
-{{-              end }}
-{{-          else }}
-            if err = m.{{ .GoName }}[i].Deserialize(buf); err != nil {
+            m.{{ .GoName }}[i] = string(data)
+        }
+{{-         else }}
+        for i := range m.{{ .GoName }} {
+            if err := m.{{ .GoName }}[i].Deserialize(buf); err != nil {
                 return err
             }
-{{-      	end }}
         }
+{{-         end }}
     }
-{{-    else }}
-{{-        if .IsBuiltin }}
-{{-            if eq .Type "string" }}
-    {
-        var size uint32
-        if err = binary.Read(buf, binary.LittleEndian, &size); err != nil {
-            return err
-        }
-        data := make([]byte, int(size))
-        if err = binary.Read(buf, binary.LittleEndian, data); err != nil {
-            return err
-        }
-        m.{{ .GoName }} = string(data)
+{{-     else if gt (elemSize .) 0 }}
+    if _, err := io.ReadFull(buf, b[:{{ elemSize . }}]); err != nil {
+        return err
     }
-{{-            else }}
-{{-            		if or (eq .Type "time") (eq .Type "duration") }}
+    {{ decodeValue . (printf "m.%s" .GoName) "b[:]" }}
+{{-     else if eq .Type "string" }}
     {
-        if err = binary.Read(buf, binary.LittleEndian, &m.{{ .GoName }}.Sec); err != nil {
+        if _, err := io.ReadFull(buf, b[:4]); err != nil {
             return err
         }
-
-        if err = binary.Read(buf, binary.LittleEndian, &m.{{ .GoName }}.NSec); err != nil {
+        n := int(binary.LittleEndian.Uint32(b[:4]))
+        if int64(n) > int64(buf.Len()) {
+            return io.ErrUnexpectedEOF
+        }
+        data := make([]byte, n)
+        if _, err := io.ReadFull(buf, data); err != nil {
             return err
         }
+        m.{{ .GoName }} = string(data)
     }
-{{-            		else }}
-    if err = binary.Read(buf, binary.LittleEndian, &m.{{ .GoName }}); err != nil {
-        return err
-    }
-{{-         			end }}
-{{-            end }}
-{{-        else }}
-    if err = m.{{ .GoName }}.Deserialize(buf); err != nil {
+{{-     else }}
+    if err := m.{{ .GoName }}.Deserialize(buf); err != nil {
         return err
     }
-{{-    	  end }}
-{{-    end }}
+{{-     end }}
 {{- end }}
-    return err
+    return nil
 }
 `
 
@@ -268,60 +415,385 @@ var (
     Srv{{ .ShortName }} = &_Srv{{ .ShortName }} {
         "{{ .FullName }}",
         "{{ .MD5Sum }}",
-        ` + "`" + `{{ .Text }}` + "`" + `,
+        {{ rawString .Text }},
         Msg{{ .ShortName }}Request,
         Msg{{ .ShortName }}Response,
     }
 )
 
+func init() {
+    ros.RegisterServiceType(Srv{{ .ShortName }})
+}
+
 
 type {{ .ShortName }} struct {
     Request {{ .ShortName }}Request
     Response {{ .ShortName }}Response
 }
 
+func (s *{{ .ShortName }}) Type() ros.ServiceType { return Srv{{ .ShortName }} }
 func (s *{{ .ShortName }}) ReqMessage() ros.Message { return &s.Request }
 func (s *{{ .ShortName }}) ResMessage() ros.Message { return &s.Response }
 `
 
 type MsgGen struct {
 	MsgSpec
+	FullText       string
 	BinaryRequired bool
+	IoRequired     bool
+	MathRequired   bool
+	ImportPrefix   string
 	Imports        []string
+	// Whether Serialize/Deserialize need a scratch buffer for scalars.
+	SerializeScratch   bool
+	DeserializeScratch bool
+	// Lower bounds of the wire sizes of elements of variable-length
+	// arrays by GoName, to reject lengths which cannot fit in the remaining
+	// bytes before allocating. Elements of empty messages take no bytes, so
+	// any length fits.
+	ArrayMinSizes map[string]int
+	// Constants split by whether Go constants can hold their values. NaN
+	// and infinities cannot, so they are declared as variables.
+	Consts []Constant
+	Vars   []Constant
+	// Constants which older versions of gengo declared unscoped by their
+	// GoNames. The names are kept as deprecated aliases.
+	LegacyConsts []Constant
+	LegacyVars   []Constant
+}
+
+// Size in bytes of a field element on the wire, or 0 if it varies.
+func elemSize(f Field) int {
+	switch f.Type {
+	case "bool", "int8", "uint8", "byte", "char":
+		return 1
+	case "int16", "uint16":
+		return 2
+	case "int32", "uint32", "float32":
+		return 4
+	case "int64", "uint64", "float64", "time", "duration":
+		return 8
+	}
+	return 0
 }
 
-func (gen *MsgGen) analyzeImports() {
+// Whether the field is stored as uint8 and can be copied as is.
+func isOctet(f Field) bool {
+	return f.GoType == "uint8"
+}
+
+// Shift a slice expression like `b[:]` or `data[8*i:]` by offset bytes.
+func sliceFrom(slice string, offset int) string {
+	i := strings.LastIndex(slice, "[")
+	start := slice[i+1 : len(slice)-2]
+	if start == "" {
+		return fmt.Sprintf("%s[%d:]", slice[:i], offset)
+	}
+	return fmt.Sprintf("%s[%s+%d:]", slice[:i], start, offset)
+}
+
+// Convert expr to goType unless it already is.
+func convert(goType string, exprType string, expr string) string {
+	if goType == exprType {
+		return expr
+	}
+	return fmt.Sprintf("%s(%s)", goType, expr)
+}
+
+// Code storing `value` into the byte slice `dst` in little endian.
+func encodeValue(f Field, dst string, value string) string {
+	switch f.Type {
+	case "bool":
+		return fmt.Sprintf("if %s { %s[0] = 1 } else { %s[0] = 0 }", value, dst, dst)
+	case "int8", "uint8", "byte", "char":
+		return fmt.Sprintf("%s[0] = %s", dst, convert("uint8", f.GoType, value))
+	case "int16", "uint16":
+		return fmt.Sprintf("binary.LittleEndian.PutUint16(%s, %s)", dst, convert("uint16", f.GoType, value))
+	case "int32", "uint32":
+		return fmt.Sprintf("binary.LittleEndian.PutUint32(%s, %s)", dst, convert("uint32", f.GoType, value))
+	case "int64", "uint64":
+		return fmt.Sprintf("binary.LittleEndian.PutUint64(%s, %s)", dst, convert("uint64", f.GoType, value))
+	case "float32":
+		return fmt.Sprintf("binary.LittleEndian.PutUint32(%s, math.Float32bits(%s))", dst, value)
+	case "float64":
+		return fmt.Sprintf("binary.LittleEndian.PutUint64(%s, math.Float64bits(%s))", dst, value)
+	case "time", "duration":
+		return fmt.Sprintf("binary.LittleEndian.PutUint32(%s, %s.Sec)\nbinary.LittleEndian.PutUint32(%s, %s.NSec)",
+			dst, value, sliceFrom(dst, 4), value)
+	}
+	return ""
+}
+
+// Code loading `dst` from the byte slice `src` in little endian.
+func decodeValue(f Field, dst string, src string) string {
+	switch f.Type {
+	case "bool":
+		return fmt.Sprintf("%s = %s[0] != 0", dst, src)
+	case "int8", "uint8", "byte", "char":
+		return fmt.Sprintf("%s = %s", dst, convert(f.GoType, "uint8", src+"[0]"))
+	case "int16", "uint16":
+		return fmt.Sprintf("%s = %s", dst, convert(f.GoType, "uint16", "binary.LittleEndian.Uint16("+src+")"))
+	case "int32", "uint32":
+		return fmt.Sprintf("%s = %s", dst, convert(f.GoType, "uint32", "binary.LittleEndian.Uint32("+src+")"))
+	case "int64", "uint64":
+		return fmt.Sprintf("%s = %s", dst, convert(f.GoType, "uint64", "binary.LittleEndian.Uint64("+src+")"))
+	case "float32":
+		return fmt.Sprintf("%s = math.Float32frombits(binary.LittleEndian.Uint32(%s))", dst, src)
+	case "float64":
+		return fmt.Sprintf("%s = math.Float64frombits(binary.LittleEndian.Uint64(%s))", dst, src)
+	case "time", "duration":
+		return fmt.Sprintf("%s.Sec = binary.LittleEndian.Uint32(%s)\n%s.NSec = binary.LittleEndian.Uint32(%s)",
+			dst, src, dst, sliceFrom(src, 4))
+	}
+	return ""
+}
+
+// Go type of the constant. Byte constants were declared as byte before
+// they were scoped, so they keep the type.
+func constType(c Constant) string {
+	if c.Type == "byte" {
+		return "byte"
+	}
+	return ToGoType("", c.Type)
+}
+
+// Value of a float constant.
+func floatValue(c Constant) (float64, bool) {
+	switch v := c.Value.(type) {
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+// Whether the value of the constant is NaN or an infinity.
+func isVariable(c Constant) bool {
+	value, ok := floatValue(c)
+	return ok && (math.IsNaN(value) || math.IsInf(value, 0))
+}
+
+// Go expression of the constant value.
+func constValue(c Constant) string {
+	switch v := c.Value.(type) {
+	case string:
+		return strconv.Quote(v)
+	case int8:
+		if c.Type == "byte" {
+			// Bytes are parsed as int8 like genmsg, so negative ones wrap.
+			return fmt.Sprint(uint8(v))
+		}
+	case float32, float64:
+		if isVariable(c) {
+			var expr string
+			switch value, _ := floatValue(c); {
+			case math.IsNaN(value):
+				expr = "math.NaN()"
+			case value > 0:
+				expr = "math.Inf(1)"
+			default:
+				expr = "math.Inf(-1)"
+			}
+			if c.Type == "float32" {
+				return "float32(" + expr + ")"
+			}
+			return expr
+		}
+	}
+	return fmt.Sprint(c.Value)
+}
+
+// GoNames of the constants of the ROS package which can be declared
+// unscoped as older versions of gengo did. Names shared by several
+// messages, or with types of the package, are left out, since such
+// packages did not compile.
+func legacyConstantNames(context *MsgContext, spec *MsgSpec) (map[string]bool, error) {
+	var specs []*MsgSpec
+	listed := false
+	for _, name := range context.MsgNames(spec.Package) {
+		msgSpec, err := context.LoadMsg(name)
+		if err != nil {
+			return nil, err
+		}
+		specs = append(specs, msgSpec)
+		listed = listed || name == spec.FullName
+	}
+	for _, name := range context.SrvNames(spec.Package) {
+		srvSpec, err := context.LoadSrv(name)
+		if err != nil {
+			return nil, err
+		}
+		specs = append(specs, srvSpec.Request, srvSpec.Response)
+		listed = listed || srvSpec.Request.FullName == spec.FullName || srvSpec.Response.FullName == spec.FullName
+	}
+	if !listed {
+		specs = append(specs, spec)
+	}
+	count := make(map[string]int)
+	for _, s := range specs {
+		for _, name := range []string{s.ShortName, "Msg" + s.ShortName, "Srv" + s.ShortName} {
+			count[name]++
+		}
+		for _, c := range s.Constants {
+			count[c.GoName]++
+		}
+	}
+	names := make(map[string]bool)
+	for _, c := range spec.Constants {
+		if count[c.GoName] == 1 {
+			names[c.GoName] = true
+		}
+	}
+	return names, nil
+}
+
+// Quote text as a Go raw string literal.
+func rawString(text string) string {
+	return "`" + strings.Replace(text, "`", "` + \"`\" + `", -1) + "`"
+}
+
+var templateFuncs = template.FuncMap{
+	"rawString":   rawString,
+	"constType":   constType,
+	"constValue":  constValue,
+	"elemSize":    elemSize,
+	"isOctet":     isOctet,
+	"encodeValue": encodeValue,
+	"decodeValue": decodeValue,
+}
+
+func (gen *MsgGen) analyzeImports(context *MsgContext) {
 	for _, field := range gen.Fields {
-		if len(field.Package) == 0 {
+		if field.IsArray && field.ArrayLen < 0 {
 			gen.BinaryRequired = true
-		} else {
+			gen.IoRequired = true
+			gen.SerializeScratch = true
+			gen.DeserializeScratch = true
+		}
+		if len(field.Package) == 0 {
+			if elemSize(field) != 1 {
+				gen.BinaryRequired = true
+			}
+			if field.Type == "float32" || field.Type == "float64" {
+				gen.MathRequired = true
+			}
+			gen.IoRequired = true
+			if !field.IsArray {
+				gen.DeserializeScratch = true
+				if elemSize(field) != 1 {
+					gen.SerializeScratch = true
+				}
+			} else if field.Type == "string" {
+				gen.SerializeScratch = true
+				gen.DeserializeScratch = true
+			}
+		} else if field.Package != gen.Package {
+			importPath := field.Package
+			if context.IsEmbedded(field.Package) {
+				importPath = msgs.ImportPrefix + "/" + field.Package
+			} else if gen.ImportPrefix != "" {
+				importPath = gen.ImportPrefix + "/" + field.Package
+			}
 			found := false
 			for _, imp := range gen.Imports {
-				if imp == field.Package {
+				if imp == importPath {
 					found = true
 					break
 				}
 			}
 			if !found {
-				gen.Imports = append(gen.Imports, field.Package)
+				gen.Imports = append(gen.Imports, importPath)
+			}
+		}
+	}
+}
+
+func (gen *MsgGen) computeArrayMinSizes(context *MsgContext) error {
+	gen.ArrayMinSizes = make(map[string]int)
+	for _, field := range gen.Fields {
+		if !field.IsArray || field.ArrayLen >= 0 {
+			continue
+		}
+		size, err := context.MinElemSize(field)
+		if err != nil {
+			return err
+		}
+		gen.ArrayMinSizes[field.GoName] = size
+	}
+	return nil
+}
+
+// Methods of generated messages. Fields of the same names get a trailing
+// underscore.
+var messageMethods = []string{
+	"Type", "SerializedLength", "Serialize", "Deserialize", "MarshalJSON", "UnmarshalJSON",
+	"String", "Clone", "Equal",
+}
+
+// Adjust fields for the Go package of the message. Messages of the same
+// package are referred to without a package qualifier.
+func localFields(pkg string, fields []Field) []Field {
+	local := make([]Field, len(fields))
+	copy(local, fields)
+	for i := range local {
+		for _, method := range messageMethods {
+			if local[i].GoName == method {
+				local[i].GoName += "_"
 			}
 		}
+		if local[i].Package == pkg {
+			local[i].GoType = local[i].Type
+			local[i].ZeroValue = local[i].Type + "{}"
+		}
 	}
+	return local
 }
 
-func GenerateMessage(context *MsgContext, spec *MsgSpec) (string, error) {
+// GenerateMessage generates the code of the message. Generated packages
+// are imported as importPrefix/<ROS package>, or as the bare ROS package
+// name if importPrefix is empty.
+func GenerateMessage(context *MsgContext, spec *MsgSpec, importPrefix string) (string, error) {
 	var gen MsgGen
-	gen.Fields = spec.Fields
+	gen.Fields = localFields(spec.Package, spec.Fields)
 	gen.Constants = spec.Constants
+	legacyNames, err := legacyConstantNames(context, spec)
+	if err != nil {
+		return "", err
+	}
+	for _, c := range spec.Constants {
+		if isVariable(c) {
+			gen.Vars = append(gen.Vars, c)
+			gen.MathRequired = true
+			if legacyNames[c.GoName] {
+				gen.LegacyVars = append(gen.LegacyVars, c)
+			}
+		} else {
+			gen.Consts = append(gen.Consts, c)
+			if legacyNames[c.GoName] {
+				gen.LegacyConsts = append(gen.LegacyConsts, c)
+			}
+		}
+	}
 	gen.Text = spec.Text
 	gen.FullName = spec.FullName
 	gen.ShortName = spec.ShortName
 	gen.Package = spec.Package
 	gen.MD5Sum = spec.MD5Sum
+	gen.ImportPrefix = strings.TrimSuffix(importPrefix, "/")
 
-	gen.analyzeImports()
+	fullText, err := context.ComputeFullText(spec)
+	if err != nil {
+		return "", err
+	}
+	gen.FullText = fullText
+
+	gen.analyzeImports(context)
+	if err := gen.computeArrayMinSizes(context); err != nil {
+		return "", err
+	}
 
-	tmpl, err := template.New("msg").Parse(msgTemplate)
+	tmpl, err := template.New("msg").Funcs(templateFuncs).Parse(msgTemplate)
 	if err != nil {
 		return "", err
 	}
@@ -332,20 +804,24 @@ func GenerateMessage(context *MsgContext, spec *MsgSpec) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return buffer.String(), err
+	code, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return "", err
+	}
+	return string(code), nil
 }
 
-func GenerateService(context *MsgContext, spec *SrvSpec) (string, string, string, error) {
-	reqCode, err := GenerateMessage(context, spec.Request)
+func GenerateService(context *MsgContext, spec *SrvSpec, importPrefix string) (string, string, string, error) {
+	reqCode, err := GenerateMessage(context, spec.Request, importPrefix)
 	if err != nil {
 		return "", "", "", err
 	}
-	resCode, err := GenerateMessage(context, spec.Response)
+	resCode, err := GenerateMessage(context, spec.Response, importPrefix)
 	if err != nil {
 		return "", "", "", err
 	}
 
-	tmpl, err := template.New("srv").Parse(srvTemplate)
+	tmpl, err := template.New("srv").Funcs(templateFuncs).Parse(srvTemplate)
 	if err != nil {
 		return "", "", "", err
 	}
@@ -356,5 +832,9 @@ func GenerateService(context *MsgContext, spec *SrvSpec) (string, string, string
 	if err != nil {
 		return "", "", "", err
 	}
-	return buffer.String(), reqCode, resCode, err
+	srvCode, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return "", "", "", err
+	}
+	return string(srvCode), reqCode, resCode, nil
 }
@@ -3,6 +3,11 @@ package main
 
 import (
 	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
 	//	"math"
 	"testing"
 )
@@ -45,19 +50,149 @@ Bar x
 Bar[] xva
 Bar[42] xfa
 `
-	ctx, e := NewMsgContext()
+	ctx, e := NewMsgContext([]string{})
 	if e != nil {
 		t.Errorf("Failed to create MsgContext.")
 	}
+	ctx.LoadMsgFromString("uint32 seq\ntime stamp\nstring frame_id\n", "std_msgs/Header")
+	ctx.LoadMsgFromString("", "std_msgs/Empty")
+	ctx.LoadMsgFromString("int32 x\n", "foo/Bar")
 	var spec *MsgSpec
-	spec, e = LoadMsgFromString(ctx, text, "foo/Foo")
+	spec, e = ctx.LoadMsgFromString(text, "foo/Foo")
 	if e != nil {
 		t.Errorf("Failed to parse: %v", e)
 	}
 
-	msg, err := GenerateMessage(spec)
+	msg, err := GenerateMessage(ctx, spec, "")
 	if err != nil {
 		t.Errorf("Failed to generate message: %v", err)
 	}
 	fmt.Printf(msg)
 }
+
+func TestEncodeDecodeValue(t *testing.T) {
+	var tests = []struct {
+		fieldType string
+		encoded   string
+		decoded   string
+	}{
+		{"bool", "if v { b[:][0] = 1 } else { b[:][0] = 0 }", "x = b[:][0] != 0"},
+		{"uint8", "b[:][0] = v", "x = b[:][0]"},
+		{"int8", "b[:][0] = uint8(v)", "x = int8(b[:][0])"},
+		{"int16", "binary.LittleEndian.PutUint16(b[:], uint16(v))", "x = int16(binary.LittleEndian.Uint16(b[:]))"},
+		{"uint32", "binary.LittleEndian.PutUint32(b[:], v)", "x = binary.LittleEndian.Uint32(b[:])"},
+		{"float64", "binary.LittleEndian.PutUint64(b[:], math.Float64bits(v))", "x = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))"},
+		{"time", "binary.LittleEndian.PutUint32(b[:], v.Sec)\nbinary.LittleEndian.PutUint32(b[4:], v.NSec)",
+			"x.Sec = binary.LittleEndian.Uint32(b[:])\nx.NSec = binary.LittleEndian.Uint32(b[4:])"},
+	}
+	for _, test := range tests {
+		field := NewField("", test.fieldType, "x", false, 0)
+		if code := encodeValue(*field, "b[:]", "v"); code != test.encoded {
+			t.Errorf("%s: expected %q but %q", test.fieldType, test.encoded, code)
+		}
+		if code := decodeValue(*field, "x", "b[:]"); code != test.decoded {
+			t.Errorf("%s: expected %q but %q", test.fieldType, test.decoded, code)
+		}
+	}
+	if s := sliceFrom("data[8*i:]", 4); s != "data[8*i+4:]" {
+		t.Error(s)
+	}
+}
+
+func TestConstValue(t *testing.T) {
+	var tests = []struct {
+		line     string
+		goType   string
+		expected string
+	}{
+		{"string S = say \"hi\"\\n", "string", `"say \"hi\"\\n"`},
+		{"bool B = 1", "bool", "true"},
+		{"char C = 65", "uint8", "65"},
+		{"int8 I = -1", "int8", "-1"},
+		{"uint64 U = 18446744073709551615", "uint64", "18446744073709551615"},
+		{"byte B = 3", "byte", "3"},
+		{"byte B = -1", "byte", "255"},
+		{"float64 F = 0.5", "float64", "0.5"},
+		{"float32 F = nan", "float32", "float32(math.NaN())"},
+		{"float64 F = inf", "float64", "math.Inf(1)"},
+		{"float64 F = -inf", "float64", "math.Inf(-1)"},
+	}
+	for _, test := range tests {
+		c, err := loadConstantLine(test.line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if goType := constType(*c); goType != test.goType {
+			t.Errorf("%s: expected type %s but %s", test.line, test.goType, goType)
+		}
+		if value := constValue(*c); value != test.expected {
+			t.Errorf("%s: expected %s but %s", test.line, test.expected, value)
+		}
+	}
+}
+
+func TestGenerateConstants(t *testing.T) {
+	root := t.TempDir()
+	writeRosPackage(t, root, "foo_msgs", map[string]string{
+		"msg/Mode.msg":  "uint8 IDLE=0\nbyte FLAG=-1\nuint8 SHARED=1\nfloat64 LIMIT=inf\nfloat32 UNSET=nan\nuint8 mode\n",
+		"msg/Other.msg": "uint8 SHARED=2\n",
+	})
+	ctx, err := NewMsgContext([]string{root})
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec, err := ctx.LoadMsg("foo_msgs/Mode")
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, err := GenerateMessage(ctx, spec, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "Mode.go", code, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	pkg, err := conf.Check("foo_msgs", fset, []*ast.File{file}, nil)
+	if err != nil {
+		t.Fatalf("%v\n%s", err, code)
+	}
+
+	var tests = []struct {
+		name     string
+		isConst  bool
+		typeName string
+	}{
+		{"Mode_IDLE", true, "uint8"},
+		{"IDLE", true, "uint8"},
+		{"Mode_FLAG", true, "byte"},
+		{"FLAG", true, "byte"},
+		{"Mode_SHARED", true, "uint8"},
+		{"Mode_LIMIT", false, "float64"},
+		{"LIMIT", false, "float64"},
+		{"Mode_UNSET", false, "float32"},
+		{"UNSET", false, "float32"},
+	}
+	for _, test := range tests {
+		obj := pkg.Scope().Lookup(test.name)
+		if obj == nil {
+			t.Errorf("%s is not declared", test.name)
+			continue
+		}
+		if _, isConst := obj.(*types.Const); isConst != test.isConst {
+			t.Errorf("%s: expected constant %t", test.name, test.isConst)
+		}
+		if obj.Type().String() != test.typeName {
+			t.Errorf("%s: expected type %s but %s", test.name, test.typeName, obj.Type())
+		}
+	}
+	if c, ok := pkg.Scope().Lookup("Mode_FLAG").(*types.Const); ok && c.Val().String() != "255" {
+		t.Errorf("expected 255 but %s", c.Val())
+	}
+	// Other messages of the package have the name too.
+	if pkg.Scope().Lookup("SHARED") != nil {
+		t.Error("SHARED is declared")
+	}
+}
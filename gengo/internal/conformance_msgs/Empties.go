@@ -0,0 +1,132 @@
+// Automatically generated from the message definition "conformance_msgs/Empties.msg"
+package conformance_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgEmpties struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgEmpties) Text() string {
+	return t.text
+}
+
+func (t *_MsgEmpties) Name() string {
+	return t.name
+}
+
+func (t *_MsgEmpties) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgEmpties) NewMessage() ros.Message {
+	m := new(Empties)
+	m.Empties = []Empty{}
+	return m
+}
+
+var (
+	MsgEmpties = &_MsgEmpties{
+		`# Ends with an array of empty messages, whose elements take no bytes
+Empty[] empties
+
+================================================================================
+MSG: conformance_msgs/Empty
+`,
+		"conformance_msgs/Empties",
+		"4fc47b383d2d91e6798d24772f26322b",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgEmpties)
+}
+
+type Empties struct {
+	Empties []Empty `rosmsg:"empties:Empty[]"`
+}
+
+func (m *Empties) Type() ros.MessageType {
+	return MsgEmpties
+}
+
+func (m *Empties) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Empties) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Empties) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Empties) Clone() *Empties {
+	c := *m
+	if m.Empties != nil {
+		c.Empties = make([]Empty, len(m.Empties))
+		for i := range m.Empties {
+			c.Empties[i] = *m.Empties[i].Clone()
+		}
+	}
+	return &c
+}
+
+func (m *Empties) Equal(other *Empties) bool {
+	if len(m.Empties) != len(other.Empties) {
+		return false
+	}
+	for i := range m.Empties {
+		if !m.Empties[i].Equal(&other.Empties[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Empties) SerializedLength() int {
+	length := 0
+	length += 4
+	for i := range m.Empties {
+		length += m.Empties[i].SerializedLength()
+	}
+	return length
+}
+
+func (m *Empties) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Empties)))
+	buf.Write(b[:4])
+	for i := range m.Empties {
+		if err := m.Empties[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Empties) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		m.Empties = make([]Empty, size)
+		for i := range m.Empties {
+			if err := m.Empties[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+// Automatically generated from the message definition "conformance_msgs/Empty.msg"
+package conformance_msgs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/ros"
+)
+
+type _MsgEmpty struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgEmpty) Text() string {
+	return t.text
+}
+
+func (t *_MsgEmpty) Name() string {
+	return t.name
+}
+
+func (t *_MsgEmpty) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgEmpty) NewMessage() ros.Message {
+	m := new(Empty)
+	return m
+}
+
+var (
+	MsgEmpty = &_MsgEmpty{
+		``,
+		"conformance_msgs/Empty",
+		"d41d8cd98f00b204e9800998ecf8427e",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgEmpty)
+}
+
+type Empty struct {
+}
+
+func (m *Empty) Type() ros.MessageType {
+	return MsgEmpty
+}
+
+func (m *Empty) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Empty) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Empty) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Empty) Clone() *Empty {
+	c := *m
+	return &c
+}
+
+func (m *Empty) Equal(other *Empty) bool {
+	return true
+}
+
+func (m *Empty) SerializedLength() int {
+	length := 0
+	return length
+}
+
+func (m *Empty) Serialize(buf *bytes.Buffer) error {
+	return nil
+}
+
+func (m *Empty) Deserialize(buf *bytes.Reader) error {
+	return nil
+}
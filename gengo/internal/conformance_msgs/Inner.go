@@ -0,0 +1,189 @@
+// Automatically generated from the message definition "conformance_msgs/Inner.msg"
+package conformance_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgInner struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgInner) Text() string {
+	return t.text
+}
+
+func (t *_MsgInner) Name() string {
+	return t.name
+}
+
+func (t *_MsgInner) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgInner) NewMessage() ros.Message {
+	m := new(Inner)
+	m.Id = 0
+	m.Name = ""
+	m.Stamp = ros.Time{}
+	m.Points = []Point{}
+	return m
+}
+
+var (
+	MsgInner = &_MsgInner{
+		`# Variable-size message used in arrays
+int32 id
+string name
+time stamp
+Point[] points
+
+================================================================================
+MSG: conformance_msgs/Point
+float64 x
+float64 y
+`,
+		"conformance_msgs/Inner",
+		"74ed045042eeb08f2537f322dd00185d",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgInner)
+}
+
+type Inner struct {
+	Id     int32    `rosmsg:"id:int32"`
+	Name   string   `rosmsg:"name:string"`
+	Stamp  ros.Time `rosmsg:"stamp:time"`
+	Points []Point  `rosmsg:"points:Point[]"`
+}
+
+func (m *Inner) Type() ros.MessageType {
+	return MsgInner
+}
+
+func (m *Inner) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Inner) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Inner) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Inner) Clone() *Inner {
+	c := *m
+	if m.Points != nil {
+		c.Points = make([]Point, len(m.Points))
+		for i := range m.Points {
+			c.Points[i] = *m.Points[i].Clone()
+		}
+	}
+	return &c
+}
+
+func (m *Inner) Equal(other *Inner) bool {
+	if m.Id != other.Id {
+		return false
+	}
+	if m.Name != other.Name {
+		return false
+	}
+	if m.Stamp != other.Stamp {
+		return false
+	}
+	if len(m.Points) != len(other.Points) {
+		return false
+	}
+	for i := range m.Points {
+		if !m.Points[i].Equal(&other.Points[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Inner) SerializedLength() int {
+	length := 0
+	length += 4
+	length += 4 + len(m.Name)
+	length += 8
+	length += 4
+	for i := range m.Points {
+		length += m.Points[i].SerializedLength()
+	}
+	return length
+}
+
+func (m *Inner) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:], uint32(m.Id))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Name)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Name)
+	binary.LittleEndian.PutUint32(b[:], m.Stamp.Sec)
+	binary.LittleEndian.PutUint32(b[4:], m.Stamp.NSec)
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Points)))
+	buf.Write(b[:4])
+	for i := range m.Points {
+		if err := m.Points[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Inner) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Id = int32(binary.LittleEndian.Uint32(b[:]))
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Name = string(data)
+	}
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Stamp.Sec = binary.LittleEndian.Uint32(b[:])
+	m.Stamp.NSec = binary.LittleEndian.Uint32(b[4:])
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*16 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Points = make([]Point, size)
+		for i := range m.Points {
+			if err := m.Points[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,1757 @@
+// Automatically generated from the message definition "conformance_msgs/Kinds.msg"
+package conformance_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgKinds struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgKinds) Text() string {
+	return t.text
+}
+
+func (t *_MsgKinds) Name() string {
+	return t.name
+}
+
+func (t *_MsgKinds) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgKinds) NewMessage() ros.Message {
+	m := new(Kinds)
+	m.Header = std_msgs.Header{}
+	m.B = false
+	m.I8 = 0
+	m.U8 = 0
+	m.By = 0
+	m.Ch = 0
+	m.I16 = 0
+	m.U16 = 0
+	m.I32 = 0
+	m.U32 = 0
+	m.I64 = 0
+	m.U64 = 0
+	m.F32 = 0.0
+	m.F64 = 0.0
+	m.S = ""
+	m.T = ros.Time{}
+	m.D = ros.Duration{}
+	m.P = Point{}
+	m.Inner = Inner{}
+	m.E = Empty{}
+	m.BVa = []bool{}
+	m.I8Va = []int8{}
+	m.U8Va = []uint8{}
+	m.ByVa = []uint8{}
+	m.ChVa = []uint8{}
+	m.I16Va = []int16{}
+	m.U16Va = []uint16{}
+	m.I32Va = []int32{}
+	m.U32Va = []uint32{}
+	m.I64Va = []int64{}
+	m.U64Va = []uint64{}
+	m.F32Va = []float32{}
+	m.F64Va = []float64{}
+	m.SVa = []string{}
+	m.TVa = []ros.Time{}
+	m.DVa = []ros.Duration{}
+	m.PVa = []Point{}
+	m.InnerVa = []Inner{}
+	m.EVa = []Empty{}
+	for i := 0; i < 2; i++ {
+		m.BFa[i] = false
+	}
+	for i := 0; i < 2; i++ {
+		m.I8Fa[i] = 0
+	}
+	for i := 0; i < 2; i++ {
+		m.U8Fa[i] = 0
+	}
+	for i := 0; i < 2; i++ {
+		m.ByFa[i] = 0
+	}
+	for i := 0; i < 2; i++ {
+		m.ChFa[i] = 0
+	}
+	for i := 0; i < 2; i++ {
+		m.I16Fa[i] = 0
+	}
+	for i := 0; i < 2; i++ {
+		m.U16Fa[i] = 0
+	}
+	for i := 0; i < 2; i++ {
+		m.I32Fa[i] = 0
+	}
+	for i := 0; i < 2; i++ {
+		m.U32Fa[i] = 0
+	}
+	for i := 0; i < 2; i++ {
+		m.I64Fa[i] = 0
+	}
+	for i := 0; i < 2; i++ {
+		m.U64Fa[i] = 0
+	}
+	for i := 0; i < 2; i++ {
+		m.F32Fa[i] = 0.0
+	}
+	for i := 0; i < 2; i++ {
+		m.F64Fa[i] = 0.0
+	}
+	for i := 0; i < 2; i++ {
+		m.SFa[i] = ""
+	}
+	for i := 0; i < 2; i++ {
+		m.TFa[i] = ros.Time{}
+	}
+	for i := 0; i < 2; i++ {
+		m.DFa[i] = ros.Duration{}
+	}
+	for i := 0; i < 2; i++ {
+		m.PFa[i] = Point{}
+	}
+	for i := 0; i < 2; i++ {
+		m.InnerFa[i] = Inner{}
+	}
+	for i := 0; i < 2; i++ {
+		m.EFa[i] = Empty{}
+	}
+	return m
+}
+
+var (
+	MsgKinds = &_MsgKinds{
+		`# Every field kind of the ROS message format
+
+Header header
+
+# Scalars
+bool b
+int8 i8
+uint8 u8
+byte by
+char ch
+int16 i16
+uint16 u16
+int32 i32
+uint32 u32
+int64 i64
+uint64 u64
+float32 f32
+float64 f64
+string s
+time t
+duration d
+Point p
+Inner inner
+Empty e
+
+# Variable-length arrays
+bool[] b_va
+int8[] i8_va
+uint8[] u8_va
+byte[] by_va
+char[] ch_va
+int16[] i16_va
+uint16[] u16_va
+int32[] i32_va
+uint32[] u32_va
+int64[] i64_va
+uint64[] u64_va
+float32[] f32_va
+float64[] f64_va
+string[] s_va
+time[] t_va
+duration[] d_va
+Point[] p_va
+Inner[] inner_va
+Empty[] e_va
+
+# Fixed-length arrays
+bool[2] b_fa
+int8[2] i8_fa
+uint8[2] u8_fa
+byte[2] by_fa
+char[2] ch_fa
+int16[2] i16_fa
+uint16[2] u16_fa
+int32[2] i32_fa
+uint32[2] u32_fa
+int64[2] i64_fa
+uint64[2] u64_fa
+float32[2] f32_fa
+float64[2] f64_fa
+string[2] s_fa
+time[2] t_fa
+duration[2] d_fa
+Point[2] p_fa
+Inner[2] inner_fa
+Empty[2] e_fa
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: conformance_msgs/Point
+float64 x
+float64 y
+
+================================================================================
+MSG: conformance_msgs/Inner
+# Variable-size message used in arrays
+int32 id
+string name
+time stamp
+Point[] points
+
+================================================================================
+MSG: conformance_msgs/Empty
+`,
+		"conformance_msgs/Kinds",
+		"9ad3fb23943626ad4da41f9d4b37a358",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgKinds)
+}
+
+type Kinds struct {
+	Header  std_msgs.Header `rosmsg:"header:Header"`
+	B       bool            `rosmsg:"b:bool"`
+	I8      int8            `rosmsg:"i8:int8"`
+	U8      uint8           `rosmsg:"u8:uint8"`
+	By      uint8           `rosmsg:"by:byte"`
+	Ch      uint8           `rosmsg:"ch:char"`
+	I16     int16           `rosmsg:"i16:int16"`
+	U16     uint16          `rosmsg:"u16:uint16"`
+	I32     int32           `rosmsg:"i32:int32"`
+	U32     uint32          `rosmsg:"u32:uint32"`
+	I64     int64           `rosmsg:"i64:int64"`
+	U64     uint64          `rosmsg:"u64:uint64"`
+	F32     float32         `rosmsg:"f32:float32"`
+	F64     float64         `rosmsg:"f64:float64"`
+	S       string          `rosmsg:"s:string"`
+	T       ros.Time        `rosmsg:"t:time"`
+	D       ros.Duration    `rosmsg:"d:duration"`
+	P       Point           `rosmsg:"p:Point"`
+	Inner   Inner           `rosmsg:"inner:Inner"`
+	E       Empty           `rosmsg:"e:Empty"`
+	BVa     []bool          `rosmsg:"b_va:bool[]"`
+	I8Va    []int8          `rosmsg:"i8_va:int8[]"`
+	U8Va    []uint8         `rosmsg:"u8_va:uint8[]"`
+	ByVa    []uint8         `rosmsg:"by_va:byte[]"`
+	ChVa    []uint8         `rosmsg:"ch_va:char[]"`
+	I16Va   []int16         `rosmsg:"i16_va:int16[]"`
+	U16Va   []uint16        `rosmsg:"u16_va:uint16[]"`
+	I32Va   []int32         `rosmsg:"i32_va:int32[]"`
+	U32Va   []uint32        `rosmsg:"u32_va:uint32[]"`
+	I64Va   []int64         `rosmsg:"i64_va:int64[]"`
+	U64Va   []uint64        `rosmsg:"u64_va:uint64[]"`
+	F32Va   []float32       `rosmsg:"f32_va:float32[]"`
+	F64Va   []float64       `rosmsg:"f64_va:float64[]"`
+	SVa     []string        `rosmsg:"s_va:string[]"`
+	TVa     []ros.Time      `rosmsg:"t_va:time[]"`
+	DVa     []ros.Duration  `rosmsg:"d_va:duration[]"`
+	PVa     []Point         `rosmsg:"p_va:Point[]"`
+	InnerVa []Inner         `rosmsg:"inner_va:Inner[]"`
+	EVa     []Empty         `rosmsg:"e_va:Empty[]"`
+	BFa     [2]bool         `rosmsg:"b_fa:bool[2]"`
+	I8Fa    [2]int8         `rosmsg:"i8_fa:int8[2]"`
+	U8Fa    [2]uint8        `rosmsg:"u8_fa:uint8[2]"`
+	ByFa    [2]uint8        `rosmsg:"by_fa:byte[2]"`
+	ChFa    [2]uint8        `rosmsg:"ch_fa:char[2]"`
+	I16Fa   [2]int16        `rosmsg:"i16_fa:int16[2]"`
+	U16Fa   [2]uint16       `rosmsg:"u16_fa:uint16[2]"`
+	I32Fa   [2]int32        `rosmsg:"i32_fa:int32[2]"`
+	U32Fa   [2]uint32       `rosmsg:"u32_fa:uint32[2]"`
+	I64Fa   [2]int64        `rosmsg:"i64_fa:int64[2]"`
+	U64Fa   [2]uint64       `rosmsg:"u64_fa:uint64[2]"`
+	F32Fa   [2]float32      `rosmsg:"f32_fa:float32[2]"`
+	F64Fa   [2]float64      `rosmsg:"f64_fa:float64[2]"`
+	SFa     [2]string       `rosmsg:"s_fa:string[2]"`
+	TFa     [2]ros.Time     `rosmsg:"t_fa:time[2]"`
+	DFa     [2]ros.Duration `rosmsg:"d_fa:duration[2]"`
+	PFa     [2]Point        `rosmsg:"p_fa:Point[2]"`
+	InnerFa [2]Inner        `rosmsg:"inner_fa:Inner[2]"`
+	EFa     [2]Empty        `rosmsg:"e_fa:Empty[2]"`
+}
+
+func (m *Kinds) Type() ros.MessageType {
+	return MsgKinds
+}
+
+func (m *Kinds) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Kinds) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Kinds) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Kinds) Clone() *Kinds {
+	c := *m
+	c.Header = *m.Header.Clone()
+	c.P = *m.P.Clone()
+	c.Inner = *m.Inner.Clone()
+	c.E = *m.E.Clone()
+	if m.BVa != nil {
+		c.BVa = make([]bool, len(m.BVa))
+		copy(c.BVa, m.BVa)
+	}
+	if m.I8Va != nil {
+		c.I8Va = make([]int8, len(m.I8Va))
+		copy(c.I8Va, m.I8Va)
+	}
+	if m.U8Va != nil {
+		c.U8Va = make([]uint8, len(m.U8Va))
+		copy(c.U8Va, m.U8Va)
+	}
+	if m.ByVa != nil {
+		c.ByVa = make([]uint8, len(m.ByVa))
+		copy(c.ByVa, m.ByVa)
+	}
+	if m.ChVa != nil {
+		c.ChVa = make([]uint8, len(m.ChVa))
+		copy(c.ChVa, m.ChVa)
+	}
+	if m.I16Va != nil {
+		c.I16Va = make([]int16, len(m.I16Va))
+		copy(c.I16Va, m.I16Va)
+	}
+	if m.U16Va != nil {
+		c.U16Va = make([]uint16, len(m.U16Va))
+		copy(c.U16Va, m.U16Va)
+	}
+	if m.I32Va != nil {
+		c.I32Va = make([]int32, len(m.I32Va))
+		copy(c.I32Va, m.I32Va)
+	}
+	if m.U32Va != nil {
+		c.U32Va = make([]uint32, len(m.U32Va))
+		copy(c.U32Va, m.U32Va)
+	}
+	if m.I64Va != nil {
+		c.I64Va = make([]int64, len(m.I64Va))
+		copy(c.I64Va, m.I64Va)
+	}
+	if m.U64Va != nil {
+		c.U64Va = make([]uint64, len(m.U64Va))
+		copy(c.U64Va, m.U64Va)
+	}
+	if m.F32Va != nil {
+		c.F32Va = make([]float32, len(m.F32Va))
+		copy(c.F32Va, m.F32Va)
+	}
+	if m.F64Va != nil {
+		c.F64Va = make([]float64, len(m.F64Va))
+		copy(c.F64Va, m.F64Va)
+	}
+	if m.SVa != nil {
+		c.SVa = make([]string, len(m.SVa))
+		copy(c.SVa, m.SVa)
+	}
+	if m.TVa != nil {
+		c.TVa = make([]ros.Time, len(m.TVa))
+		copy(c.TVa, m.TVa)
+	}
+	if m.DVa != nil {
+		c.DVa = make([]ros.Duration, len(m.DVa))
+		copy(c.DVa, m.DVa)
+	}
+	if m.PVa != nil {
+		c.PVa = make([]Point, len(m.PVa))
+		for i := range m.PVa {
+			c.PVa[i] = *m.PVa[i].Clone()
+		}
+	}
+	if m.InnerVa != nil {
+		c.InnerVa = make([]Inner, len(m.InnerVa))
+		for i := range m.InnerVa {
+			c.InnerVa[i] = *m.InnerVa[i].Clone()
+		}
+	}
+	if m.EVa != nil {
+		c.EVa = make([]Empty, len(m.EVa))
+		for i := range m.EVa {
+			c.EVa[i] = *m.EVa[i].Clone()
+		}
+	}
+	for i := range m.PFa {
+		c.PFa[i] = *m.PFa[i].Clone()
+	}
+	for i := range m.InnerFa {
+		c.InnerFa[i] = *m.InnerFa[i].Clone()
+	}
+	for i := range m.EFa {
+		c.EFa[i] = *m.EFa[i].Clone()
+	}
+	return &c
+}
+
+func (m *Kinds) Equal(other *Kinds) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if m.B != other.B {
+		return false
+	}
+	if m.I8 != other.I8 {
+		return false
+	}
+	if m.U8 != other.U8 {
+		return false
+	}
+	if m.By != other.By {
+		return false
+	}
+	if m.Ch != other.Ch {
+		return false
+	}
+	if m.I16 != other.I16 {
+		return false
+	}
+	if m.U16 != other.U16 {
+		return false
+	}
+	if m.I32 != other.I32 {
+		return false
+	}
+	if m.U32 != other.U32 {
+		return false
+	}
+	if m.I64 != other.I64 {
+		return false
+	}
+	if m.U64 != other.U64 {
+		return false
+	}
+	if m.F32 != other.F32 {
+		return false
+	}
+	if m.F64 != other.F64 {
+		return false
+	}
+	if m.S != other.S {
+		return false
+	}
+	if m.T != other.T {
+		return false
+	}
+	if m.D != other.D {
+		return false
+	}
+	if !m.P.Equal(&other.P) {
+		return false
+	}
+	if !m.Inner.Equal(&other.Inner) {
+		return false
+	}
+	if !m.E.Equal(&other.E) {
+		return false
+	}
+	if len(m.BVa) != len(other.BVa) {
+		return false
+	}
+	for i := range m.BVa {
+		if m.BVa[i] != other.BVa[i] {
+			return false
+		}
+	}
+	if len(m.I8Va) != len(other.I8Va) {
+		return false
+	}
+	for i := range m.I8Va {
+		if m.I8Va[i] != other.I8Va[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(m.U8Va, other.U8Va) {
+		return false
+	}
+	if !bytes.Equal(m.ByVa, other.ByVa) {
+		return false
+	}
+	if !bytes.Equal(m.ChVa, other.ChVa) {
+		return false
+	}
+	if len(m.I16Va) != len(other.I16Va) {
+		return false
+	}
+	for i := range m.I16Va {
+		if m.I16Va[i] != other.I16Va[i] {
+			return false
+		}
+	}
+	if len(m.U16Va) != len(other.U16Va) {
+		return false
+	}
+	for i := range m.U16Va {
+		if m.U16Va[i] != other.U16Va[i] {
+			return false
+		}
+	}
+	if len(m.I32Va) != len(other.I32Va) {
+		return false
+	}
+	for i := range m.I32Va {
+		if m.I32Va[i] != other.I32Va[i] {
+			return false
+		}
+	}
+	if len(m.U32Va) != len(other.U32Va) {
+		return false
+	}
+	for i := range m.U32Va {
+		if m.U32Va[i] != other.U32Va[i] {
+			return false
+		}
+	}
+	if len(m.I64Va) != len(other.I64Va) {
+		return false
+	}
+	for i := range m.I64Va {
+		if m.I64Va[i] != other.I64Va[i] {
+			return false
+		}
+	}
+	if len(m.U64Va) != len(other.U64Va) {
+		return false
+	}
+	for i := range m.U64Va {
+		if m.U64Va[i] != other.U64Va[i] {
+			return false
+		}
+	}
+	if len(m.F32Va) != len(other.F32Va) {
+		return false
+	}
+	for i := range m.F32Va {
+		if m.F32Va[i] != other.F32Va[i] {
+			return false
+		}
+	}
+	if len(m.F64Va) != len(other.F64Va) {
+		return false
+	}
+	for i := range m.F64Va {
+		if m.F64Va[i] != other.F64Va[i] {
+			return false
+		}
+	}
+	if len(m.SVa) != len(other.SVa) {
+		return false
+	}
+	for i := range m.SVa {
+		if m.SVa[i] != other.SVa[i] {
+			return false
+		}
+	}
+	if len(m.TVa) != len(other.TVa) {
+		return false
+	}
+	for i := range m.TVa {
+		if m.TVa[i] != other.TVa[i] {
+			return false
+		}
+	}
+	if len(m.DVa) != len(other.DVa) {
+		return false
+	}
+	for i := range m.DVa {
+		if m.DVa[i] != other.DVa[i] {
+			return false
+		}
+	}
+	if len(m.PVa) != len(other.PVa) {
+		return false
+	}
+	for i := range m.PVa {
+		if !m.PVa[i].Equal(&other.PVa[i]) {
+			return false
+		}
+	}
+	if len(m.InnerVa) != len(other.InnerVa) {
+		return false
+	}
+	for i := range m.InnerVa {
+		if !m.InnerVa[i].Equal(&other.InnerVa[i]) {
+			return false
+		}
+	}
+	if len(m.EVa) != len(other.EVa) {
+		return false
+	}
+	for i := range m.EVa {
+		if !m.EVa[i].Equal(&other.EVa[i]) {
+			return false
+		}
+	}
+	if m.BFa != other.BFa {
+		return false
+	}
+	if m.I8Fa != other.I8Fa {
+		return false
+	}
+	if m.U8Fa != other.U8Fa {
+		return false
+	}
+	if m.ByFa != other.ByFa {
+		return false
+	}
+	if m.ChFa != other.ChFa {
+		return false
+	}
+	if m.I16Fa != other.I16Fa {
+		return false
+	}
+	if m.U16Fa != other.U16Fa {
+		return false
+	}
+	if m.I32Fa != other.I32Fa {
+		return false
+	}
+	if m.U32Fa != other.U32Fa {
+		return false
+	}
+	if m.I64Fa != other.I64Fa {
+		return false
+	}
+	if m.U64Fa != other.U64Fa {
+		return false
+	}
+	if m.F32Fa != other.F32Fa {
+		return false
+	}
+	if m.F64Fa != other.F64Fa {
+		return false
+	}
+	if m.SFa != other.SFa {
+		return false
+	}
+	if m.TFa != other.TFa {
+		return false
+	}
+	if m.DFa != other.DFa {
+		return false
+	}
+	for i := range m.PFa {
+		if !m.PFa[i].Equal(&other.PFa[i]) {
+			return false
+		}
+	}
+	for i := range m.InnerFa {
+		if !m.InnerFa[i].Equal(&other.InnerFa[i]) {
+			return false
+		}
+	}
+	for i := range m.EFa {
+		if !m.EFa[i].Equal(&other.EFa[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Kinds) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += 1
+	length += 1
+	length += 1
+	length += 1
+	length += 1
+	length += 2
+	length += 2
+	length += 4
+	length += 4
+	length += 8
+	length += 8
+	length += 4
+	length += 8
+	length += 4 + len(m.S)
+	length += 8
+	length += 8
+	length += m.P.SerializedLength()
+	length += m.Inner.SerializedLength()
+	length += m.E.SerializedLength()
+	length += 4
+	length += 1 * len(m.BVa)
+	length += 4
+	length += 1 * len(m.I8Va)
+	length += 4
+	length += 1 * len(m.U8Va)
+	length += 4
+	length += 1 * len(m.ByVa)
+	length += 4
+	length += 1 * len(m.ChVa)
+	length += 4
+	length += 2 * len(m.I16Va)
+	length += 4
+	length += 2 * len(m.U16Va)
+	length += 4
+	length += 4 * len(m.I32Va)
+	length += 4
+	length += 4 * len(m.U32Va)
+	length += 4
+	length += 8 * len(m.I64Va)
+	length += 4
+	length += 8 * len(m.U64Va)
+	length += 4
+	length += 4 * len(m.F32Va)
+	length += 4
+	length += 8 * len(m.F64Va)
+	length += 4
+	for _, e := range m.SVa {
+		length += 4 + len(e)
+	}
+	length += 4
+	length += 8 * len(m.TVa)
+	length += 4
+	length += 8 * len(m.DVa)
+	length += 4
+	for i := range m.PVa {
+		length += m.PVa[i].SerializedLength()
+	}
+	length += 4
+	for i := range m.InnerVa {
+		length += m.InnerVa[i].SerializedLength()
+	}
+	length += 4
+	for i := range m.EVa {
+		length += m.EVa[i].SerializedLength()
+	}
+	length += 1 * len(m.BFa)
+	length += 1 * len(m.I8Fa)
+	length += 1 * len(m.U8Fa)
+	length += 1 * len(m.ByFa)
+	length += 1 * len(m.ChFa)
+	length += 2 * len(m.I16Fa)
+	length += 2 * len(m.U16Fa)
+	length += 4 * len(m.I32Fa)
+	length += 4 * len(m.U32Fa)
+	length += 8 * len(m.I64Fa)
+	length += 8 * len(m.U64Fa)
+	length += 4 * len(m.F32Fa)
+	length += 8 * len(m.F64Fa)
+	for _, e := range m.SFa {
+		length += 4 + len(e)
+	}
+	length += 8 * len(m.TFa)
+	length += 8 * len(m.DFa)
+	for i := range m.PFa {
+		length += m.PFa[i].SerializedLength()
+	}
+	for i := range m.InnerFa {
+		length += m.InnerFa[i].SerializedLength()
+	}
+	for i := range m.EFa {
+		length += m.EFa[i].SerializedLength()
+	}
+	return length
+}
+
+func (m *Kinds) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	if m.B {
+		buf.WriteByte(1)
+	} else {
+		buf.WriteByte(0)
+	}
+	buf.WriteByte(byte(m.I8))
+	buf.WriteByte(m.U8)
+	buf.WriteByte(m.By)
+	buf.WriteByte(m.Ch)
+	binary.LittleEndian.PutUint16(b[:], uint16(m.I16))
+	buf.Write(b[:2])
+	binary.LittleEndian.PutUint16(b[:], m.U16)
+	buf.Write(b[:2])
+	binary.LittleEndian.PutUint32(b[:], uint32(m.I32))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], m.U32)
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint64(b[:], uint64(m.I64))
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint64(b[:], m.U64)
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.F32))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.F64))
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.S)))
+	buf.Write(b[:4])
+	buf.WriteString(m.S)
+	binary.LittleEndian.PutUint32(b[:], m.T.Sec)
+	binary.LittleEndian.PutUint32(b[4:], m.T.NSec)
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint32(b[:], m.D.Sec)
+	binary.LittleEndian.PutUint32(b[4:], m.D.NSec)
+	buf.Write(b[:8])
+	if err := m.P.Serialize(buf); err != nil {
+		return err
+	}
+	if err := m.Inner.Serialize(buf); err != nil {
+		return err
+	}
+	if err := m.E.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.BVa)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 1 * len(m.BVa)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.BVa {
+			if e {
+				data[1*i:][0] = 1
+			} else {
+				data[1*i:][0] = 0
+			}
+		}
+		buf.Write(data)
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.I8Va)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 1 * len(m.I8Va)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.I8Va {
+			data[1*i:][0] = uint8(e)
+		}
+		buf.Write(data)
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.U8Va)))
+	buf.Write(b[:4])
+	buf.Write(m.U8Va[:])
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.ByVa)))
+	buf.Write(b[:4])
+	buf.Write(m.ByVa[:])
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.ChVa)))
+	buf.Write(b[:4])
+	buf.Write(m.ChVa[:])
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.I16Va)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 2 * len(m.I16Va)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.I16Va {
+			binary.LittleEndian.PutUint16(data[2*i:], uint16(e))
+		}
+		buf.Write(data)
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.U16Va)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 2 * len(m.U16Va)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.U16Va {
+			binary.LittleEndian.PutUint16(data[2*i:], e)
+		}
+		buf.Write(data)
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.I32Va)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 4 * len(m.I32Va)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.I32Va {
+			binary.LittleEndian.PutUint32(data[4*i:], uint32(e))
+		}
+		buf.Write(data)
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.U32Va)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 4 * len(m.U32Va)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.U32Va {
+			binary.LittleEndian.PutUint32(data[4*i:], e)
+		}
+		buf.Write(data)
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.I64Va)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 8 * len(m.I64Va)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.I64Va {
+			binary.LittleEndian.PutUint64(data[8*i:], uint64(e))
+		}
+		buf.Write(data)
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.U64Va)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 8 * len(m.U64Va)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.U64Va {
+			binary.LittleEndian.PutUint64(data[8*i:], e)
+		}
+		buf.Write(data)
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.F32Va)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 4 * len(m.F32Va)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.F32Va {
+			binary.LittleEndian.PutUint32(data[4*i:], math.Float32bits(e))
+		}
+		buf.Write(data)
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.F64Va)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 8 * len(m.F64Va)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.F64Va {
+			binary.LittleEndian.PutUint64(data[8*i:], math.Float64bits(e))
+		}
+		buf.Write(data)
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.SVa)))
+	buf.Write(b[:4])
+	for _, e := range m.SVa {
+		binary.LittleEndian.PutUint32(b[:4], uint32(len(e)))
+		buf.Write(b[:4])
+		buf.WriteString(e)
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.TVa)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 8 * len(m.TVa)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.TVa {
+			binary.LittleEndian.PutUint32(data[8*i:], e.Sec)
+			binary.LittleEndian.PutUint32(data[8*i+4:], e.NSec)
+		}
+		buf.Write(data)
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.DVa)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 8 * len(m.DVa)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.DVa {
+			binary.LittleEndian.PutUint32(data[8*i:], e.Sec)
+			binary.LittleEndian.PutUint32(data[8*i+4:], e.NSec)
+		}
+		buf.Write(data)
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.PVa)))
+	buf.Write(b[:4])
+	for i := range m.PVa {
+		if err := m.PVa[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.InnerVa)))
+	buf.Write(b[:4])
+	for i := range m.InnerVa {
+		if err := m.InnerVa[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.EVa)))
+	buf.Write(b[:4])
+	for i := range m.EVa {
+		if err := m.EVa[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 1 * len(m.BFa)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.BFa {
+			if e {
+				data[1*i:][0] = 1
+			} else {
+				data[1*i:][0] = 0
+			}
+		}
+		buf.Write(data)
+	}
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 1 * len(m.I8Fa)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.I8Fa {
+			data[1*i:][0] = uint8(e)
+		}
+		buf.Write(data)
+	}
+	buf.Write(m.U8Fa[:])
+	buf.Write(m.ByFa[:])
+	buf.Write(m.ChFa[:])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 2 * len(m.I16Fa)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.I16Fa {
+			binary.LittleEndian.PutUint16(data[2*i:], uint16(e))
+		}
+		buf.Write(data)
+	}
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 2 * len(m.U16Fa)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.U16Fa {
+			binary.LittleEndian.PutUint16(data[2*i:], e)
+		}
+		buf.Write(data)
+	}
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 4 * len(m.I32Fa)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.I32Fa {
+			binary.LittleEndian.PutUint32(data[4*i:], uint32(e))
+		}
+		buf.Write(data)
+	}
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 4 * len(m.U32Fa)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.U32Fa {
+			binary.LittleEndian.PutUint32(data[4*i:], e)
+		}
+		buf.Write(data)
+	}
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 8 * len(m.I64Fa)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.I64Fa {
+			binary.LittleEndian.PutUint64(data[8*i:], uint64(e))
+		}
+		buf.Write(data)
+	}
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 8 * len(m.U64Fa)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.U64Fa {
+			binary.LittleEndian.PutUint64(data[8*i:], e)
+		}
+		buf.Write(data)
+	}
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 4 * len(m.F32Fa)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.F32Fa {
+			binary.LittleEndian.PutUint32(data[4*i:], math.Float32bits(e))
+		}
+		buf.Write(data)
+	}
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 8 * len(m.F64Fa)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.F64Fa {
+			binary.LittleEndian.PutUint64(data[8*i:], math.Float64bits(e))
+		}
+		buf.Write(data)
+	}
+	for _, e := range m.SFa {
+		binary.LittleEndian.PutUint32(b[:4], uint32(len(e)))
+		buf.Write(b[:4])
+		buf.WriteString(e)
+	}
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 8 * len(m.TFa)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.TFa {
+			binary.LittleEndian.PutUint32(data[8*i:], e.Sec)
+			binary.LittleEndian.PutUint32(data[8*i+4:], e.NSec)
+		}
+		buf.Write(data)
+	}
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 8 * len(m.DFa)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.DFa {
+			binary.LittleEndian.PutUint32(data[8*i:], e.Sec)
+			binary.LittleEndian.PutUint32(data[8*i+4:], e.NSec)
+		}
+		buf.Write(data)
+	}
+	for i := range m.PFa {
+		if err := m.PFa[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	for i := range m.InnerFa {
+		if err := m.InnerFa[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	for i := range m.EFa {
+		if err := m.EFa[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Kinds) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.B = b[:][0] != 0
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.I8 = int8(b[:][0])
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.U8 = b[:][0]
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.By = b[:][0]
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.Ch = b[:][0]
+	if _, err := io.ReadFull(buf, b[:2]); err != nil {
+		return err
+	}
+	m.I16 = int16(binary.LittleEndian.Uint16(b[:]))
+	if _, err := io.ReadFull(buf, b[:2]); err != nil {
+		return err
+	}
+	m.U16 = binary.LittleEndian.Uint16(b[:])
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.I32 = int32(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.U32 = binary.LittleEndian.Uint32(b[:])
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.I64 = int64(binary.LittleEndian.Uint64(b[:]))
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.U64 = binary.LittleEndian.Uint64(b[:])
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.F32 = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.F64 = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.S = string(data)
+	}
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.T.Sec = binary.LittleEndian.Uint32(b[:])
+	m.T.NSec = binary.LittleEndian.Uint32(b[4:])
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.D.Sec = binary.LittleEndian.Uint32(b[:])
+	m.D.NSec = binary.LittleEndian.Uint32(b[4:])
+	if err := m.P.Deserialize(buf); err != nil {
+		return err
+	}
+	if err := m.Inner.Deserialize(buf); err != nil {
+		return err
+	}
+	if err := m.E.Deserialize(buf); err != nil {
+		return err
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*1 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.BVa = make([]bool, size)
+		data := make([]byte, 1*len(m.BVa))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.BVa {
+			m.BVa[i] = data[1*i:][0] != 0
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*1 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.I8Va = make([]int8, size)
+		data := make([]byte, 1*len(m.I8Va))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.I8Va {
+			m.I8Va[i] = int8(data[1*i:][0])
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*1 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.U8Va = make([]uint8, size)
+		if _, err := io.ReadFull(buf, m.U8Va[:]); err != nil {
+			return err
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*1 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.ByVa = make([]uint8, size)
+		if _, err := io.ReadFull(buf, m.ByVa[:]); err != nil {
+			return err
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*1 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.ChVa = make([]uint8, size)
+		if _, err := io.ReadFull(buf, m.ChVa[:]); err != nil {
+			return err
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*2 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.I16Va = make([]int16, size)
+		data := make([]byte, 2*len(m.I16Va))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.I16Va {
+			m.I16Va[i] = int16(binary.LittleEndian.Uint16(data[2*i:]))
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*2 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.U16Va = make([]uint16, size)
+		data := make([]byte, 2*len(m.U16Va))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.U16Va {
+			m.U16Va[i] = binary.LittleEndian.Uint16(data[2*i:])
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*4 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.I32Va = make([]int32, size)
+		data := make([]byte, 4*len(m.I32Va))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.I32Va {
+			m.I32Va[i] = int32(binary.LittleEndian.Uint32(data[4*i:]))
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*4 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.U32Va = make([]uint32, size)
+		data := make([]byte, 4*len(m.U32Va))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.U32Va {
+			m.U32Va[i] = binary.LittleEndian.Uint32(data[4*i:])
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*8 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.I64Va = make([]int64, size)
+		data := make([]byte, 8*len(m.I64Va))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.I64Va {
+			m.I64Va[i] = int64(binary.LittleEndian.Uint64(data[8*i:]))
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*8 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.U64Va = make([]uint64, size)
+		data := make([]byte, 8*len(m.U64Va))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.U64Va {
+			m.U64Va[i] = binary.LittleEndian.Uint64(data[8*i:])
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*4 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.F32Va = make([]float32, size)
+		data := make([]byte, 4*len(m.F32Va))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.F32Va {
+			m.F32Va[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[4*i:]))
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*8 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.F64Va = make([]float64, size)
+		data := make([]byte, 8*len(m.F64Va))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.F64Va {
+			m.F64Va[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[8*i:]))
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*4 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.SVa = make([]string, size)
+		for i := range m.SVa {
+			if _, err := io.ReadFull(buf, b[:4]); err != nil {
+				return err
+			}
+			n := int(binary.LittleEndian.Uint32(b[:4]))
+			if int64(n) > int64(buf.Len()) {
+				return io.ErrUnexpectedEOF
+			}
+			data := make([]byte, n)
+			if _, err := io.ReadFull(buf, data); err != nil {
+				return err
+			}
+			m.SVa[i] = string(data)
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*8 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.TVa = make([]ros.Time, size)
+		data := make([]byte, 8*len(m.TVa))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.TVa {
+			m.TVa[i].Sec = binary.LittleEndian.Uint32(data[8*i:])
+			m.TVa[i].NSec = binary.LittleEndian.Uint32(data[8*i+4:])
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*8 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.DVa = make([]ros.Duration, size)
+		data := make([]byte, 8*len(m.DVa))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.DVa {
+			m.DVa[i].Sec = binary.LittleEndian.Uint32(data[8*i:])
+			m.DVa[i].NSec = binary.LittleEndian.Uint32(data[8*i+4:])
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*16 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.PVa = make([]Point, size)
+		for i := range m.PVa {
+			if err := m.PVa[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*20 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.InnerVa = make([]Inner, size)
+		for i := range m.InnerVa {
+			if err := m.InnerVa[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		m.EVa = make([]Empty, size)
+		for i := range m.EVa {
+			if err := m.EVa[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	{
+		data := make([]byte, 1*len(m.BFa))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.BFa {
+			m.BFa[i] = data[1*i:][0] != 0
+		}
+	}
+	{
+		data := make([]byte, 1*len(m.I8Fa))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.I8Fa {
+			m.I8Fa[i] = int8(data[1*i:][0])
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, m.U8Fa[:]); err != nil {
+			return err
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, m.ByFa[:]); err != nil {
+			return err
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, m.ChFa[:]); err != nil {
+			return err
+		}
+	}
+	{
+		data := make([]byte, 2*len(m.I16Fa))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.I16Fa {
+			m.I16Fa[i] = int16(binary.LittleEndian.Uint16(data[2*i:]))
+		}
+	}
+	{
+		data := make([]byte, 2*len(m.U16Fa))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.U16Fa {
+			m.U16Fa[i] = binary.LittleEndian.Uint16(data[2*i:])
+		}
+	}
+	{
+		data := make([]byte, 4*len(m.I32Fa))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.I32Fa {
+			m.I32Fa[i] = int32(binary.LittleEndian.Uint32(data[4*i:]))
+		}
+	}
+	{
+		data := make([]byte, 4*len(m.U32Fa))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.U32Fa {
+			m.U32Fa[i] = binary.LittleEndian.Uint32(data[4*i:])
+		}
+	}
+	{
+		data := make([]byte, 8*len(m.I64Fa))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.I64Fa {
+			m.I64Fa[i] = int64(binary.LittleEndian.Uint64(data[8*i:]))
+		}
+	}
+	{
+		data := make([]byte, 8*len(m.U64Fa))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.U64Fa {
+			m.U64Fa[i] = binary.LittleEndian.Uint64(data[8*i:])
+		}
+	}
+	{
+		data := make([]byte, 4*len(m.F32Fa))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.F32Fa {
+			m.F32Fa[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[4*i:]))
+		}
+	}
+	{
+		data := make([]byte, 8*len(m.F64Fa))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.F64Fa {
+			m.F64Fa[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[8*i:]))
+		}
+	}
+	{
+		for i := range m.SFa {
+			if _, err := io.ReadFull(buf, b[:4]); err != nil {
+				return err
+			}
+			n := int(binary.LittleEndian.Uint32(b[:4]))
+			if int64(n) > int64(buf.Len()) {
+				return io.ErrUnexpectedEOF
+			}
+			data := make([]byte, n)
+			if _, err := io.ReadFull(buf, data); err != nil {
+				return err
+			}
+			m.SFa[i] = string(data)
+		}
+	}
+	{
+		data := make([]byte, 8*len(m.TFa))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.TFa {
+			m.TFa[i].Sec = binary.LittleEndian.Uint32(data[8*i:])
+			m.TFa[i].NSec = binary.LittleEndian.Uint32(data[8*i+4:])
+		}
+	}
+	{
+		data := make([]byte, 8*len(m.DFa))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.DFa {
+			m.DFa[i].Sec = binary.LittleEndian.Uint32(data[8*i:])
+			m.DFa[i].NSec = binary.LittleEndian.Uint32(data[8*i+4:])
+		}
+	}
+	{
+		for i := range m.PFa {
+			if err := m.PFa[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	{
+		for i := range m.InnerFa {
+			if err := m.InnerFa[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	{
+		for i := range m.EFa {
+			if err := m.EFa[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
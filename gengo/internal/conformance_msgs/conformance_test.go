@@ -0,0 +1,319 @@
+package conformance_msgs
+
+// The code of this package is generated from testdata/conformance_msgs.
+// The fixtures are serialized by genpy with testdata/genfixtures.py, which
+// records the ROS distribution and the genpy version in their headers.
+// Tests against them are skipped where they have not been generated.
+
+//go:generate go run github.com/akio/rosgo/gengo -skip-unchanged -path testdata -out .. -import github.com/akio/rosgo/gengo/internal all
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+// Read a fixture written by testdata/genfixtures.py, or skip the test if it
+// has not been generated.
+func readFixture(t *testing.T, name string) string {
+	text, err := ioutil.ReadFile(filepath.Join("testdata", name))
+	if os.IsNotExist(err) {
+		t.Skipf("%s is not generated; run testdata/genfixtures.py with genpy", name)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	var lines []string
+	for _, line := range strings.Split(string(text), "\n") {
+		line = strings.TrimSpace(strings.SplitN(line, "#", 2)[0])
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func readHexFixture(t *testing.T, name string) []byte {
+	data, err := hex.DecodeString(strings.Replace(readFixture(t, name), "\n", "", -1))
+	if err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	return data
+}
+
+// Keep in sync with filled() in testdata/genfixtures.py.
+func filledKinds() *Kinds {
+	m := &Kinds{}
+	m.Header = std_msgs.Header{Seq: 7, Stamp: ros.NewTime(1, 2), FrameId: "base"}
+	m.B = true
+	m.I8 = -3
+	m.U8 = 250
+	m.By = 9
+	m.Ch = 10
+	m.I16 = -300
+	m.U16 = 60000
+	m.I32 = -70000
+	m.U32 = 4000000000
+	m.I64 = -(1 << 40)
+	m.U64 = 1 << 63
+	m.F32 = 1.5
+	m.F64 = -2.25
+	m.S = "héllo"
+	m.T = ros.NewTime(5, 6)
+	m.D = ros.NewDuration(7, 8)
+	m.P = Point{X: 1.0, Y: -1.0}
+	m.Inner = Inner{Id: 4, Name: "in", Stamp: ros.NewTime(9, 10), Points: []Point{{X: 0.5, Y: 0.25}}}
+
+	m.BVa = []bool{true, false, true}
+	m.I8Va = []int8{-1, 2}
+	m.U8Va = []uint8{1, 2, 3}
+	m.ByVa = []uint8{4, 5}
+	m.ChVa = []uint8{6}
+	m.I16Va = []int16{-2, 3}
+	m.U16Va = []uint16{65535}
+	m.I32Va = []int32{-5, 6}
+	m.U32Va = []uint32{7}
+	m.I64Va = []int64{-8}
+	m.U64Va = []uint64{9, 10}
+	m.F32Va = []float32{0.5, 1.0}
+	m.F64Va = []float64{3.0}
+	m.SVa = []string{"a", "", "bc"}
+	m.TVa = []ros.Time{ros.NewTime(1, 1)}
+	m.DVa = []ros.Duration{ros.NewDuration(2, 2), ros.NewDuration(3, 3)}
+	m.PVa = []Point{{X: 1.0, Y: 2.0}}
+	m.InnerVa = []Inner{
+		{Id: 1, Name: "x", Points: []Point{}},
+		{Id: 2, Name: "", Stamp: ros.NewTime(3, 4), Points: []Point{{X: 5.0, Y: 6.0}, {X: 7.0, Y: 8.0}}},
+	}
+	m.EVa = []Empty{{}, {}}
+
+	m.BFa = [2]bool{false, true}
+	m.I8Fa = [2]int8{-128, 127}
+	m.U8Fa = [2]uint8{4, 5}
+	m.ByFa = [2]uint8{1, 2}
+	m.ChFa = [2]uint8{3, 4}
+	m.I16Fa = [2]int16{-32768, 32767}
+	m.U16Fa = [2]uint16{1, 2}
+	m.I32Fa = [2]int32{-1, 1}
+	m.U32Fa = [2]uint32{1, 2}
+	m.I64Fa = [2]int64{-1, 1}
+	m.U64Fa = [2]uint64{1, 2}
+	m.F32Fa = [2]float32{0.25, -0.25}
+	m.F64Fa = [2]float64{3.0, 4.0}
+	m.SFa = [2]string{"x", "yz"}
+	m.TFa = [2]ros.Time{ros.NewTime(1, 2), ros.NewTime(3, 4)}
+	m.DFa = [2]ros.Duration{ros.NewDuration(5, 6), ros.NewDuration(7, 8)}
+	m.PFa = [2]Point{{X: 1.0, Y: 1.0}, {X: 2.0, Y: 2.0}}
+	m.InnerFa = [2]Inner{
+		{Id: 3, Name: "p", Stamp: ros.NewTime(1, 1), Points: []Point{}},
+		{Id: 4, Name: "q", Stamp: ros.NewTime(2, 2), Points: []Point{{X: 9.0, Y: 9.0}}},
+	}
+	return m
+}
+
+var conformanceTests = []struct {
+	fixture string
+	msg     func() *Kinds
+}{
+	{"Kinds_zero.hex", func() *Kinds { return MsgKinds.NewMessage().(*Kinds) }},
+	{"Kinds_filled.hex", filledKinds},
+}
+
+func TestMD5Sum(t *testing.T) {
+	msgTypes := map[string]ros.MessageType{}
+	for _, msgType := range []ros.MessageType{MsgPoint, MsgEmpty, MsgInner, MsgKinds} {
+		msgTypes[msgType.Name()] = msgType
+	}
+	for _, line := range strings.Split(readFixture(t, "md5sums.txt"), "\n") {
+		fields := strings.Fields(line)
+		msgType, ok := msgTypes[fields[0]]
+		if !ok {
+			t.Errorf("unknown message %s", fields[0])
+		} else if msgType.MD5Sum() != fields[1] {
+			t.Errorf("%s: expected %s but %s", fields[0], fields[1], msgType.MD5Sum())
+		}
+	}
+}
+
+func TestSerialize(t *testing.T) {
+	for _, test := range conformanceTests {
+		expected := readHexFixture(t, test.fixture)
+		msg := test.msg()
+		var buf bytes.Buffer
+		if err := msg.Serialize(&buf); err != nil {
+			t.Fatalf("%s: %v", test.fixture, err)
+		}
+		if !bytes.Equal(buf.Bytes(), expected) {
+			t.Errorf("%s: expected\n%x\nbut\n%x", test.fixture, expected, buf.Bytes())
+		}
+		if msg.SerializedLength() != len(expected) {
+			t.Errorf("%s: expected length %d but %d", test.fixture, len(expected), msg.SerializedLength())
+		}
+	}
+}
+
+func TestDeserialize(t *testing.T) {
+	for _, test := range conformanceTests {
+		data := readHexFixture(t, test.fixture)
+		expected := test.msg()
+		msg := MsgKinds.NewMessage().(*Kinds)
+		reader := bytes.NewReader(data)
+		if err := msg.Deserialize(reader); err != nil {
+			t.Fatalf("%s: %v", test.fixture, err)
+		}
+		if reader.Len() != 0 {
+			t.Errorf("%s: %d bytes are left", test.fixture, reader.Len())
+		}
+		if !msg.Equal(expected) {
+			t.Errorf("%s: expected\n%v\nbut\n%v", test.fixture, expected, msg)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, test := range conformanceTests {
+		expected := test.msg()
+		data := serializeKinds(t, expected)
+		if expected.SerializedLength() != len(data) {
+			t.Errorf("%s: expected length %d but %d", test.fixture, len(data), expected.SerializedLength())
+		}
+		msg := MsgKinds.NewMessage().(*Kinds)
+		reader := bytes.NewReader(data)
+		if err := msg.Deserialize(reader); err != nil {
+			t.Fatalf("%s: %v", test.fixture, err)
+		}
+		if reader.Len() != 0 {
+			t.Errorf("%s: %d bytes are left", test.fixture, reader.Len())
+		}
+		if !msg.Equal(expected) {
+			t.Errorf("%s: expected\n%v\nbut\n%v", test.fixture, expected, msg)
+		}
+	}
+}
+
+func TestDeserializeTruncated(t *testing.T) {
+	for _, test := range conformanceTests {
+		data := serializeKinds(t, test.msg())
+		for n := 0; n < len(data); n++ {
+			msg := MsgKinds.NewMessage().(*Kinds)
+			if err := msg.Deserialize(bytes.NewReader(data[:n])); err == nil {
+				t.Errorf("%s: %d of %d bytes are accepted", test.fixture, n, len(data))
+			}
+		}
+	}
+}
+
+func serializeKinds(t *testing.T, m *Kinds) []byte {
+	var buf bytes.Buffer
+	if err := m.Serialize(&buf); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// A length prefix larger than the remaining bytes can hold must be
+// rejected before the array is allocated, or any peer could exhaust the
+// memory of a subscriber.
+func TestDeserializeHugeLength(t *testing.T) {
+	var tests = []struct {
+		name string
+		// The array is empty in base and has an element in grow, so that the
+		// first differing byte is its length prefix.
+		base func(m *Kinds)
+		grow func(m *Kinds)
+	}{
+		{"u8_va", nil, func(m *Kinds) { m.U8Va = []uint8{0} }},
+		{"i32_va", nil, func(m *Kinds) { m.I32Va = []int32{0} }},
+		{"f64_va", nil, func(m *Kinds) { m.F64Va = []float64{0} }},
+		{"s_va", nil, func(m *Kinds) { m.SVa = []string{""} }},
+		{"t_va", nil, func(m *Kinds) { m.TVa = []ros.Time{{}} }},
+		{"d_va", nil, func(m *Kinds) { m.DVa = []ros.Duration{{}} }},
+		{"p_va", nil, func(m *Kinds) { m.PVa = []Point{{}} }},
+		{"inner_va", nil, func(m *Kinds) { m.InnerVa = []Inner{{Points: []Point{}}} }},
+		{"inner_va.points",
+			func(m *Kinds) { m.InnerVa = []Inner{{Points: []Point{}}} },
+			func(m *Kinds) { m.InnerVa = []Inner{{Points: []Point{{}}}} }},
+	}
+	for _, test := range tests {
+		base := MsgKinds.NewMessage().(*Kinds)
+		if test.base != nil {
+			test.base(base)
+		}
+		data := serializeKinds(t, base)
+		grown := MsgKinds.NewMessage().(*Kinds)
+		if test.base != nil {
+			test.base(grown)
+		}
+		test.grow(grown)
+		offset := 0
+		for grownData := serializeKinds(t, grown); data[offset] == grownData[offset]; offset++ {
+		}
+		for _, size := range []uint32{0x7fffffff, 0xffffffff} {
+			binary.LittleEndian.PutUint32(data[offset:], size)
+			var before, after runtime.MemStats
+			runtime.ReadMemStats(&before)
+			err := MsgKinds.NewMessage().Deserialize(bytes.NewReader(data))
+			runtime.ReadMemStats(&after)
+			if err == nil {
+				t.Errorf("%s: length %#x is accepted", test.name, size)
+			}
+			if allocated := after.TotalAlloc - before.TotalAlloc; allocated > 1<<20 {
+				t.Errorf("%s: %d bytes are allocated for length %#x", test.name, allocated, size)
+			}
+		}
+	}
+}
+
+// Elements of empty messages take no bytes, so an array of them may end the
+// message.
+func TestEmptyArray(t *testing.T) {
+	msg := &Empties{Empties: []Empty{{}, {}, {}}}
+	var buf bytes.Buffer
+	if err := msg.Serialize(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if expected := []byte{3, 0, 0, 0}; !bytes.Equal(buf.Bytes(), expected) {
+		t.Errorf("expected %x but %x", expected, buf.Bytes())
+	}
+	decoded := MsgEmpties.NewMessage().(*Empties)
+	if err := decoded.Deserialize(bytes.NewReader(buf.Bytes())); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Equal(msg) {
+		t.Errorf("expected %v but %v", msg, decoded)
+	}
+}
+
+// The generated code and DynamicMessage must agree on the wire format.
+func TestDynamicMessage(t *testing.T) {
+	dynamicType, err := ros.NewDynamicMessageType(MsgKinds.Name(), MsgKinds.Text())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dynamicType.MD5Sum() != MsgKinds.MD5Sum() {
+		t.Errorf("expected MD5 sum %s but %s", MsgKinds.MD5Sum(), dynamicType.MD5Sum())
+	}
+	for _, test := range conformanceTests {
+		data := serializeKinds(t, test.msg())
+		msg := dynamicType.NewMessage()
+		if err := msg.Deserialize(bytes.NewReader(data)); err != nil {
+			t.Fatalf("%s: %v", test.fixture, err)
+		}
+		var buf bytes.Buffer
+		if err := msg.Serialize(&buf); err != nil {
+			t.Fatalf("%s: %v", test.fixture, err)
+		}
+		if !bytes.Equal(buf.Bytes(), data) {
+			t.Errorf("%s: expected\n%x\nbut\n%x", test.fixture, data, buf.Bytes())
+		}
+	}
+}
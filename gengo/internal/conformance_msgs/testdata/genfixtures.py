@@ -0,0 +1,100 @@
+#!/usr/bin/env python3
+"""Write the fixtures of conformance_msgs serialized by genpy.
+
+Message classes are generated by genpy from the definitions in this
+directory, so no catkin build is needed. The header of each fixture records
+the ROS distribution and the version of genpy which serialized it.
+
+usage: python3 genfixtures.py  (in this directory, with a ROS environment
+       sourced)
+"""
+
+import io
+import os
+
+import genmsg
+import genmsg.gentools
+import genmsg.msg_loader
+import genpy
+import genpy.dynamic
+import rospkg
+
+PKG = "conformance_msgs"
+NAMES = ("Point", "Empty", "Inner", "Kinds")
+
+
+def load_classes():
+    rospack = rospkg.RosPack()
+    search_path = {
+        PKG: [os.path.join(PKG, "msg")],
+        "std_msgs": [os.path.join(rospack.get_path("std_msgs"), "msg")],
+    }
+    ctx = genmsg.msg_loader.MsgContext.create_default()
+    spec = genmsg.msg_loader.load_msg_by_type(ctx, PKG + "/Kinds", search_path)
+    genmsg.msg_loader.load_depends(ctx, spec, search_path)
+    full_text = genmsg.gentools.compute_full_text(ctx, spec)
+    return genpy.dynamic.generate_dynamic(PKG + "/Kinds", full_text)
+
+
+def origin():
+    distro = os.environ.get("ROS_DISTRO")
+    if not distro:
+        raise SystemExit("ROS_DISTRO is not set; source a ROS environment")
+    version = rospkg.RosPack().get_manifest("genpy").version
+    return "genpy %s (ROS %s)" % (version, distro)
+
+
+def filled(classes):
+    """Keep in sync with filledKinds() in conformance_test.go."""
+    Point = classes[PKG + "/Point"]
+    Inner = classes[PKG + "/Inner"]
+    Empty = classes[PKG + "/Empty"]
+    Header = classes["std_msgs/Header"]
+    T, D = genpy.Time, genpy.Duration
+    return classes[PKG + "/Kinds"](
+        header=Header(seq=7, stamp=T(1, 2), frame_id="base"),
+        b=True, i8=-3, u8=250, by=9, ch=10,
+        i16=-300, u16=60000, i32=-70000, u32=4000000000,
+        i64=-(1 << 40), u64=1 << 63, f32=1.5, f64=-2.25,
+        s="héllo", t=T(5, 6), d=D(7, 8),
+        p=Point(x=1.0, y=-1.0),
+        inner=Inner(id=4, name="in", stamp=T(9, 10), points=[Point(x=0.5, y=0.25)]),
+        e=Empty(),
+        b_va=[True, False, True], i8_va=[-1, 2], u8_va=bytes([1, 2, 3]), by_va=[4, 5],
+        ch_va=bytes([6]), i16_va=[-2, 3], u16_va=[65535], i32_va=[-5, 6], u32_va=[7],
+        i64_va=[-8], u64_va=[9, 10], f32_va=[0.5, 1.0], f64_va=[3.0],
+        s_va=["a", "", "bc"], t_va=[T(1, 1)], d_va=[D(2, 2), D(3, 3)],
+        p_va=[Point(x=1.0, y=2.0)],
+        inner_va=[Inner(id=1, name="x", points=[]),
+                  Inner(id=2, name="", stamp=T(3, 4), points=[Point(x=5.0, y=6.0), Point(x=7.0, y=8.0)])],
+        e_va=[Empty(), Empty()],
+        b_fa=[False, True], i8_fa=[-128, 127], u8_fa=bytes([4, 5]), by_fa=[1, 2],
+        ch_fa=bytes([3, 4]), i16_fa=[-32768, 32767], u16_fa=[1, 2], i32_fa=[-1, 1],
+        u32_fa=[1, 2], i64_fa=[-1, 1], u64_fa=[1, 2], f32_fa=[0.25, -0.25],
+        f64_fa=[3.0, 4.0], s_fa=["x", "yz"], t_fa=[T(1, 2), T(3, 4)],
+        d_fa=[D(5, 6), D(7, 8)], p_fa=[Point(x=1.0, y=1.0), Point(x=2.0, y=2.0)],
+        inner_fa=[Inner(id=3, name="p", stamp=T(1, 1), points=[]),
+                  Inner(id=4, name="q", stamp=T(2, 2), points=[Point(x=9.0, y=9.0)])],
+        e_fa=[Empty(), Empty()],
+    )
+
+
+def write_fixture(filename, msg, header):
+    buf = io.BytesIO()
+    msg.serialize(buf)
+    data = buf.getvalue()
+    with open(filename, "w") as f:
+        f.write("# %s serialized by %s\n" % (msg._type, header))
+        for i in range(0, len(data), 32):
+            f.write(data[i:i + 32].hex() + "\n")
+
+
+if __name__ == "__main__":
+    classes = load_classes()
+    header = origin()
+    write_fixture("Kinds_zero.hex", classes[PKG + "/Kinds"](), header)
+    write_fixture("Kinds_filled.hex", filled(classes), header)
+    with open("md5sums.txt", "w") as f:
+        f.write("# MD5 sums computed by %s\n" % header)
+        for name in NAMES:
+            f.write("%s/%s %s\n" % (PKG, name, classes[PKG + "/" + name]._md5sum))
@@ -1,114 +1,402 @@
 package main
 
 import (
+	"bytes"
+	"encoding/json"
+	"flag"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
+
+	"github.com/akio/rosgo/msgs"
 )
 
-func writeCode(fullname string, code string) error {
+// Generator writes the code of messages and services together with the
+// messages they depend on. Each message is generated at most once.
+type Generator struct {
+	context *MsgContext
+	// Root directory of the generated packages.
+	outDir string
+	// Go import path of outDir used when generated packages import each
+	// other. If empty, ROS package names are imported as they are.
+	importPrefix string
+	// Keep files whose content would not change so that their
+	// modification times are preserved.
+	skipUnchanged bool
+	generated     map[string]bool
+}
+
+func NewGenerator(context *MsgContext, outDir string) *Generator {
+	return &Generator{
+		context:   context,
+		outDir:    outDir,
+		generated: make(map[string]bool),
+	}
+}
+
+func (g *Generator) writeCode(fullname string, code string) error {
 	nameComponents := strings.Split(fullname, "/")
-	pkgDir := filepath.Join("vendor", nameComponents[0])
-	if _, err := os.Stat(pkgDir); os.IsNotExist(err) {
-		err = os.MkdirAll(pkgDir, os.ModeDir|os.FileMode(0775))
-		if err != nil {
-			return err
-		}
+	pkgDir := filepath.Join(g.outDir, nameComponents[0])
+	if err := os.MkdirAll(pkgDir, os.ModeDir|os.FileMode(0775)); err != nil {
+		return err
 	}
 	filename := filepath.Join(pkgDir, nameComponents[1]+".go")
 
+	if g.skipUnchanged {
+		if old, err := ioutil.ReadFile(filename); err == nil && bytes.Equal(old, []byte(code)) {
+			return nil
+		}
+	}
+	fmt.Printf("Generating %v...\n", fullname)
 	return ioutil.WriteFile(filename, []byte(code), os.FileMode(0664))
 }
 
-func main() {
-	if _, err := os.Stat("vendor"); os.IsNotExist(err) {
-		err = os.Mkdir("vendor", os.ModeDir|os.FileMode(0775))
-		if err != nil {
-			fmt.Println(err)
-			os.Exit(-1)
+// Dependencies provided by msgs.ImportPrefix are imported by the generated
+// code rather than generated.
+func (g *Generator) generateDepends(spec *MsgSpec) error {
+	for _, d := range g.context.getDepends(spec) {
+		if g.isEmbedded(d) {
+			continue
+		}
+		if err := g.GenerateMsg(d); err != nil {
+			return err
 		}
 	}
+	return nil
+}
 
-	if len(os.Args) < 3 {
-		fmt.Println("USAGE: gengo msg|srv <NAME> [<FILE>]")
-		os.Exit(-1)
+func (g *Generator) generateMsgSpec(spec *MsgSpec) error {
+	if g.generated[spec.FullName] {
+		return nil
+	}
+	g.generated[spec.FullName] = true
+	if err := g.generateDepends(spec); err != nil {
+		return err
+	}
+	code, err := GenerateMessage(g.context, spec, g.importPrefix)
+	if err != nil {
+		return err
 	}
+	return g.writeCode(spec.FullName, code)
+}
+
+// Whether the message or service is provided by the packages under
+// msgs.ImportPrefix and need not be generated.
+func (g *Generator) isEmbedded(fullname string) bool {
+	return g.context.IsEmbedded(strings.Split(fullname, "/")[0])
+}
 
-	rosPkgPath := os.Getenv("ROS_PACKAGE_PATH")
+// The error for a message, service or package requested by name which is
+// provided by msgs.ImportPrefix.
+func embeddedError(name string) error {
+	pkg := strings.Split(name, "/")[0]
+	return fmt.Errorf("`%s` is not found in the ROS package paths but provided by %s/%s", name, msgs.ImportPrefix, pkg)
+}
 
-	context, err := NewMsgContext(strings.Split(rosPkgPath, ":"))
+// GenerateMsg generates the message and all messages it depends on. It
+// fails for messages provided by msgs.ImportPrefix.
+func (g *Generator) GenerateMsg(fullname string) error {
+	if g.isEmbedded(fullname) {
+		return embeddedError(fullname)
+	}
+	if g.generated[fullname] {
+		return nil
+	}
+	spec, err := g.context.LoadMsg(fullname)
 	if err != nil {
-		fmt.Println(err)
-		os.Exit(-1)
+		return err
+	}
+	return g.generateMsgSpec(spec)
+}
+
+// GenerateMsgFromFile is like GenerateMsg but reads the definition from
+// the file instead of the ROS package paths.
+func (g *Generator) GenerateMsgFromFile(filePath string, fullname string) error {
+	spec, err := g.context.LoadMsgFromFile(filePath, fullname)
+	if err != nil {
+		return err
 	}
-	mode := os.Args[1]
-	fullname := os.Args[2]
+	return g.generateMsgSpec(spec)
+}
 
-	fmt.Printf("Generating %v...", fullname)
+func (g *Generator) generateSrvSpec(spec *SrvSpec) error {
+	if g.generated[spec.FullName] {
+		return nil
+	}
+	g.generated[spec.FullName] = true
+	g.generated[spec.Request.FullName] = true
+	g.generated[spec.Response.FullName] = true
+	if err := g.generateDepends(spec.Request); err != nil {
+		return err
+	}
+	if err := g.generateDepends(spec.Response); err != nil {
+		return err
+	}
+	srvCode, reqCode, resCode, err := GenerateService(g.context, spec, g.importPrefix)
+	if err != nil {
+		return err
+	}
+	if err := g.writeCode(spec.FullName, srvCode); err != nil {
+		return err
+	}
+	if err := g.writeCode(spec.Request.FullName, reqCode); err != nil {
+		return err
+	}
+	return g.writeCode(spec.Response.FullName, resCode)
+}
 
-	if mode == "msg" {
-		var spec *MsgSpec
-		var err error
-		if len(os.Args) == 3 {
-			spec, err = context.LoadMsg(fullname)
-		} else {
-			spec, err = context.LoadMsgFromFile(os.Args[3], fullname)
-		}
-		if err != nil {
-			fmt.Println(err)
-			os.Exit(-1)
+// GenerateSrv generates the service, its request and response and all
+// messages they depend on. It fails for services provided by
+// msgs.ImportPrefix.
+func (g *Generator) GenerateSrv(fullname string) error {
+	if g.isEmbedded(fullname) {
+		return embeddedError(fullname)
+	}
+	if g.generated[fullname] {
+		return nil
+	}
+	spec, err := g.context.LoadSrv(fullname)
+	if err != nil {
+		return err
+	}
+	return g.generateSrvSpec(spec)
+}
+
+// GenerateSrvFromFile is like GenerateSrv but reads the definition from
+// the file instead of the ROS package paths.
+func (g *Generator) GenerateSrvFromFile(filePath string, fullname string) error {
+	spec, err := g.context.LoadSrvFromFile(filePath, fullname)
+	if err != nil {
+		return err
+	}
+	return g.generateSrvSpec(spec)
+}
+
+// GeneratePackage generates all messages and services of the ROS package.
+// An empty package name means all packages in the ROS package paths.
+func (g *Generator) GeneratePackage(pkg string) error {
+	if g.context.IsEmbedded(pkg) {
+		return embeddedError(pkg)
+	}
+	var msgNames, srvNames []string
+	for _, name := range g.context.MsgNames(pkg) {
+		if !g.isEmbedded(name) {
+			msgNames = append(msgNames, name)
 		}
-		var code string
-		code, err = GenerateMessage(context, spec)
-		if err != nil {
-			fmt.Println(err)
-			os.Exit(-1)
+	}
+	for _, name := range g.context.SrvNames(pkg) {
+		if !g.isEmbedded(name) {
+			srvNames = append(srvNames, name)
 		}
-		err = writeCode(fullname, code)
-		if err != nil {
-			fmt.Println(err)
-			os.Exit(-1)
-		}
-	} else if mode == "srv" {
-		var spec *SrvSpec
-		var err error
-		if len(os.Args) == 3 {
-			spec, err = context.LoadSrv(fullname)
-		} else {
-			spec, err = context.LoadSrvFromFile(os.Args[3], fullname)
+	}
+	if len(msgNames) == 0 && len(srvNames) == 0 {
+		if pkg == "" {
+			return fmt.Errorf("No message or service definitions are found")
 		}
-		if err != nil {
-			fmt.Println(err)
-			os.Exit(-1)
+		return fmt.Errorf("No message or service definitions of `%s` are found", pkg)
+	}
+	for _, name := range msgNames {
+		if err := g.GenerateMsg(name); err != nil {
+			return err
 		}
-		srvCode, reqCode, resCode, err := GenerateService(context, spec)
-		if err != nil {
-			fmt.Println(err)
-			os.Exit(-1)
+	}
+	for _, name := range srvNames {
+		if err := g.GenerateSrv(name); err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
-		err = writeCode(fullname, srvCode)
-		if err != nil {
-			fmt.Println(err)
-			os.Exit(-1)
+func usage() {
+	fmt.Fprintln(os.Stderr, "USAGE: gengo [<OPTIONS>] msg|srv <NAME> [<FILE>]")
+	fmt.Fprintln(os.Stderr, "       gengo [<OPTIONS>] pkg <ROS_PACKAGE>")
+	fmt.Fprintln(os.Stderr, "       gengo [<OPTIONS>] all")
+	fmt.Fprintln(os.Stderr, "       gengo [<OPTIONS>] check <DIR>")
+	fmt.Fprintln(os.Stderr, "       gengo [<OPTIONS>] compat <OLD_DIR> <NEW_DIR>")
+	fmt.Fprintln(os.Stderr, "OPTIONS:")
+	flag.PrintDefaults()
+}
+
+func run() error {
+	skipUnchanged := flag.Bool("skip-unchanged", false, "do not rewrite generated files whose content is unchanged")
+	outDir := flag.String("out", "vendor", "directory where a Go package is generated for each ROS package")
+	importPrefix := flag.String("import", "", "Go import path of the -out directory (e.g. github.com/user/robot/msgs)")
+	rosPkgPath := flag.String("path", os.Getenv("ROS_PACKAGE_PATH"), "directories to search for ROS packages")
+	jsonOutput := flag.Bool("json", false, "print the results of check and compat as JSON")
+	flag.Usage = usage
+	flag.Parse()
+	args := flag.Args()
+
+	if len(args) < 1 {
+		usage()
+		os.Exit(-1)
+	}
+	mode := args[0]
+	switch {
+	case (mode == "msg" || mode == "srv") && (len(args) == 2 || len(args) == 3):
+	case mode == "pkg" && len(args) == 2:
+	case mode == "all" && len(args) == 1:
+	case mode == "check" && len(args) == 2:
+	case mode == "compat" && len(args) == 3:
+	default:
+		usage()
+		os.Exit(-1)
+	}
+
+	if mode == "check" {
+		return check(filepath.SplitList(*rosPkgPath), args[1], *jsonOutput)
+	}
+	if mode == "compat" {
+		return compat(filepath.SplitList(*rosPkgPath), args[1], args[2], *jsonOutput)
+	}
+
+	context, err := NewMsgContext(filepath.SplitList(*rosPkgPath))
+	if err != nil {
+		return err
+	}
+	conflicts, err := context.EmbeddedConflicts()
+	if err != nil {
+		return err
+	}
+	if len(conflicts) > 0 {
+		return fmt.Errorf("Definitions in the ROS package paths differ from the ones shipped under %s:\n  %s",
+			msgs.ImportPrefix, strings.Join(conflicts, "\n  "))
+	}
+
+	g := NewGenerator(context, *outDir)
+	g.importPrefix = *importPrefix
+	g.skipUnchanged = *skipUnchanged
+
+	switch mode {
+	case "msg":
+		if len(args) == 2 {
+			err = g.GenerateMsg(args[1])
+		} else {
+			err = g.GenerateMsgFromFile(args[2], args[1])
+		}
+	case "srv":
+		if len(args) == 2 {
+			err = g.GenerateSrv(args[1])
+		} else {
+			err = g.GenerateSrvFromFile(args[2], args[1])
 		}
+	case "pkg":
+		err = g.GeneratePackage(args[1])
+	default:
+		err = g.GeneratePackage("")
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Println("Done")
+	return nil
+}
 
-		err = writeCode(spec.Request.FullName, reqCode)
+// Print the MD5 sums of the definitions under the directory and fail if
+// any of them is invalid.
+func check(rosPkgPaths []string, dir string, jsonOutput bool) error {
+	results, err := CheckDefinitions(rosPkgPaths, dir)
+	if err != nil {
+		return err
+	}
+	numErrors := 0
+	for _, r := range results {
+		numErrors += len(r.Errors)
+	}
+	if jsonOutput {
+		out, err := json.MarshalIndent(results, "", "  ")
 		if err != nil {
-			fmt.Println(err)
-			os.Exit(-1)
+			return err
+		}
+		fmt.Println(string(out))
+	} else {
+		for _, r := range results {
+			if r.OK() {
+				fmt.Printf("%s  %s\n", r.MD5Sum, r.Name)
+			}
+			for _, e := range r.Errors {
+				fmt.Fprintf(os.Stderr, "%s: %s\n", r.File, e)
+			}
 		}
+	}
+	if numErrors > 0 {
+		return fmt.Errorf("%d errors are found in %s", numErrors, dir)
+	}
+	return nil
+}
 
-		err = writeCode(spec.Response.FullName, resCode)
+// Print how the messages and services differ between the directories.
+func compat(rosPkgPaths []string, oldDir string, newDir string, jsonOutput bool) error {
+	results, err := CompareDefinitions(rosPkgPaths, oldDir, newDir)
+	if err != nil {
+		return err
+	}
+	if jsonOutput {
+		out, err := json.MarshalIndent(results, "", "  ")
 		if err != nil {
-			fmt.Println(err)
-			os.Exit(-1)
+			return err
 		}
+		fmt.Println(string(out))
 	} else {
-		fmt.Println("USAGE: genmsg <MSG>")
+		for _, r := range results {
+			printCompatResult(&r)
+		}
+	}
+	for _, r := range results {
+		if len(r.Errors) > 0 {
+			return fmt.Errorf("Invalid definitions are found")
+		}
+	}
+	return nil
+}
+
+func printCompatResult(r *CompatResult) {
+	switch r.Status {
+	case "unchanged", "added", "removed":
+		fmt.Printf("%s: %s\n", r.Name, r.Status)
+		return
+	case "invalid":
+		fmt.Printf("%s: invalid\n", r.Name)
+		for _, e := range r.Errors {
+			fmt.Printf("    %s\n", e)
+		}
+		return
+	}
+	fmt.Printf("%s: %s %s -> %s (migration: %s)\n", r.Name, r.Status, r.OldMD5Sum, r.NewMD5Sum, r.Migration)
+	for _, f := range r.Fields {
+		switch {
+		case f.OldType == "":
+			fmt.Printf("    + %s %s\n", f.NewType, f.Name)
+		case f.NewType == "":
+			fmt.Printf("    - %s %s\n", f.OldType, f.Name)
+		default:
+			fmt.Printf("    ~ %s %s -> %s (%s)\n", f.OldType, f.Name, f.NewType, f.Migration)
+		}
+	}
+	for _, c := range r.Constants {
+		switch {
+		case c.Old == "":
+			fmt.Printf("    + %s\n", c.New)
+		case c.New == "":
+			fmt.Printf("    - %s\n", c.Old)
+		default:
+			fmt.Printf("    ~ %s -> %s\n", c.Old, c.New)
+		}
+	}
+	if r.Reordered {
+		fmt.Println("    fields are reordered")
+	}
+	for _, d := range r.ChangedDepends {
+		fmt.Printf("    depends on changed %s\n", d)
+	}
+}
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
 		os.Exit(-1)
 	}
-	fmt.Println("Done")
 }
@@ -0,0 +1,251 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeRosPackage(t *testing.T, root string, pkg string, files map[string]string) {
+	pkgDir := filepath.Join(root, pkg)
+	for _, dir := range []string{"msg", "srv"} {
+		if err := os.MkdirAll(filepath.Join(pkgDir, dir), 0775); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ioutil.WriteFile(filepath.Join(pkgDir, "package.xml"), []byte("<package/>"), 0664); err != nil {
+		t.Fatal(err)
+	}
+	for name, text := range files {
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(pkgDir, name)), 0775); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(pkgDir, name), []byte(text), 0664); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func listGenerated(t *testing.T, outDir string) []string {
+	var files []string
+	err := filepath.Walk(outDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			rel, _ := filepath.Rel(outDir, path)
+			files = append(files, filepath.ToSlash(rel))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(files)
+	return files
+}
+
+func newTestGenerator(t *testing.T) (*Generator, string) {
+	root := t.TempDir()
+	writeRosPackage(t, root, "std_msgs", map[string]string{
+		"msg/Header.msg": "uint32 seq\ntime stamp\nstring frame_id\n",
+		"msg/String.msg": "string data\n",
+	})
+	writeRosPackage(t, root, "geometry_msgs", map[string]string{
+		"msg/Point.msg":        "float64 x\nfloat64 y\nfloat64 z\n",
+		"msg/PointStamped.msg": "Header header\nPoint point\n",
+	})
+	writeRosPackage(t, root, "foo_msgs", map[string]string{
+		"msg/Path.msg":   "geometry_msgs/PointStamped[] points\n",
+		"srv/Lookup.srv": "std_msgs/String name\n---\ngeometry_msgs/Point point\n",
+	})
+	ctx, err := NewMsgContext([]string{root})
+	if err != nil {
+		t.Fatal(err)
+	}
+	outDir := t.TempDir()
+	return NewGenerator(ctx, outDir), outDir
+}
+
+func checkFiles(t *testing.T, expected []string, actual []string) {
+	if len(expected) != len(actual) {
+		t.Fatalf("expected %v but %v", expected, actual)
+	}
+	for i := range expected {
+		if expected[i] != actual[i] {
+			t.Fatalf("expected %v but %v", expected, actual)
+		}
+	}
+}
+
+func TestGenerateMsgWithDepends(t *testing.T) {
+	g, outDir := newTestGenerator(t)
+	if err := g.GenerateMsg("foo_msgs/Path"); err != nil {
+		t.Fatal(err)
+	}
+	checkFiles(t, []string{
+		"foo_msgs/Path.go",
+		"geometry_msgs/Point.go",
+		"geometry_msgs/PointStamped.go",
+		"std_msgs/Header.go",
+	}, listGenerated(t, outDir))
+}
+
+func TestGeneratePackage(t *testing.T) {
+	g, outDir := newTestGenerator(t)
+	if err := g.GeneratePackage("foo_msgs"); err != nil {
+		t.Fatal(err)
+	}
+	checkFiles(t, []string{
+		"foo_msgs/Lookup.go",
+		"foo_msgs/LookupRequest.go",
+		"foo_msgs/LookupResponse.go",
+		"foo_msgs/Path.go",
+		"geometry_msgs/Point.go",
+		"geometry_msgs/PointStamped.go",
+		"std_msgs/Header.go",
+		"std_msgs/String.go",
+	}, listGenerated(t, outDir))
+
+	if err := g.GeneratePackage("bar_msgs"); err == nil {
+		t.Error("generating an unknown package should fail")
+	}
+}
+
+func TestGenerateAll(t *testing.T) {
+	g, outDir := newTestGenerator(t)
+	if err := g.GeneratePackage(""); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(listGenerated(t, outDir)); n != 8 {
+		t.Errorf("expected 8 files but %d", n)
+	}
+}
+
+func TestGenerateSkipUnchanged(t *testing.T) {
+	g, outDir := newTestGenerator(t)
+	if err := g.GenerateMsg("std_msgs/String"); err != nil {
+		t.Fatal(err)
+	}
+	filename := filepath.Join(outDir, "std_msgs", "String.go")
+	past := time.Now().Add(-time.Hour).Truncate(time.Second)
+	if err := os.Chtimes(filename, past, past); err != nil {
+		t.Fatal(err)
+	}
+
+	g = NewGenerator(g.context, outDir)
+	g.skipUnchanged = true
+	if err := g.GenerateMsg("std_msgs/String"); err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !info.ModTime().Equal(past) {
+		t.Error("unchanged file is rewritten")
+	}
+}
+
+func TestGenerateWithImportPrefix(t *testing.T) {
+	g, outDir := newTestGenerator(t)
+	g.importPrefix = "example.com/robot/msgs"
+	if err := g.GenerateMsg("foo_msgs/Path"); err != nil {
+		t.Fatal(err)
+	}
+	readCode := func(name string) string {
+		code, err := ioutil.ReadFile(filepath.Join(outDir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(code)
+	}
+
+	code := readCode("foo_msgs/Path.go")
+	if !strings.Contains(code, `"example.com/robot/msgs/geometry_msgs"`) {
+		t.Errorf("geometry_msgs is not imported with the prefix:\n%s", code)
+	}
+
+	code = readCode("geometry_msgs/PointStamped.go")
+	if !strings.Contains(code, `"example.com/robot/msgs/std_msgs"`) {
+		t.Errorf("std_msgs is not imported with the prefix:\n%s", code)
+	}
+	if strings.Contains(code, "msgs/geometry_msgs\"") {
+		t.Errorf("geometry_msgs imports itself:\n%s", code)
+	}
+	if strings.Contains(code, "geometry_msgs.Point") {
+		t.Errorf("Point of the same package is qualified:\n%s", code)
+	}
+}
+
+func TestGenerateWithEmbeddedDepends(t *testing.T) {
+	root := t.TempDir()
+	writeRosPackage(t, root, "foo_msgs", map[string]string{
+		"msg/Target.msg": "Header header\ngeometry_msgs/Pose pose\n",
+	})
+	ctx, err := NewMsgContext([]string{root})
+	if err != nil {
+		t.Fatal(err)
+	}
+	outDir := t.TempDir()
+	g := NewGenerator(ctx, outDir)
+	if err := g.GeneratePackage(""); err != nil {
+		t.Fatal(err)
+	}
+	checkFiles(t, []string{"foo_msgs/Target.go"}, listGenerated(t, outDir))
+
+	code, err := ioutil.ReadFile(filepath.Join(outDir, "foo_msgs", "Target.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, imp := range []string{`"github.com/akio/rosgo/msgs/std_msgs"`, `"github.com/akio/rosgo/msgs/geometry_msgs"`} {
+		if !strings.Contains(string(code), imp) {
+			t.Errorf("%s is not imported:\n%s", imp, code)
+		}
+	}
+
+	if err := g.GeneratePackage("std_msgs"); err == nil {
+		t.Error("generating an embedded package should fail")
+	}
+	err = g.GenerateMsg("std_msgs/String")
+	if err == nil || !strings.Contains(err.Error(), "github.com/akio/rosgo/msgs/std_msgs") {
+		t.Errorf("unexpected error %v for an embedded message", err)
+	}
+	if err := g.GenerateSrv("std_srvs/SetBool"); err == nil {
+		t.Error("generating an embedded service should fail")
+	}
+	checkFiles(t, []string{"foo_msgs/Target.go"}, listGenerated(t, outDir))
+}
+
+// The conformance tests in internal/conformance_msgs run the committed
+// code, so it must be what the generator produces now.
+func TestConformanceCodeIsUpToDate(t *testing.T) {
+	pkgDir := filepath.Join("internal", "conformance_msgs")
+	ctx, err := NewMsgContext([]string{filepath.Join(pkgDir, "testdata")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	outDir := t.TempDir()
+	g := NewGenerator(ctx, outDir)
+	g.importPrefix = "github.com/akio/rosgo/gengo/internal"
+	if err := g.GeneratePackage("conformance_msgs"); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range listGenerated(t, outDir) {
+		generated, err := ioutil.ReadFile(filepath.Join(outDir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		committed, err := ioutil.ReadFile(filepath.Join("internal", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(generated) != string(committed) {
+			t.Errorf("%s is out of date; run go generate ./gengo/internal/conformance_msgs", name)
+		}
+	}
+}
@@ -33,7 +33,7 @@ var BuiltinTypes = append([]string{TimeType, DurationType}, PrimitiveTypes...)
 
 var ResourceNameLegalCharsPattern = regexp.MustCompile(`^[A-Za-z][\w_\/]*$`)
 
-var BaseResourceNameLegalCharsPattern = regexp.MustCompile(`"[A-Za-z][\w_]*$`)
+var BaseResourceNameLegalCharsPattern = regexp.MustCompile(`^[A-Za-z][\w_]*$`)
 
 func isValidConsantType(t string) bool {
 	for _, e := range PrimitiveTypes {
@@ -49,16 +49,16 @@ func isValidMsgFieldName(name string) bool {
 }
 
 func isLegalResourceBaseName(name string) bool {
+	return BaseResourceNameLegalCharsPattern.MatchString(name)
+}
+
+func isLegalResourceName(name string) bool {
 	if strings.Contains(name, "//") {
 		return false
 	}
 	return ResourceNameLegalCharsPattern.MatchString(name)
 }
 
-func isLegalResourceName(name string) bool {
-	return BaseResourceNameLegalCharsPattern.MatchString(name)
-}
-
 func isPrimitiveType(name string) bool {
 	for _, t := range PrimitiveTypes {
 		if t == name {
@@ -126,7 +126,7 @@ func isValidMsgType(t string) bool {
 		return false
 	}
 	base := baseMsgType(t)
-	if !isLegalResourceBaseName(base) {
+	if !isLegalResourceName(base) {
 		return false
 	}
 
@@ -8,11 +8,13 @@ import (
 )
 
 const (
-	Sep    = "/"
-	MsgDir = "msg"
-	SrvDir = "srv"
-	ExtMsg = ".msg"
-	ExtSrv = ".msg"
+	Sep       = "/"
+	MsgDir    = "msg"
+	SrvDir    = "srv"
+	ActionDir = "action"
+	ExtMsg    = ".msg"
+	ExtSrv    = ".srv"
+	ExtAction = ".action"
 
 	ConstChar   = "="
 	CommentChar = "#"
@@ -147,16 +149,19 @@ func loadConstantLine(line string) (*Constant, error) {
 		valueText = strings.TrimSpace(kvSplits[1])
 	}
 
+	if !isLegalResourceBaseName(name) {
+		return nil, fmt.Errorf("%s is not a legal constant name", name)
+	}
 	value, e := convertConstantValue(fieldType, valueText)
 	if e != nil {
-		return nil, e
+		return nil, fmt.Errorf("Invalid value for %s constant %s: %v", fieldType, name, e)
 	}
 	return NewConstant(fieldType, name, value, valueText), nil
 }
 
 func loadFieldLine(line string, packageName string) (*Field, error) {
 	cleanLine := stripComment(line)
-	lineSplits := strings.SplitN(cleanLine, " ", 2)
+	lineSplits := strings.Fields(cleanLine)
 	if len(lineSplits) != 2 {
 		return nil, fmt.Errorf("Invalid declaration: %s", line)
 	}
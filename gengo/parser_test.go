@@ -116,14 +116,18 @@ Bar[] xva
 Bar[42] xfa
 `
 
-	ctx, e := NewMsgContext()
+	ctx, e := NewMsgContext([]string{})
 	if e != nil {
 		t.Errorf("Failed to create MsgContext.")
 	}
+	// Unknown dependencies are errors, so foo/Bar must be known.
+	if _, e = ctx.LoadMsgFromString("int32 x", "foo/Bar"); e != nil {
+		t.Fatalf("Failed to parse foo/Bar: %v", e)
+	}
 	var spec *MsgSpec
-	spec, e = LoadMsgFromString(ctx, text, "foo/Foo")
+	spec, e = ctx.LoadMsgFromString(text, "foo/Foo")
 	if e != nil {
-		t.Errorf("Failed to parse: %v", e)
+		t.Fatalf("Failed to parse: %v", e)
 	}
 	fmt.Println("---")
 	fmt.Println(spec.String())
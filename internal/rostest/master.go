@@ -0,0 +1,282 @@
+package rostest
+
+import (
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/akio/rosgo/ros"
+	"github.com/akio/rosgo/xmlrpc"
+)
+
+const (
+	apiStatusError   = int32(-1)
+	apiStatusSuccess = int32(1)
+)
+
+func apiResult(code int32, msg string, value interface{}) (interface{}, error) {
+	return []interface{}{code, msg, value}, nil
+}
+
+// Master is a ROS master for tests. It keeps the registrations of topics
+// and services and the parameters, and notifies subscribers of new
+// publishers as roscore does.
+type Master struct {
+	uri         string
+	server      *http.Server
+	handler     *xmlrpc.Handler
+	mutex       sync.Mutex
+	publishers  map[string][]string
+	subscribers map[string][]string
+	services    map[string]string
+	params      map[string]interface{}
+	notifyMutex sync.Mutex
+	notifyGroup sync.WaitGroup
+}
+
+// StartMaster starts a master listening on the loopback interface. It is
+// stopped when the test finishes.
+func StartMaster(t testing.TB) *Master {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &Master{
+		uri:         "http://" + listener.Addr().String(),
+		publishers:  make(map[string][]string),
+		subscribers: make(map[string][]string),
+		services:    make(map[string]string),
+		params:      make(map[string]interface{}),
+	}
+	m.handler = xmlrpc.NewHandler(map[string]xmlrpc.Method{
+		"registerPublisher":    m.registerPublisher,
+		"unregisterPublisher":  m.unregisterPublisher,
+		"registerSubscriber":   m.registerSubscriber,
+		"unregisterSubscriber": m.unregisterSubscriber,
+		"registerService":      m.registerService,
+		"unregisterService":    m.unregisterService,
+		"lookupService":        m.lookupService,
+		"getParam":             m.getParam,
+		"setParam":             m.setParam,
+		"hasParam":             m.hasParam,
+		"searchParam":          m.searchParam,
+		"deleteParam":          m.deleteParam,
+	})
+	m.server = &http.Server{Handler: m.handler}
+	go m.server.Serve(listener)
+	t.Cleanup(m.stop)
+	return m
+}
+
+// Idle connections are closed too, or clients keeping them alive would
+// reach this master at its port after a node takes it.
+func (m *Master) stop() {
+	m.server.Close()
+	m.handler.WaitForShutdown()
+	m.notifyGroup.Wait()
+}
+
+// URI returns the XML-RPC URI of the master.
+func (m *Master) URI() string {
+	return m.uri
+}
+
+// NewNode creates a node registering to the master. Its logger only logs
+// fatal messages and it is shut down when the test finishes.
+func (m *Master) NewNode(t testing.TB, name string) ros.Node {
+	node, err := ros.NewNode(name, []string{"__master:=" + m.uri, "__ip:=127.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	node.Logger().SetSeverity(ros.LogLevelFatal)
+	t.Cleanup(node.Shutdown)
+	return node
+}
+
+func appendOnce(list []string, s string) []string {
+	for _, item := range list {
+		if item == s {
+			return list
+		}
+	}
+	return append(list, s)
+}
+
+func remove(list []string, s string) []string {
+	for i, item := range list {
+		if item == s {
+			return append(list[:i:i], list[i+1:]...)
+		}
+	}
+	return list
+}
+
+func toInterfaces(list []string) []interface{} {
+	result := make([]interface{}, 0, len(list))
+	for _, s := range list {
+		result = append(result, s)
+	}
+	return result
+}
+
+// Tell the subscribers of the topic its current publishers. Notifications
+// are serialized, so that the last one has the latest publishers.
+func (m *Master) notifySubscribers(topic string) {
+	m.notifyGroup.Add(1)
+	go func() {
+		defer m.notifyGroup.Done()
+		m.notifyMutex.Lock()
+		defer m.notifyMutex.Unlock()
+		m.mutex.Lock()
+		publishers := toInterfaces(m.publishers[topic])
+		subscribers := append([]string{}, m.subscribers[topic]...)
+		m.mutex.Unlock()
+		for _, api := range subscribers {
+			// Subscribers shut down meanwhile fail, which is fine.
+			xmlrpc.Call(api, "publisherUpdate", "/master", topic, publishers)
+		}
+	}()
+}
+
+func (m *Master) registerPublisher(callerId, topic, topicType, callerApi string) (interface{}, error) {
+	m.mutex.Lock()
+	m.publishers[topic] = appendOnce(m.publishers[topic], callerApi)
+	subscribers := toInterfaces(m.subscribers[topic])
+	m.mutex.Unlock()
+	m.notifySubscribers(topic)
+	return apiResult(apiStatusSuccess, "", subscribers)
+}
+
+func (m *Master) unregisterPublisher(callerId, topic, callerApi string) (interface{}, error) {
+	m.mutex.Lock()
+	m.publishers[topic] = remove(m.publishers[topic], callerApi)
+	m.mutex.Unlock()
+	m.notifySubscribers(topic)
+	return apiResult(apiStatusSuccess, "", int32(1))
+}
+
+func (m *Master) registerSubscriber(callerId, topic, topicType, callerApi string) (interface{}, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.subscribers[topic] = appendOnce(m.subscribers[topic], callerApi)
+	return apiResult(apiStatusSuccess, "", toInterfaces(m.publishers[topic]))
+}
+
+func (m *Master) unregisterSubscriber(callerId, topic, callerApi string) (interface{}, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.subscribers[topic] = remove(m.subscribers[topic], callerApi)
+	return apiResult(apiStatusSuccess, "", int32(1))
+}
+
+func (m *Master) registerService(callerId, service, serviceApi, callerApi string) (interface{}, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.services[service] = serviceApi
+	return apiResult(apiStatusSuccess, "", int32(1))
+}
+
+// The node passes its XML-RPC URI rather than the service URI, so the
+// service is removed whichever was registered.
+func (m *Master) unregisterService(callerId, service, serviceApi string) (interface{}, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	delete(m.services, service)
+	return apiResult(apiStatusSuccess, "", int32(1))
+}
+
+func (m *Master) lookupService(callerId, service string) (interface{}, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	api, ok := m.services[service]
+	if !ok {
+		return apiResult(apiStatusError, "no provider", "")
+	}
+	return apiResult(apiStatusSuccess, "", api)
+}
+
+// Parameters are kept by their full names. Getting a namespace returns its
+// parameters as a dictionary.
+func (m *Master) getParam(callerId, key string) (interface{}, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if value, ok := m.params[key]; ok {
+		return apiResult(apiStatusSuccess, "", value)
+	}
+	namespace := strings.TrimSuffix(key, "/") + "/"
+	dict := make(map[string]interface{})
+	for name, value := range m.params {
+		if !strings.HasPrefix(name, namespace) {
+			continue
+		}
+		parts := strings.Split(strings.TrimPrefix(name, namespace), "/")
+		d := dict
+		for _, part := range parts[:len(parts)-1] {
+			child, ok := d[part].(map[string]interface{})
+			if !ok {
+				child = make(map[string]interface{})
+				d[part] = child
+			}
+			d = child
+		}
+		d[parts[len(parts)-1]] = value
+	}
+	if len(dict) == 0 {
+		return apiResult(apiStatusError, "parameter ["+key+"] is not set", 0)
+	}
+	return apiResult(apiStatusSuccess, "", dict)
+}
+
+func (m *Master) setParam(callerId, key string, value interface{}) (interface{}, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.params[key] = value
+	return apiResult(apiStatusSuccess, "", int32(0))
+}
+
+func (m *Master) hasParam(callerId, key string) (interface{}, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	_, ok := m.params[key]
+	return apiResult(apiStatusSuccess, "", ok)
+}
+
+// Look for the key in the namespace of the caller and then in its parents.
+func (m *Master) searchParam(callerId, key string) (interface{}, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	namespace := callerId
+	for namespace != "" {
+		namespace = namespace[:strings.LastIndex(namespace, "/")]
+		name := namespace + "/" + strings.TrimPrefix(key, "/")
+		if _, ok := m.params[name]; ok {
+			return apiResult(apiStatusSuccess, "", name)
+		}
+	}
+	return apiResult(apiStatusError, "parameter ["+key+"] is not found", "")
+}
+
+func (m *Master) deleteParam(callerId, key string) (interface{}, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if _, ok := m.params[key]; !ok {
+		return apiResult(apiStatusError, "parameter ["+key+"] is not set", int32(0))
+	}
+	delete(m.params, key)
+	return apiResult(apiStatusSuccess, "", int32(0))
+}
+
+// Params returns the names of the parameters set.
+func (m *Master) Params() []string {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	var names []string
+	for name := range m.params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
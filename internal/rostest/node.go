@@ -0,0 +1,453 @@
+// Package rostest holds the test doubles shared by the tests of tf2,
+// message_filters, diagnostic_updater and dynamic_reconfigure. It is not
+// part of the API of rosgo.
+//
+// Node is a fake ros.Node which connects its publishers, subscribers and
+// services in memory, so that code using them can be tested without a
+// master and without Spin:
+//
+//	node := rostest.NewNode("/test_node")
+//	pub := node.NewPublisher("/chatter", std_msgs.MsgString)
+//	pub.Publish(&std_msgs.String{Data: "hello"})
+//	msgs := node.Published("/chatter")
+//
+// Master is a ROS master running in the test, so that real nodes can talk
+// to each other over TCPROS on the loopback interface:
+//
+//	master := rostest.StartMaster(t)
+//	talker := master.NewNode(t, "/talker")
+//	listener := master.NewNode(t, "/listener")
+package rostest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/akio/rosgo/ros"
+)
+
+// Node is a fake ros.Node. Messages published on a topic are passed as
+// they are, without serialization, to the subscribers of the topic on the
+// same Node. Callbacks are called by Publish and service handlers by Call,
+// on the goroutine of the caller. Channels of SubscribeChan drop their
+// oldest message when full; options of SubscribeChan are ignored.
+type Node struct {
+	name     string
+	logger   ros.Logger
+	mutex    sync.Mutex
+	topics   map[string]*topic
+	services map[string]*service
+	params   map[string]interface{}
+	quitChan chan struct{}
+	quitOnce sync.Once
+}
+
+var _ ros.Node = (*Node)(nil)
+
+type topic struct {
+	latched     bool
+	publishers  int
+	subscribers []*subscriber
+	published   []ros.Message
+}
+
+type service struct {
+	srvType ros.ServiceType
+	handler func(ros.Service) error
+}
+
+// NewNode creates a node with the fully qualified name, e.g. "/test_node".
+func NewNode(name string) *Node {
+	return &Node{
+		name:     "/" + strings.Trim(name, "/"),
+		logger:   ros.NewDefaultLogger(),
+		topics:   make(map[string]*topic),
+		services: make(map[string]*service),
+		params:   make(map[string]interface{}),
+		quitChan: make(chan struct{}),
+	}
+}
+
+// Resolve returns the global name of a graph resource name. Private names
+// start with the name of the node and relative ones with its namespace.
+func (n *Node) Resolve(name string) string {
+	switch {
+	case strings.HasPrefix(name, "/"):
+		return name
+	case strings.HasPrefix(name, "~"):
+		return n.name + "/" + strings.TrimPrefix(name[1:], "/")
+	}
+	namespace := n.name[:strings.LastIndex(n.name, "/")]
+	return namespace + "/" + name
+}
+
+// Call with the mutex locked.
+func (n *Node) topic(name string) *topic {
+	t, ok := n.topics[name]
+	if !ok {
+		t = &topic{}
+		n.topics[name] = t
+	}
+	return t
+}
+
+// Published returns the messages published on the topic so far.
+func (n *Node) Published(topic string) []ros.Message {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	t, ok := n.topics[n.Resolve(topic)]
+	if !ok {
+		return nil
+	}
+	return append([]ros.Message{}, t.published...)
+}
+
+// Advertised reports whether the topic has publishers which are not shut
+// down.
+func (n *Node) Advertised(topic string) bool {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	t, ok := n.topics[n.Resolve(topic)]
+	return ok && t.publishers > 0
+}
+
+// Latched reports whether the topic is published by a latched publisher.
+func (n *Node) Latched(topic string) bool {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	t, ok := n.topics[n.Resolve(topic)]
+	return ok && t.latched
+}
+
+// Subscribed reports whether the topic has subscribers which are not shut
+// down.
+func (n *Node) Subscribed(topic string) bool {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	t, ok := n.topics[n.Resolve(topic)]
+	return ok && len(t.subscribers) > 0
+}
+
+// Served reports whether the service has a server which is not shut down.
+func (n *Node) Served(service string) bool {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	_, ok := n.services[n.Resolve(service)]
+	return ok
+}
+
+type publisher struct {
+	node     *Node
+	topic    string
+	shutdown bool
+}
+
+func (n *Node) newPublisher(name string, latched bool) *publisher {
+	name = n.Resolve(name)
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	t := n.topic(name)
+	t.publishers++
+	t.latched = t.latched || latched
+	return &publisher{node: n, topic: name}
+}
+
+// Publish records the message and passes it to the subscribers.
+func (p *publisher) Publish(msg ros.Message) {
+	p.node.mutex.Lock()
+	t := p.node.topic(p.topic)
+	t.published = append(t.published, msg)
+	subscribers := append([]*subscriber{}, t.subscribers...)
+	p.node.mutex.Unlock()
+	for _, s := range subscribers {
+		s.deliver(msg, p.node.name)
+	}
+}
+
+func (p *publisher) Shutdown() {
+	p.node.mutex.Lock()
+	defer p.node.mutex.Unlock()
+	if !p.shutdown {
+		p.shutdown = true
+		p.node.topic(p.topic).publishers--
+	}
+}
+
+func (n *Node) NewPublisher(topic string, msgType ros.MessageType) ros.Publisher {
+	return n.newPublisher(topic, false)
+}
+
+// NewPublisherWithCallbacks creates a publisher. The callbacks are never
+// called, as subscribers do not connect.
+func (n *Node) NewPublisherWithCallbacks(topic string, msgType ros.MessageType, connectCallback, disconnectCallback func(ros.SingleSubscriberPublisher)) ros.Publisher {
+	return n.newPublisher(topic, false)
+}
+
+// NewLatchedPublisher creates a publisher whose last message is passed to
+// subscribers created later.
+func (n *Node) NewLatchedPublisher(topic string, msgType ros.MessageType) ros.Publisher {
+	return n.newPublisher(topic, true)
+}
+
+type subscriber struct {
+	node     *Node
+	topic    string
+	mutex    sync.Mutex
+	callback reflect.Value
+	ch       chan ros.ReceivedMessage
+	shutdown bool
+}
+
+// Call the callback as ros calls subscriber callbacks, or send to the
+// channel.
+func (s *subscriber) deliver(msg ros.Message, publisherName string) {
+	event := ros.MessageEvent{PublisherName: publisherName, ReceiptTime: time.Now()}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.shutdown {
+		return
+	}
+	if s.ch == nil {
+		args := []reflect.Value{reflect.ValueOf(msg), reflect.ValueOf(event)}
+		s.callback.Call(args[:s.callback.Type().NumIn()])
+		return
+	}
+	received := ros.ReceivedMessage{Message: msg, Event: event}
+	for {
+		select {
+		case s.ch <- received:
+			return
+		default:
+		}
+		select {
+		case <-s.ch:
+		default:
+		}
+	}
+}
+
+func (s *subscriber) GetNumPublishers() int {
+	s.node.mutex.Lock()
+	defer s.node.mutex.Unlock()
+	return s.node.topic(s.topic).publishers
+}
+
+func (s *subscriber) Shutdown() {
+	s.node.mutex.Lock()
+	t := s.node.topic(s.topic)
+	for i, other := range t.subscribers {
+		if other == s {
+			t.subscribers = append(t.subscribers[:i], t.subscribers[i+1:]...)
+			break
+		}
+	}
+	s.node.mutex.Unlock()
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if !s.shutdown {
+		s.shutdown = true
+		if s.ch != nil {
+			close(s.ch)
+		}
+	}
+}
+
+// Add the subscriber and pass it the latched message.
+func (n *Node) subscribe(name string, s *subscriber) {
+	s.node, s.topic = n, n.Resolve(name)
+	n.mutex.Lock()
+	t := n.topic(s.topic)
+	t.subscribers = append(t.subscribers, s)
+	var latched ros.Message
+	if t.latched && len(t.published) > 0 {
+		latched = t.published[len(t.published)-1]
+	}
+	n.mutex.Unlock()
+	if latched != nil {
+		s.deliver(latched, n.name)
+	}
+}
+
+// NewSubscriber subscribes to the topic. The callback takes up to two
+// arguments, the message and ros.MessageEvent, as for ros.Node.
+func (n *Node) NewSubscriber(topic string, msgType ros.MessageType, callback interface{}) ros.Subscriber {
+	fun := reflect.ValueOf(callback)
+	if fun.Kind() != reflect.Func || fun.Type().NumIn() > 2 {
+		panic(fmt.Sprintf("rostest: invalid callback %T", callback))
+	}
+	s := &subscriber{callback: fun}
+	n.subscribe(topic, s)
+	return s
+}
+
+func (n *Node) SubscribeChan(topic string, msgType ros.MessageType, bufferSize int, options ...ros.SubscribeChanOption) (<-chan ros.ReceivedMessage, ros.Subscriber) {
+	if bufferSize < 1 {
+		bufferSize = 1
+	}
+	s := &subscriber{ch: make(chan ros.ReceivedMessage, bufferSize)}
+	n.subscribe(topic, s)
+	return s.ch, s
+}
+
+// NewServiceServer serves the service. The handler takes the service type,
+// or its request and response types, and returns error, as for ros.Node.
+// The options are ignored.
+func (n *Node) NewServiceServer(name string, srvType ros.ServiceType, handler interface{}, options ...ros.ServiceServerOption) ros.ServiceServer {
+	fun := reflect.ValueOf(handler)
+	if fun.Kind() != reflect.Func || fun.Type().NumIn() < 1 || fun.Type().NumIn() > 2 || fun.Type().NumOut() != 1 {
+		panic(fmt.Sprintf("rostest: invalid handler %T", handler))
+	}
+	s := &service{srvType: srvType}
+	s.handler = func(srv ros.Service) error {
+		args := []reflect.Value{reflect.ValueOf(srv)}
+		if fun.Type().NumIn() == 2 {
+			args = []reflect.Value{reflect.ValueOf(srv.ReqMessage()), reflect.ValueOf(srv.ResMessage())}
+		}
+		err, _ := fun.Call(args)[0].Interface().(error)
+		return err
+	}
+	name = n.Resolve(name)
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.services[name] = s
+	return &serviceServer{node: n, name: name, service: s}
+}
+
+type serviceServer struct {
+	node    *Node
+	name    string
+	service *service
+}
+
+func (s *serviceServer) Shutdown() {
+	s.node.mutex.Lock()
+	defer s.node.mutex.Unlock()
+	if s.node.services[s.name] == s.service {
+		delete(s.node.services, s.name)
+	}
+}
+
+type serviceClient struct {
+	node *Node
+	name string
+}
+
+// Call calls the handler of the server on the node. Errors of the handler
+// are returned as *ros.ServiceError.
+func (c *serviceClient) Call(srv ros.Service) error {
+	c.node.mutex.Lock()
+	s, ok := c.node.services[c.name]
+	c.node.mutex.Unlock()
+	if !ok {
+		return fmt.Errorf("service [%s] is not served", c.name)
+	}
+	if err := s.handler(srv); err != nil {
+		return &ros.ServiceError{Service: c.name, Message: err.Error()}
+	}
+	return nil
+}
+
+func (c *serviceClient) Shutdown() {}
+
+// NewServiceClient creates a client of a service on the node. The options
+// are ignored.
+func (n *Node) NewServiceClient(service string, srvType ros.ServiceType, options ...ros.ServiceClientOption) ros.ServiceClient {
+	return &serviceClient{node: n, name: n.Resolve(service)}
+}
+
+func (n *Node) OK() bool {
+	select {
+	case <-n.quitChan:
+		return false
+	default:
+		return true
+	}
+}
+
+// SpinOnce does nothing, as callbacks are called by Publish.
+func (n *Node) SpinOnce() {}
+
+// Spin waits until the node is shut down.
+func (n *Node) Spin() {
+	<-n.quitChan
+}
+
+func (n *Node) Shutdown() {
+	n.quitOnce.Do(func() { close(n.quitChan) })
+}
+
+func (n *Node) GetParam(name string) (interface{}, error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	value, ok := n.params[n.Resolve(name)]
+	if !ok {
+		return nil, fmt.Errorf("parameter [%s] is not set", n.Resolve(name))
+	}
+	return value, nil
+}
+
+func (n *Node) SetParam(name string, value interface{}) error {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.params[n.Resolve(name)] = value
+	return nil
+}
+
+func (n *Node) HasParam(name string) (bool, error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	_, ok := n.params[n.Resolve(name)]
+	return ok, nil
+}
+
+// SearchParam looks for the parameter in the namespace of the node and
+// then in its parents.
+func (n *Node) SearchParam(name string) (string, error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	namespace := n.name
+	for namespace != "" {
+		namespace = namespace[:strings.LastIndex(namespace, "/")]
+		key := namespace + "/" + strings.TrimPrefix(name, "/")
+		if _, ok := n.params[key]; ok {
+			return key, nil
+		}
+	}
+	return "", errors.New("parameter is not found")
+}
+
+func (n *Node) DeleteParam(name string) error {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	delete(n.params, n.Resolve(name))
+	return nil
+}
+
+// Params returns the names of the parameters set.
+func (n *Node) Params() []string {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	var names []string
+	for name := range n.params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (n *Node) Logger() ros.Logger {
+	return n.logger
+}
+
+func (n *Node) Name() string {
+	return n.name
+}
+
+func (n *Node) NonRosArgs() []string {
+	return nil
+}
@@ -0,0 +1,97 @@
+package rostest
+
+import (
+	"testing"
+
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+func TestResolve(t *testing.T) {
+	node := NewNode("/ns/node")
+	for name, expected := range map[string]string{
+		"/abs":  "/abs",
+		"rel":   "/ns/rel",
+		"~priv": "/ns/node/priv",
+	} {
+		if resolved := node.Resolve(name); resolved != expected {
+			t.Errorf("expected %s for %s but %s", expected, name, resolved)
+		}
+	}
+}
+
+func TestLatchedTopic(t *testing.T) {
+	node := NewNode("/test_node")
+	pub := node.NewLatchedPublisher("/latched", std_msgs.MsgInt64)
+	pub.Publish(&std_msgs.Int64{Data: 1})
+	pub.Publish(&std_msgs.Int64{Data: 2})
+	if !node.Latched("/latched") {
+		t.Error("expected /latched to be latched")
+	}
+
+	ch, sub := node.SubscribeChan("/latched", std_msgs.MsgInt64, 1)
+	if received := (<-ch).Message.(*std_msgs.Int64); received.Data != 2 {
+		t.Errorf("expected latched 2 but %d", received.Data)
+	}
+	// The oldest message is dropped when the channel is full.
+	pub.Publish(&std_msgs.Int64{Data: 3})
+	pub.Publish(&std_msgs.Int64{Data: 4})
+	if received := (<-ch).Message.(*std_msgs.Int64); received.Data != 4 {
+		t.Errorf("expected 4 but %d", received.Data)
+	}
+	sub.Shutdown()
+	if _, ok := <-ch; ok {
+		t.Error("expected the channel to be closed")
+	}
+	pub.Publish(&std_msgs.Int64{Data: 5})
+
+	plain := node.NewPublisher("/plain", std_msgs.MsgInt64)
+	plain.Publish(&std_msgs.Int64{Data: 1})
+	var received []ros.Message
+	node.NewSubscriber("/plain", std_msgs.MsgInt64, func(msg *std_msgs.Int64) {
+		received = append(received, msg)
+	})
+	if len(received) != 0 {
+		t.Errorf("unexpected messages %v", received)
+	}
+}
+
+func TestParams(t *testing.T) {
+	node := NewNode("/ns/node")
+	node.SetParam("~rate", 10.0)
+	node.SetParam("/ns/frame", "map")
+	if value, err := node.GetParam("/ns/node/rate"); err != nil || value != 10.0 {
+		t.Errorf("unexpected value %v, %v", value, err)
+	}
+	if key, err := node.SearchParam("frame"); err != nil || key != "/ns/frame" {
+		t.Errorf("unexpected key %s, %v", key, err)
+	}
+	node.DeleteParam("~rate")
+	if ok, _ := node.HasParam("~rate"); ok {
+		t.Error("expected ~rate to be deleted")
+	}
+	if names := node.Params(); len(names) != 1 || names[0] != "/ns/frame" {
+		t.Errorf("unexpected params %v", names)
+	}
+}
+
+func TestMasterParams(t *testing.T) {
+	master := StartMaster(t)
+	node := master.NewNode(t, "/ns/node")
+	if err := node.SetParam("~gains/p", 1.5); err != nil {
+		t.Fatal(err)
+	}
+	if err := node.SetParam("/ns/frame", "map"); err != nil {
+		t.Fatal(err)
+	}
+	value, err := node.GetParam("~gains")
+	if gains, ok := value.(map[string]interface{}); err != nil || !ok || gains["p"] != 1.5 {
+		t.Errorf("unexpected value %v, %v", value, err)
+	}
+	if key, err := node.SearchParam("frame"); err != nil || key != "/ns/frame" {
+		t.Errorf("unexpected key %s, %v", key, err)
+	}
+	if _, err := node.GetParam("~missing"); err == nil {
+		t.Error("expected an error for a missing parameter")
+	}
+}
@@ -0,0 +1,138 @@
+package message_filters
+
+import (
+	"sync"
+
+	"github.com/akio/rosgo/ros"
+)
+
+// Cache keeps the latest messages sorted by stamp and passes them through.
+type Cache struct {
+	signal
+	mutex sync.Mutex
+	size  int
+	msgs  []stampedMessage
+}
+
+// NewCache creates a cache of up to size messages. input may be nil to feed
+// messages with Add.
+func NewCache(input Filter, size int) *Cache {
+	c := &Cache{size: size}
+	if input != nil {
+		input.RegisterCallback(c.Add)
+	}
+	return c
+}
+
+// Add stores the message, dropping the oldest one if the cache is full.
+func (c *Cache) Add(msg ros.Message) {
+	stamp, ok := stampOf(msg)
+	if !ok {
+		return
+	}
+	c.mutex.Lock()
+	c.msgs = insertStamped(c.msgs, stampedMessage{stamp, msg})
+	if len(c.msgs) > c.size {
+		c.msgs = c.msgs[len(c.msgs)-c.size:]
+	}
+	c.mutex.Unlock()
+	c.emit(msg)
+}
+
+func (c *Cache) collect(first, last int) []ros.Message {
+	var msgs []ros.Message
+	for i := first; i <= last && i < len(c.msgs); i++ {
+		msgs = append(msgs, c.msgs[i].msg)
+	}
+	return msgs
+}
+
+// Interval returns the messages stamped between start and end inclusive.
+func (c *Cache) Interval(start, end ros.Time) []ros.Message {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	first := len(c.msgs)
+	for i, m := range c.msgs {
+		if m.stamp >= start.ToNSec() {
+			first = i
+			break
+		}
+	}
+	last := -1
+	for i := len(c.msgs) - 1; i >= 0; i-- {
+		if c.msgs[i].stamp <= end.ToNSec() {
+			last = i
+			break
+		}
+	}
+	return c.collect(first, last)
+}
+
+// Surroundings returns the messages of Interval plus the latest one before
+// start and the earliest one after end, so that values at start and end can
+// be interpolated.
+func (c *Cache) Surroundings(start, end ros.Time) []ros.Message {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	first := 0
+	for i := len(c.msgs) - 1; i >= 0; i-- {
+		if c.msgs[i].stamp <= start.ToNSec() {
+			first = i
+			break
+		}
+	}
+	last := len(c.msgs) - 1
+	for i, m := range c.msgs {
+		if m.stamp >= end.ToNSec() {
+			last = i
+			break
+		}
+	}
+	return c.collect(first, last)
+}
+
+// ElemBeforeTime returns the latest message stamped at or before t, or nil.
+func (c *Cache) ElemBeforeTime(t ros.Time) ros.Message {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for i := len(c.msgs) - 1; i >= 0; i-- {
+		if c.msgs[i].stamp <= t.ToNSec() {
+			return c.msgs[i].msg
+		}
+	}
+	return nil
+}
+
+// ElemAfterTime returns the earliest message stamped at or after t, or nil.
+func (c *Cache) ElemAfterTime(t ros.Time) ros.Message {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for _, m := range c.msgs {
+		if m.stamp >= t.ToNSec() {
+			return m.msg
+		}
+	}
+	return nil
+}
+
+// OldestTime returns the stamp of the oldest message, or zero if the cache
+// is empty.
+func (c *Cache) OldestTime() ros.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if len(c.msgs) == 0 {
+		return ros.Time{}
+	}
+	return nsecToTime(c.msgs[0].stamp)
+}
+
+// NewestTime returns the stamp of the newest message, or zero if the cache
+// is empty.
+func (c *Cache) NewestTime() ros.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if len(c.msgs) == 0 {
+		return ros.Time{}
+	}
+	return nsecToTime(c.msgs[len(c.msgs)-1].stamp)
+}
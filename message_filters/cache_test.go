@@ -0,0 +1,50 @@
+package message_filters
+
+import (
+	"testing"
+
+	"github.com/akio/rosgo/ros"
+)
+
+func TestCache(t *testing.T) {
+	input := &Subscriber{}
+	cache := NewCache(input, 3)
+	var passed []ros.Message
+	cache.RegisterCallback(func(msg ros.Message) {
+		passed = append(passed, msg)
+	})
+	for _, msg := range []*testMsg{newTestMsg("a", 1), newTestMsg("c", 3), newTestMsg("b", 2), newTestMsg("d", 4)} {
+		input.Add(msg)
+	}
+	// Messages without a header are ignored.
+	input.Add(&ros.RawMessage{})
+
+	expectNames(t, []string{"a", "c", "b", "d"}, passed)
+	if oldest := cache.OldestTime(); oldest.ToSec() != 2 {
+		t.Errorf("expected oldest 2, got %v", oldest.ToSec())
+	}
+	if newest := cache.NewestTime(); newest.ToSec() != 4 {
+		t.Errorf("expected newest 4, got %v", newest.ToSec())
+	}
+	expectNames(t, []string{"b", "c", "d"}, cache.Interval(sec(0), sec(10)))
+	expectNames(t, []string{"c"}, cache.Interval(sec(2.5), sec(3.5)))
+	expectNames(t, nil, cache.Interval(sec(5), sec(6)))
+	expectNames(t, []string{"b", "c", "d"}, cache.Surroundings(sec(2.5), sec(3.5)))
+	expectNames(t, []string{"c"}, cache.Surroundings(sec(3), sec(3)))
+	expectNames(t, []string{"b"}, []ros.Message{cache.ElemBeforeTime(sec(2.5))})
+	expectNames(t, []string{"d"}, []ros.Message{cache.ElemAfterTime(sec(3.5))})
+	if msg := cache.ElemBeforeTime(sec(1)); msg != nil {
+		t.Errorf("expected nil, got %v", msg)
+	}
+	if msg := cache.ElemAfterTime(sec(5)); msg != nil {
+		t.Errorf("expected nil, got %v", msg)
+	}
+}
+
+func TestEmptyCache(t *testing.T) {
+	cache := NewCache(nil, 3)
+	if oldest := cache.OldestTime(); !oldest.IsZero() {
+		t.Errorf("expected zero, got %v", oldest)
+	}
+	expectNames(t, nil, cache.Surroundings(sec(0), sec(1)))
+}
@@ -0,0 +1,115 @@
+// Package message_filters provides filters which collect and align
+// messages by the stamp of their std_msgs/Header, like message_filters of
+// roscpp and rospy.
+//
+// Filters are chained by passing a filter as the input of another. A
+// Subscriber is usually the first one.
+//
+//	left := message_filters.NewSubscriber(node, "left/image", sensor_msgs.MsgImage)
+//	right := message_filters.NewSubscriber(node, "right/image", sensor_msgs.MsgImage)
+//	sync := message_filters.NewTimeSynchronizer(10, left, right)
+//	sync.RegisterCallback(func(msgs []ros.Message) { ... })
+//
+// Messages without a header are ignored by the filters that use stamps.
+package message_filters
+
+import (
+	"sync"
+
+	"github.com/akio/rosgo/ros"
+)
+
+// Filter passes messages to the callbacks registered to it.
+type Filter interface {
+	RegisterCallback(callback func(msg ros.Message))
+}
+
+// signal holds the callbacks of a filter.
+type signal struct {
+	mutex     sync.Mutex
+	callbacks []func(msg ros.Message)
+}
+
+func (s *signal) RegisterCallback(callback func(msg ros.Message)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.callbacks = append(s.callbacks, callback)
+}
+
+func (s *signal) emit(msg ros.Message) {
+	s.mutex.Lock()
+	callbacks := make([]func(msg ros.Message), len(s.callbacks))
+	copy(callbacks, s.callbacks)
+	s.mutex.Unlock()
+	for _, callback := range callbacks {
+		callback(msg)
+	}
+}
+
+// Subscriber subscribes to a topic and passes the messages to the filters
+// connected to it.
+type Subscriber struct {
+	signal
+	sub ros.Subscriber
+}
+
+func NewSubscriber(node ros.Node, topic string, msgType ros.MessageType) *Subscriber {
+	s := &Subscriber{}
+	s.sub = node.NewSubscriber(topic, msgType, s.Add)
+	return s
+}
+
+// Add passes the message as if it were received from the topic.
+func (s *Subscriber) Add(msg ros.Message) {
+	s.emit(msg)
+}
+
+// Subscriber returns the underlying subscriber.
+func (s *Subscriber) Subscriber() ros.Subscriber {
+	return s.sub
+}
+
+func (s *Subscriber) Shutdown() {
+	s.sub.Shutdown()
+}
+
+// A message with the stamp of its header in nanoseconds.
+type stampedMessage struct {
+	stamp uint64
+	msg   ros.Message
+}
+
+func stampOf(msg ros.Message) (uint64, bool) {
+	stamp, ok := ros.HeaderStamp(msg)
+	if !ok {
+		return 0, false
+	}
+	return stamp.ToNSec(), true
+}
+
+// Insert the message after those with the same or older stamps.
+func insertStamped(msgs []stampedMessage, m stampedMessage) []stampedMessage {
+	i := len(msgs)
+	for i > 0 && msgs[i-1].stamp > m.stamp {
+		i--
+	}
+	msgs = append(msgs, stampedMessage{})
+	copy(msgs[i+1:], msgs[i:])
+	msgs[i] = m
+	return msgs
+}
+
+func nsecToTime(nsec uint64) ros.Time {
+	var t ros.Time
+	t.FromNSec(nsec)
+	return t
+}
+
+// Queues of less than one message would drop every message, so they are
+// taken as one.
+func validQueueSize(queueSize int) int {
+	if queueSize < 1 {
+		return 1
+	}
+	return queueSize
+}
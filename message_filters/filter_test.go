@@ -0,0 +1,113 @@
+package message_filters
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/akio/rosgo/internal/rostest"
+	"github.com/akio/rosgo/msgs/geometry_msgs"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+type testMsg struct {
+	Header std_msgs.Header `rosmsg:"header:Header"`
+	Name   string          `rosmsg:"name:string"`
+}
+
+func (m *testMsg) Type() ros.MessageType               { return nil }
+func (m *testMsg) Serialize(buf *bytes.Buffer) error   { return nil }
+func (m *testMsg) Deserialize(buf *bytes.Reader) error { return nil }
+
+// A message stamped at sec seconds.
+func newTestMsg(name string, sec float64) *testMsg {
+	msg := &testMsg{Name: name}
+	msg.Header.Stamp.FromSec(sec)
+	return msg
+}
+
+func names(msgs []ros.Message) []string {
+	var result []string
+	for _, msg := range msgs {
+		if msg == nil {
+			result = append(result, "")
+		} else {
+			result = append(result, msg.(*testMsg).Name)
+		}
+	}
+	return result
+}
+
+func sec(s float64) ros.Time {
+	var t ros.Time
+	t.FromSec(s)
+	return t
+}
+
+func expectNames(t *testing.T, expected []string, msgs []ros.Message) {
+	t.Helper()
+	actual := names(msgs)
+	if len(actual) != len(expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+		return
+	}
+	for i := range actual {
+		if actual[i] != expected[i] {
+			t.Errorf("expected %v, got %v", expected, actual)
+			return
+		}
+	}
+}
+
+func TestSubscriber(t *testing.T) {
+	node := rostest.NewNode("/test_node")
+	sub := NewSubscriber(node, "/chatter", std_msgs.MsgHeader)
+	var received []ros.Message
+	sub.RegisterCallback(func(msg ros.Message) {
+		received = append(received, msg)
+	})
+	if !node.Subscribed("/chatter") {
+		t.Error("expected /chatter to be subscribed")
+	}
+	node.NewPublisher("/chatter", std_msgs.MsgHeader).Publish(newTestMsg("a", 1))
+	expectNames(t, []string{"a"}, received)
+	sub.Shutdown()
+	if node.Subscribed("/chatter") {
+		t.Error("expected /chatter to be shut down")
+	}
+}
+
+func TestSynchronizerLoopback(t *testing.T) {
+	master := rostest.StartMaster(t)
+	talker := master.NewNode(t, "/talker")
+	listener := master.NewNode(t, "/listener")
+
+	// Latched, so that the messages published before the subscribers
+	// connect are received.
+	for _, topic := range []string{"/a", "/b"} {
+		msg := &geometry_msgs.PointStamped{}
+		msg.Header.Stamp = sec(1)
+		msg.Header.FrameId = topic
+		talker.NewLatchedPublisher(topic, geometry_msgs.MsgPointStamped).Publish(msg)
+	}
+	synchronized := make(chan []ros.Message, 1)
+	synchronizer := NewTimeSynchronizer(10,
+		NewSubscriber(listener, "/a", geometry_msgs.MsgPointStamped),
+		NewSubscriber(listener, "/b", geometry_msgs.MsgPointStamped))
+	synchronizer.RegisterCallback(func(msgs []ros.Message) {
+		synchronized <- msgs
+	})
+	go listener.Spin()
+
+	select {
+	case msgs := <-synchronized:
+		for i, topic := range []string{"/a", "/b"} {
+			if frame := msgs[i].(*geometry_msgs.PointStamped).Header.FrameId; frame != topic {
+				t.Errorf("expected %s at %d but %s", topic, i, frame)
+			}
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no messages synchronized")
+	}
+}
@@ -0,0 +1,236 @@
+package message_filters
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/akio/rosgo/ros"
+)
+
+// Policy decides which messages of the inputs of a Synchronizer make a set.
+type Policy interface {
+	// Prepare for the number of inputs.
+	init(numInputs int)
+	// Store the message from the input and return the sets completed by it.
+	add(input int, m stampedMessage) [][]ros.Message
+}
+
+// Synchronizer passes a set of messages, one from each input, when the
+// policy finds one.
+type Synchronizer struct {
+	mutex     sync.Mutex
+	policy    Policy
+	numInputs int
+	callbacks []func(msgs []ros.Message)
+}
+
+// NewSynchronizer creates a synchronizer of two or more inputs. An input
+// may be nil to feed messages with Add. It panics if less than two inputs
+// are given.
+func NewSynchronizer(policy Policy, inputs ...Filter) *Synchronizer {
+	if len(inputs) < 2 {
+		panic("message_filters: Synchronizer needs at least two inputs")
+	}
+	s := &Synchronizer{policy: policy, numInputs: len(inputs)}
+	policy.init(len(inputs))
+	for i, input := range inputs {
+		if input != nil {
+			i := i
+			input.RegisterCallback(func(msg ros.Message) {
+				s.Add(i, msg)
+			})
+		}
+	}
+	return s
+}
+
+// NewTimeSynchronizer creates a synchronizer with ExactTime.
+func NewTimeSynchronizer(queueSize int, inputs ...Filter) *Synchronizer {
+	return NewSynchronizer(ExactTime(queueSize), inputs...)
+}
+
+// NewApproximateTimeSynchronizer creates a synchronizer with
+// ApproximateTime.
+func NewApproximateTimeSynchronizer(queueSize int, slop ros.Duration, inputs ...Filter) *Synchronizer {
+	return NewSynchronizer(ApproximateTime(queueSize, slop), inputs...)
+}
+
+// RegisterCallback adds a callback which takes a message of each input in
+// the order of the inputs.
+func (s *Synchronizer) RegisterCallback(callback func(msgs []ros.Message)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.callbacks = append(s.callbacks, callback)
+}
+
+// Add passes the message as if it came from the input of the index.
+func (s *Synchronizer) Add(input int, msg ros.Message) {
+	stamp, ok := stampOf(msg)
+	if !ok || input < 0 || input >= s.numInputs {
+		return
+	}
+	s.mutex.Lock()
+	sets := s.policy.add(input, stampedMessage{stamp, msg})
+	callbacks := make([]func(msgs []ros.Message), len(s.callbacks))
+	copy(callbacks, s.callbacks)
+	s.mutex.Unlock()
+	for _, set := range sets {
+		for _, callback := range callbacks {
+			callback(set)
+		}
+	}
+}
+
+type exactTime struct {
+	queueSize int
+	numInputs int
+	sets      map[uint64][]ros.Message
+	last      uint64
+	passed    bool
+}
+
+// ExactTime makes a set of messages with the same stamp. Up to queueSize
+// incomplete sets are kept, at least one. Messages not newer than the last
+// set are dropped.
+func ExactTime(queueSize int) Policy {
+	return &exactTime{queueSize: validQueueSize(queueSize)}
+}
+
+func (p *exactTime) init(numInputs int) {
+	p.numInputs = numInputs
+	p.sets = make(map[uint64][]ros.Message)
+}
+
+func (p *exactTime) add(input int, m stampedMessage) [][]ros.Message {
+	if p.passed && m.stamp <= p.last {
+		return nil
+	}
+	set, ok := p.sets[m.stamp]
+	if !ok {
+		set = make([]ros.Message, p.numInputs)
+		p.sets[m.stamp] = set
+	}
+	set[input] = m.msg
+	for _, msg := range set {
+		if msg == nil {
+			p.trim()
+			return nil
+		}
+	}
+	// Older sets cannot be completed anymore.
+	for stamp := range p.sets {
+		if stamp <= m.stamp {
+			delete(p.sets, stamp)
+		}
+	}
+	p.last, p.passed = m.stamp, true
+	return [][]ros.Message{set}
+}
+
+func (p *exactTime) trim() {
+	for len(p.sets) > p.queueSize {
+		oldest := true
+		var oldestStamp uint64
+		for stamp := range p.sets {
+			if oldest || stamp < oldestStamp {
+				oldestStamp, oldest = stamp, false
+			}
+		}
+		delete(p.sets, oldestStamp)
+	}
+}
+
+type approximateTime struct {
+	queueSize int
+	slop      uint64
+	queues    [][]stampedMessage
+}
+
+// ApproximateTime makes a set of messages whose stamps are within slop of
+// each other, like ApproximateTimeSynchronizer of rospy. Each input keeps
+// up to queueSize messages, at least one. When a message arrives, the set
+// containing it is searched among the kept messages, closer ones first.
+// Messages of the set and older ones are removed once the set is found.
+func ApproximateTime(queueSize int, slop ros.Duration) Policy {
+	return &approximateTime{queueSize: validQueueSize(queueSize), slop: slop.ToNSec()}
+}
+
+func (p *approximateTime) init(numInputs int) {
+	p.queues = make([][]stampedMessage, numInputs)
+}
+
+func absDiff(a, b uint64) uint64 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+func (p *approximateTime) add(input int, m stampedMessage) [][]ros.Message {
+	queue := insertStamped(p.queues[input], m)
+	if len(queue) > p.queueSize {
+		queue = queue[len(queue)-p.queueSize:]
+	}
+	p.queues[input] = queue
+
+	// Messages of the other inputs within slop of the new one.
+	candidates := make([][]stampedMessage, len(p.queues))
+	for i, queue := range p.queues {
+		if i == input {
+			candidates[i] = []stampedMessage{m}
+			continue
+		}
+		for _, c := range queue {
+			if absDiff(c.stamp, m.stamp) <= p.slop {
+				candidates[i] = append(candidates[i], c)
+			}
+		}
+		if len(candidates[i]) == 0 {
+			return nil
+		}
+		sort.SliceStable(candidates[i], func(a, b int) bool {
+			return absDiff(candidates[i][a].stamp, m.stamp) < absDiff(candidates[i][b].stamp, m.stamp)
+		})
+	}
+	chosen := make([]stampedMessage, len(p.queues))
+	if !p.search(candidates, chosen, 0, m.stamp, m.stamp) {
+		return nil
+	}
+	set := make([]ros.Message, len(chosen))
+	for i, c := range chosen {
+		set[i] = c.msg
+		// Drop the message and older ones.
+		queue := p.queues[i]
+		j := 0
+		for j < len(queue) && queue[j].stamp <= c.stamp {
+			j++
+		}
+		p.queues[i] = queue[j:]
+	}
+	return [][]ros.Message{set}
+}
+
+// Choose a candidate of each input from the index so that all stamps are
+// within slop.
+func (p *approximateTime) search(candidates [][]stampedMessage, chosen []stampedMessage, index int, min, max uint64) bool {
+	if index == len(candidates) {
+		return true
+	}
+	for _, c := range candidates[index] {
+		newMin, newMax := min, max
+		if c.stamp < newMin {
+			newMin = c.stamp
+		}
+		if c.stamp > newMax {
+			newMax = c.stamp
+		}
+		if newMax-newMin > p.slop {
+			continue
+		}
+		chosen[index] = c
+		if p.search(candidates, chosen, index+1, newMin, newMax) {
+			return true
+		}
+	}
+	return false
+}
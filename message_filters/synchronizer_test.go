@@ -0,0 +1,121 @@
+package message_filters
+
+import (
+	"testing"
+
+	"github.com/akio/rosgo/ros"
+)
+
+func recordSets(sync *Synchronizer) *[][]ros.Message {
+	var sets [][]ros.Message
+	sync.RegisterCallback(func(msgs []ros.Message) {
+		sets = append(sets, msgs)
+	})
+	return &sets
+}
+
+func TestTimeSynchronizer(t *testing.T) {
+	a, b, c := &Subscriber{}, &Subscriber{}, &Subscriber{}
+	sync := NewTimeSynchronizer(2, a, b, c)
+	sets := recordSets(sync)
+
+	a.Add(newTestMsg("a1", 1))
+	b.Add(newTestMsg("b1", 1))
+	a.Add(newTestMsg("a2", 2))
+	b.Add(newTestMsg("b2", 2))
+	c.Add(newTestMsg("c2", 2))
+	if len(*sets) != 1 {
+		t.Fatalf("expected 1 set, got %d", len(*sets))
+	}
+	expectNames(t, []string{"a2", "b2", "c2"}, (*sets)[0])
+
+	// The set at 1 is dropped since a newer one is complete.
+	c.Add(newTestMsg("c1", 1))
+	if len(*sets) != 1 {
+		t.Fatalf("expected 1 set, got %d", len(*sets))
+	}
+
+	// Only two incomplete sets are kept.
+	a.Add(newTestMsg("a3", 3))
+	a.Add(newTestMsg("a4", 4))
+	a.Add(newTestMsg("a5", 5))
+	b.Add(newTestMsg("b3", 3))
+	c.Add(newTestMsg("c3", 3))
+	b.Add(newTestMsg("b4", 4))
+	c.Add(newTestMsg("c4", 4))
+	if len(*sets) != 2 {
+		t.Fatalf("expected 2 sets, got %d", len(*sets))
+	}
+	expectNames(t, []string{"a4", "b4", "c4"}, (*sets)[1])
+}
+
+func TestSynchronizerZeroQueueSize(t *testing.T) {
+	// A queue of 0 is taken as 1 instead of dropping every message.
+	exact := NewTimeSynchronizer(0, nil, nil)
+	exactSets := recordSets(exact)
+	exact.Add(0, newTestMsg("a1", 1))
+	exact.Add(1, newTestMsg("b1", 1))
+	if len(*exactSets) != 1 {
+		t.Fatalf("expected 1 set, got %d", len(*exactSets))
+	}
+	expectNames(t, []string{"a1", "b1"}, (*exactSets)[0])
+
+	approximate := NewApproximateTimeSynchronizer(-1, ros.NewDuration(0, 100000000), nil, nil)
+	approximateSets := recordSets(approximate)
+	approximate.Add(0, newTestMsg("a1", 1))
+	approximate.Add(1, newTestMsg("b1", 1.05))
+	if len(*approximateSets) != 1 {
+		t.Fatalf("expected 1 set, got %d", len(*approximateSets))
+	}
+	expectNames(t, []string{"a1", "b1"}, (*approximateSets)[0])
+}
+
+func TestApproximateTimeSynchronizer(t *testing.T) {
+	sync := NewApproximateTimeSynchronizer(10, ros.NewDuration(0, 100000000), nil, nil)
+	sets := recordSets(sync)
+
+	sync.Add(0, newTestMsg("a1", 1))
+	sync.Add(1, newTestMsg("b1", 1.5))
+	if len(*sets) != 0 {
+		t.Fatalf("expected no set, got %d", len(*sets))
+	}
+	sync.Add(1, newTestMsg("b2", 1.05))
+	if len(*sets) != 1 {
+		t.Fatalf("expected 1 set, got %d", len(*sets))
+	}
+	expectNames(t, []string{"a1", "b2"}, (*sets)[0])
+
+	// b1 is still kept. The closer message is chosen.
+	sync.Add(0, newTestMsg("a2", 1.45))
+	if len(*sets) != 2 {
+		t.Fatalf("expected 2 sets, got %d", len(*sets))
+	}
+	expectNames(t, []string{"a2", "b1"}, (*sets)[1])
+
+	// Inputs out of range are ignored.
+	sync.Add(2, newTestMsg("c", 2))
+}
+
+func TestApproximateTimeSynchronizerSearch(t *testing.T) {
+	sync := NewApproximateTimeSynchronizer(10, ros.NewDuration(1, 0), nil, nil, nil)
+	sets := recordSets(sync)
+
+	// The closest messages to c are too far apart from each other.
+	sync.Add(0, newTestMsg("a1", -0.6+10))
+	sync.Add(0, newTestMsg("a2", 0.7+10))
+	sync.Add(1, newTestMsg("b1", 0.6+10))
+	sync.Add(2, newTestMsg("c", 10))
+	if len(*sets) != 1 {
+		t.Fatalf("expected 1 set, got %d", len(*sets))
+	}
+	expectNames(t, []string{"a2", "b1", "c"}, (*sets)[0])
+}
+
+func TestSynchronizerNeedsTwoInputs(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic")
+		}
+	}()
+	NewTimeSynchronizer(10, nil)
+}
@@ -0,0 +1,96 @@
+package message_filters
+
+import (
+	"sync"
+	"time"
+
+	"github.com/akio/rosgo/ros"
+)
+
+// TimeSequencer passes messages in the order of their stamps. A message is
+// held until delay has passed since its stamp, so that older ones arriving
+// late can go first. Messages older than one already passed are dropped.
+type TimeSequencer struct {
+	signal
+	// Defaults to ros.Now.
+	Clock     func() ros.Time
+	mutex     sync.Mutex
+	delay     uint64
+	queueSize int
+	queue     []stampedMessage
+	last      uint64
+	passed    bool
+	quitChan  chan struct{}
+	quitOnce  sync.Once
+}
+
+// NewTimeSequencer creates a sequencer which checks held messages every
+// updateRate and keeps up to queueSize of them, at least one. input may be nil to feed
+// messages with Add.
+func NewTimeSequencer(input Filter, delay ros.Duration, updateRate ros.Duration, queueSize int) *TimeSequencer {
+	s := &TimeSequencer{
+		Clock:     ros.Now,
+		delay:     delay.ToNSec(),
+		queueSize: validQueueSize(queueSize),
+		quitChan:  make(chan struct{}),
+	}
+	if input != nil {
+		input.RegisterCallback(s.Add)
+	}
+	go s.run(time.Duration(updateRate.ToNSec()))
+	return s
+}
+
+func (s *TimeSequencer) run(updateRate time.Duration) {
+	ticker := time.NewTicker(updateRate)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.dispatch()
+		case <-s.quitChan:
+			return
+		}
+	}
+}
+
+// Add holds the message, dropping the oldest one if the queue is full.
+func (s *TimeSequencer) Add(msg ros.Message) {
+	stamp, ok := stampOf(msg)
+	if !ok {
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.passed && stamp < s.last {
+		return
+	}
+	s.queue = insertStamped(s.queue, stampedMessage{stamp, msg})
+	if len(s.queue) > s.queueSize {
+		s.queue = s.queue[len(s.queue)-s.queueSize:]
+	}
+}
+
+// Pass the messages whose delay has passed.
+func (s *TimeSequencer) dispatch() {
+	clock := s.Clock()
+	now := clock.ToNSec()
+	s.mutex.Lock()
+	var ready []stampedMessage
+	for len(s.queue) > 0 && s.queue[0].stamp+s.delay <= now {
+		ready = append(ready, s.queue[0])
+		s.last, s.passed = s.queue[0].stamp, true
+		s.queue = s.queue[1:]
+	}
+	s.mutex.Unlock()
+	for _, m := range ready {
+		s.emit(m.msg)
+	}
+}
+
+// Shutdown stops checking held messages. They are not passed anymore.
+func (s *TimeSequencer) Shutdown() {
+	s.quitOnce.Do(func() {
+		close(s.quitChan)
+	})
+}
@@ -0,0 +1,79 @@
+package message_filters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/akio/rosgo/ros"
+)
+
+func TestTimeSequencer(t *testing.T) {
+	// Dispatched by hand.
+	seq := NewTimeSequencer(nil, ros.NewDuration(1, 0), ros.NewDuration(3600, 0), 10)
+	defer seq.Shutdown()
+	now := sec(10)
+	seq.Clock = func() ros.Time { return now }
+	var passed []ros.Message
+	seq.RegisterCallback(func(msg ros.Message) {
+		passed = append(passed, msg)
+	})
+
+	seq.Add(newTestMsg("b", 9.5))
+	seq.Add(newTestMsg("a", 9))
+	seq.Add(newTestMsg("c", 10))
+	seq.dispatch()
+	expectNames(t, []string{"a"}, passed)
+
+	now = sec(11)
+	seq.dispatch()
+	expectNames(t, []string{"a", "b", "c"}, passed)
+
+	// Too late.
+	seq.Add(newTestMsg("d", 9.8))
+	now = sec(20)
+	seq.dispatch()
+	expectNames(t, []string{"a", "b", "c"}, passed)
+}
+
+func TestTimeSequencerQueueSize(t *testing.T) {
+	seq := NewTimeSequencer(nil, ros.NewDuration(0, 0), ros.NewDuration(3600, 0), 2)
+	defer seq.Shutdown()
+	var passed []ros.Message
+	seq.RegisterCallback(func(msg ros.Message) {
+		passed = append(passed, msg)
+	})
+	seq.Add(newTestMsg("a", 1))
+	seq.Add(newTestMsg("b", 2))
+	seq.Add(newTestMsg("c", 3))
+	seq.dispatch()
+	expectNames(t, []string{"b", "c"}, passed)
+
+	// A queue of 0 keeps the latest message.
+	empty := NewTimeSequencer(nil, ros.NewDuration(0, 0), ros.NewDuration(3600, 0), 0)
+	defer empty.Shutdown()
+	passed = nil
+	empty.RegisterCallback(func(msg ros.Message) {
+		passed = append(passed, msg)
+	})
+	empty.Add(newTestMsg("a", 1))
+	empty.Add(newTestMsg("b", 2))
+	empty.dispatch()
+	expectNames(t, []string{"b"}, passed)
+}
+
+func TestTimeSequencerTimer(t *testing.T) {
+	input := &Subscriber{}
+	seq := NewTimeSequencer(input, ros.NewDuration(0, 0), ros.NewDuration(0, 1000000), 10)
+	defer seq.Shutdown()
+	passed := make(chan ros.Message, 1)
+	seq.RegisterCallback(func(msg ros.Message) {
+		passed <- msg
+	})
+	input.Add(newTestMsg("a", 1))
+	select {
+	case msg := <-passed:
+		expectNames(t, []string{"a"}, []ros.Message{msg})
+	case <-time.After(time.Second):
+		t.Error("message is not passed")
+	}
+}
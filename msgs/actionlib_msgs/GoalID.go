@@ -0,0 +1,134 @@
+// Automatically generated from the message definition "actionlib_msgs/GoalID.msg"
+package actionlib_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgGoalID struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgGoalID) Text() string {
+	return t.text
+}
+
+func (t *_MsgGoalID) Name() string {
+	return t.name
+}
+
+func (t *_MsgGoalID) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgGoalID) NewMessage() ros.Message {
+	m := new(GoalID)
+	m.Stamp = ros.Time{}
+	m.Id = ""
+	return m
+}
+
+var (
+	MsgGoalID = &_MsgGoalID{
+		`# The stamp should store the time at which this goal was requested.
+# It is used by an action server when it tries to preempt all
+# goals that were requested before a certain time
+time stamp
+
+# The id provides a way to associate feedback and
+# result message with specific goal requests. The id
+# specified must be unique.
+string id
+`,
+		"actionlib_msgs/GoalID",
+		"302881f31927c1df708a2dbab0e80ee8",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgGoalID)
+}
+
+type GoalID struct {
+	Stamp ros.Time `rosmsg:"stamp:time"`
+	Id    string   `rosmsg:"id:string"`
+}
+
+func (m *GoalID) Type() ros.MessageType {
+	return MsgGoalID
+}
+
+func (m *GoalID) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *GoalID) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *GoalID) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *GoalID) Clone() *GoalID {
+	c := *m
+	return &c
+}
+
+func (m *GoalID) Equal(other *GoalID) bool {
+	if m.Stamp != other.Stamp {
+		return false
+	}
+	if m.Id != other.Id {
+		return false
+	}
+	return true
+}
+
+func (m *GoalID) SerializedLength() int {
+	length := 0
+	length += 8
+	length += 4 + len(m.Id)
+	return length
+}
+
+func (m *GoalID) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:], m.Stamp.Sec)
+	binary.LittleEndian.PutUint32(b[4:], m.Stamp.NSec)
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Id)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Id)
+	return nil
+}
+
+func (m *GoalID) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Stamp.Sec = binary.LittleEndian.Uint32(b[:])
+	m.Stamp.NSec = binary.LittleEndian.Uint32(b[4:])
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Id = string(data)
+	}
+	return nil
+}
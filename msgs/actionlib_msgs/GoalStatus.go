@@ -0,0 +1,206 @@
+// Automatically generated from the message definition "actionlib_msgs/GoalStatus.msg"
+package actionlib_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+const (
+	GoalStatus_PENDING    uint8 = 0
+	GoalStatus_ACTIVE     uint8 = 1
+	GoalStatus_PREEMPTED  uint8 = 2
+	GoalStatus_SUCCEEDED  uint8 = 3
+	GoalStatus_ABORTED    uint8 = 4
+	GoalStatus_REJECTED   uint8 = 5
+	GoalStatus_PREEMPTING uint8 = 6
+	GoalStatus_RECALLING  uint8 = 7
+	GoalStatus_RECALLED   uint8 = 8
+	GoalStatus_LOST       uint8 = 9
+)
+
+// Names of the constants before they were scoped by the message.
+const (
+	// Deprecated: Use GoalStatus_PENDING.
+	PENDING = GoalStatus_PENDING
+	// Deprecated: Use GoalStatus_ACTIVE.
+	ACTIVE = GoalStatus_ACTIVE
+	// Deprecated: Use GoalStatus_PREEMPTED.
+	PREEMPTED = GoalStatus_PREEMPTED
+	// Deprecated: Use GoalStatus_SUCCEEDED.
+	SUCCEEDED = GoalStatus_SUCCEEDED
+	// Deprecated: Use GoalStatus_ABORTED.
+	ABORTED = GoalStatus_ABORTED
+	// Deprecated: Use GoalStatus_REJECTED.
+	REJECTED = GoalStatus_REJECTED
+	// Deprecated: Use GoalStatus_PREEMPTING.
+	PREEMPTING = GoalStatus_PREEMPTING
+	// Deprecated: Use GoalStatus_RECALLING.
+	RECALLING = GoalStatus_RECALLING
+	// Deprecated: Use GoalStatus_RECALLED.
+	RECALLED = GoalStatus_RECALLED
+	// Deprecated: Use GoalStatus_LOST.
+	LOST = GoalStatus_LOST
+)
+
+type _MsgGoalStatus struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgGoalStatus) Text() string {
+	return t.text
+}
+
+func (t *_MsgGoalStatus) Name() string {
+	return t.name
+}
+
+func (t *_MsgGoalStatus) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgGoalStatus) NewMessage() ros.Message {
+	m := new(GoalStatus)
+	m.GoalId = GoalID{}
+	m.Status = 0
+	m.Text = ""
+	return m
+}
+
+var (
+	MsgGoalStatus = &_MsgGoalStatus{
+		`GoalID goal_id
+uint8 status
+uint8 PENDING         = 0   # The goal has yet to be processed by the action server
+uint8 ACTIVE          = 1   # The goal is currently being processed by the action server
+uint8 PREEMPTED       = 2   # The goal received a cancel request after it started executing
+                            #   and has since completed its execution (Terminal State)
+uint8 SUCCEEDED       = 3   # The goal was achieved successfully by the action server (Terminal State)
+uint8 ABORTED         = 4   # The goal was aborted during execution by the action server due
+                            #    to some failure (Terminal State)
+uint8 REJECTED        = 5   # The goal was rejected by the action server without being processed,
+                            #    because the goal was unattainable or invalid (Terminal State)
+uint8 PREEMPTING      = 6   # The goal received a cancel request after it started executing
+                            #    and has not yet completed execution
+uint8 RECALLING       = 7   # The goal received a cancel request before it started executing,
+                            #    but the action server has not yet confirmed that the goal is canceled
+uint8 RECALLED        = 8   # The goal received a cancel request before it started executing
+                            #    and was successfully cancelled (Terminal State)
+uint8 LOST            = 9   # An action client can determine that a goal is LOST. This should not be
+                            #    sent over the wire by an action server
+
+#Allow for the user to associate a string with GoalStatus for debugging
+string text
+
+================================================================================
+MSG: actionlib_msgs/GoalID
+# The stamp should store the time at which this goal was requested.
+# It is used by an action server when it tries to preempt all
+# goals that were requested before a certain time
+time stamp
+
+# The id provides a way to associate feedback and
+# result message with specific goal requests. The id
+# specified must be unique.
+string id
+`,
+		"actionlib_msgs/GoalStatus",
+		"d388f9b87b3c471f784434d671988d4a",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgGoalStatus)
+}
+
+type GoalStatus struct {
+	GoalId GoalID `rosmsg:"goal_id:GoalID"`
+	Status uint8  `rosmsg:"status:uint8"`
+	Text   string `rosmsg:"text:string"`
+}
+
+func (m *GoalStatus) Type() ros.MessageType {
+	return MsgGoalStatus
+}
+
+func (m *GoalStatus) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *GoalStatus) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *GoalStatus) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *GoalStatus) Clone() *GoalStatus {
+	c := *m
+	c.GoalId = *m.GoalId.Clone()
+	return &c
+}
+
+func (m *GoalStatus) Equal(other *GoalStatus) bool {
+	if !m.GoalId.Equal(&other.GoalId) {
+		return false
+	}
+	if m.Status != other.Status {
+		return false
+	}
+	if m.Text != other.Text {
+		return false
+	}
+	return true
+}
+
+func (m *GoalStatus) SerializedLength() int {
+	length := 0
+	length += m.GoalId.SerializedLength()
+	length += 1
+	length += 4 + len(m.Text)
+	return length
+}
+
+func (m *GoalStatus) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.GoalId.Serialize(buf); err != nil {
+		return err
+	}
+	buf.WriteByte(m.Status)
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Text)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Text)
+	return nil
+}
+
+func (m *GoalStatus) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.GoalId.Deserialize(buf); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.Status = b[:][0]
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Text = string(data)
+	}
+	return nil
+}
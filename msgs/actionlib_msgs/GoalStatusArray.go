@@ -0,0 +1,201 @@
+// Automatically generated from the message definition "actionlib_msgs/GoalStatusArray.msg"
+package actionlib_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgGoalStatusArray struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgGoalStatusArray) Text() string {
+	return t.text
+}
+
+func (t *_MsgGoalStatusArray) Name() string {
+	return t.name
+}
+
+func (t *_MsgGoalStatusArray) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgGoalStatusArray) NewMessage() ros.Message {
+	m := new(GoalStatusArray)
+	m.Header = std_msgs.Header{}
+	m.StatusList = []GoalStatus{}
+	return m
+}
+
+var (
+	MsgGoalStatusArray = &_MsgGoalStatusArray{
+		`# Stores the statuses for goals that are currently being tracked
+# by an action server
+Header header
+GoalStatus[] status_list
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: actionlib_msgs/GoalStatus
+GoalID goal_id
+uint8 status
+uint8 PENDING         = 0   # The goal has yet to be processed by the action server
+uint8 ACTIVE          = 1   # The goal is currently being processed by the action server
+uint8 PREEMPTED       = 2   # The goal received a cancel request after it started executing
+                            #   and has since completed its execution (Terminal State)
+uint8 SUCCEEDED       = 3   # The goal was achieved successfully by the action server (Terminal State)
+uint8 ABORTED         = 4   # The goal was aborted during execution by the action server due
+                            #    to some failure (Terminal State)
+uint8 REJECTED        = 5   # The goal was rejected by the action server without being processed,
+                            #    because the goal was unattainable or invalid (Terminal State)
+uint8 PREEMPTING      = 6   # The goal received a cancel request after it started executing
+                            #    and has not yet completed execution
+uint8 RECALLING       = 7   # The goal received a cancel request before it started executing,
+                            #    but the action server has not yet confirmed that the goal is canceled
+uint8 RECALLED        = 8   # The goal received a cancel request before it started executing
+                            #    and was successfully cancelled (Terminal State)
+uint8 LOST            = 9   # An action client can determine that a goal is LOST. This should not be
+                            #    sent over the wire by an action server
+
+#Allow for the user to associate a string with GoalStatus for debugging
+string text
+
+================================================================================
+MSG: actionlib_msgs/GoalID
+# The stamp should store the time at which this goal was requested.
+# It is used by an action server when it tries to preempt all
+# goals that were requested before a certain time
+time stamp
+
+# The id provides a way to associate feedback and
+# result message with specific goal requests. The id
+# specified must be unique.
+string id
+`,
+		"actionlib_msgs/GoalStatusArray",
+		"8b2b82f13216d0a8ea88bd3af735e619",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgGoalStatusArray)
+}
+
+type GoalStatusArray struct {
+	Header     std_msgs.Header `rosmsg:"header:Header"`
+	StatusList []GoalStatus    `rosmsg:"status_list:GoalStatus[]"`
+}
+
+func (m *GoalStatusArray) Type() ros.MessageType {
+	return MsgGoalStatusArray
+}
+
+func (m *GoalStatusArray) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *GoalStatusArray) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *GoalStatusArray) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *GoalStatusArray) Clone() *GoalStatusArray {
+	c := *m
+	c.Header = *m.Header.Clone()
+	if m.StatusList != nil {
+		c.StatusList = make([]GoalStatus, len(m.StatusList))
+		for i := range m.StatusList {
+			c.StatusList[i] = *m.StatusList[i].Clone()
+		}
+	}
+	return &c
+}
+
+func (m *GoalStatusArray) Equal(other *GoalStatusArray) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if len(m.StatusList) != len(other.StatusList) {
+		return false
+	}
+	for i := range m.StatusList {
+		if !m.StatusList[i].Equal(&other.StatusList[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *GoalStatusArray) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += 4
+	for i := range m.StatusList {
+		length += m.StatusList[i].SerializedLength()
+	}
+	return length
+}
+
+func (m *GoalStatusArray) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.StatusList)))
+	buf.Write(b[:4])
+	for i := range m.StatusList {
+		if err := m.StatusList[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *GoalStatusArray) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*17 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.StatusList = make([]GoalStatus, size)
+		for i := range m.StatusList {
+			if err := m.StatusList[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+// Automatically generated from the message definition "diagnostic_msgs/DiagnosticArray.msg"
+package diagnostic_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgDiagnosticArray struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgDiagnosticArray) Text() string {
+	return t.text
+}
+
+func (t *_MsgDiagnosticArray) Name() string {
+	return t.name
+}
+
+func (t *_MsgDiagnosticArray) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgDiagnosticArray) NewMessage() ros.Message {
+	m := new(DiagnosticArray)
+	m.Header = std_msgs.Header{}
+	m.Status = []DiagnosticStatus{}
+	return m
+}
+
+var (
+	MsgDiagnosticArray = &_MsgDiagnosticArray{
+		`# This message is used to send diagnostic information about the state of the robot
+Header header #for timestamp
+DiagnosticStatus[] status # an array of components being reported on
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: diagnostic_msgs/DiagnosticStatus
+# This message holds the status of an individual component of the robot.
+# 
+
+# Possible levels of operations
+byte OK=0
+byte WARN=1
+byte ERROR=2
+byte STALE=3
+
+byte level # level of operation enumerated above 
+string name # a description of the test/component reporting
+string message # a description of the status
+string hardware_id # a hardware unique string
+KeyValue[] values # an array of values associated with the status
+
+
+================================================================================
+MSG: diagnostic_msgs/KeyValue
+string key # what to label this value when viewing
+string value # a value to track over time
+`,
+		"diagnostic_msgs/DiagnosticArray",
+		"60810da900de1dd6ddd437c3503511da",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgDiagnosticArray)
+}
+
+type DiagnosticArray struct {
+	Header std_msgs.Header    `rosmsg:"header:Header"`
+	Status []DiagnosticStatus `rosmsg:"status:DiagnosticStatus[]"`
+}
+
+func (m *DiagnosticArray) Type() ros.MessageType {
+	return MsgDiagnosticArray
+}
+
+func (m *DiagnosticArray) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *DiagnosticArray) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *DiagnosticArray) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *DiagnosticArray) Clone() *DiagnosticArray {
+	c := *m
+	c.Header = *m.Header.Clone()
+	if m.Status != nil {
+		c.Status = make([]DiagnosticStatus, len(m.Status))
+		for i := range m.Status {
+			c.Status[i] = *m.Status[i].Clone()
+		}
+	}
+	return &c
+}
+
+func (m *DiagnosticArray) Equal(other *DiagnosticArray) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if len(m.Status) != len(other.Status) {
+		return false
+	}
+	for i := range m.Status {
+		if !m.Status[i].Equal(&other.Status[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *DiagnosticArray) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += 4
+	for i := range m.Status {
+		length += m.Status[i].SerializedLength()
+	}
+	return length
+}
+
+func (m *DiagnosticArray) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Status)))
+	buf.Write(b[:4])
+	for i := range m.Status {
+		if err := m.Status[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *DiagnosticArray) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*17 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Status = make([]DiagnosticStatus, size)
+		for i := range m.Status {
+			if err := m.Status[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
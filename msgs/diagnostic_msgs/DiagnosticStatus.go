@@ -0,0 +1,249 @@
+// Automatically generated from the message definition "diagnostic_msgs/DiagnosticStatus.msg"
+package diagnostic_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+const (
+	DiagnosticStatus_OK    byte = 0
+	DiagnosticStatus_WARN  byte = 1
+	DiagnosticStatus_ERROR byte = 2
+	DiagnosticStatus_STALE byte = 3
+)
+
+// Names of the constants before they were scoped by the message.
+const (
+	// Deprecated: Use DiagnosticStatus_OK.
+	OK = DiagnosticStatus_OK
+	// Deprecated: Use DiagnosticStatus_WARN.
+	WARN = DiagnosticStatus_WARN
+	// Deprecated: Use DiagnosticStatus_ERROR.
+	ERROR = DiagnosticStatus_ERROR
+	// Deprecated: Use DiagnosticStatus_STALE.
+	STALE = DiagnosticStatus_STALE
+)
+
+type _MsgDiagnosticStatus struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgDiagnosticStatus) Text() string {
+	return t.text
+}
+
+func (t *_MsgDiagnosticStatus) Name() string {
+	return t.name
+}
+
+func (t *_MsgDiagnosticStatus) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgDiagnosticStatus) NewMessage() ros.Message {
+	m := new(DiagnosticStatus)
+	m.Level = 0
+	m.Name = ""
+	m.Message = ""
+	m.HardwareId = ""
+	m.Values = []KeyValue{}
+	return m
+}
+
+var (
+	MsgDiagnosticStatus = &_MsgDiagnosticStatus{
+		`# This message holds the status of an individual component of the robot.
+# 
+
+# Possible levels of operations
+byte OK=0
+byte WARN=1
+byte ERROR=2
+byte STALE=3
+
+byte level # level of operation enumerated above 
+string name # a description of the test/component reporting
+string message # a description of the status
+string hardware_id # a hardware unique string
+KeyValue[] values # an array of values associated with the status
+
+
+================================================================================
+MSG: diagnostic_msgs/KeyValue
+string key # what to label this value when viewing
+string value # a value to track over time
+`,
+		"diagnostic_msgs/DiagnosticStatus",
+		"d0ce08bc6e5ba34c7754f563a9cabaf1",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgDiagnosticStatus)
+}
+
+type DiagnosticStatus struct {
+	Level      uint8      `rosmsg:"level:byte"`
+	Name       string     `rosmsg:"name:string"`
+	Message    string     `rosmsg:"message:string"`
+	HardwareId string     `rosmsg:"hardware_id:string"`
+	Values     []KeyValue `rosmsg:"values:KeyValue[]"`
+}
+
+func (m *DiagnosticStatus) Type() ros.MessageType {
+	return MsgDiagnosticStatus
+}
+
+func (m *DiagnosticStatus) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *DiagnosticStatus) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *DiagnosticStatus) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *DiagnosticStatus) Clone() *DiagnosticStatus {
+	c := *m
+	if m.Values != nil {
+		c.Values = make([]KeyValue, len(m.Values))
+		for i := range m.Values {
+			c.Values[i] = *m.Values[i].Clone()
+		}
+	}
+	return &c
+}
+
+func (m *DiagnosticStatus) Equal(other *DiagnosticStatus) bool {
+	if m.Level != other.Level {
+		return false
+	}
+	if m.Name != other.Name {
+		return false
+	}
+	if m.Message != other.Message {
+		return false
+	}
+	if m.HardwareId != other.HardwareId {
+		return false
+	}
+	if len(m.Values) != len(other.Values) {
+		return false
+	}
+	for i := range m.Values {
+		if !m.Values[i].Equal(&other.Values[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *DiagnosticStatus) SerializedLength() int {
+	length := 0
+	length += 1
+	length += 4 + len(m.Name)
+	length += 4 + len(m.Message)
+	length += 4 + len(m.HardwareId)
+	length += 4
+	for i := range m.Values {
+		length += m.Values[i].SerializedLength()
+	}
+	return length
+}
+
+func (m *DiagnosticStatus) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	buf.WriteByte(m.Level)
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Name)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Name)
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Message)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Message)
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.HardwareId)))
+	buf.Write(b[:4])
+	buf.WriteString(m.HardwareId)
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Values)))
+	buf.Write(b[:4])
+	for i := range m.Values {
+		if err := m.Values[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *DiagnosticStatus) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.Level = b[:][0]
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Name = string(data)
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Message = string(data)
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.HardwareId = string(data)
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*8 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Values = make([]KeyValue, size)
+		for i := range m.Values {
+			if err := m.Values[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+// Automatically generated from the message definition "diagnostic_msgs/KeyValue.msg"
+package diagnostic_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgKeyValue struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgKeyValue) Text() string {
+	return t.text
+}
+
+func (t *_MsgKeyValue) Name() string {
+	return t.name
+}
+
+func (t *_MsgKeyValue) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgKeyValue) NewMessage() ros.Message {
+	m := new(KeyValue)
+	m.Key = ""
+	m.Value = ""
+	return m
+}
+
+var (
+	MsgKeyValue = &_MsgKeyValue{
+		`string key # what to label this value when viewing
+string value # a value to track over time
+`,
+		"diagnostic_msgs/KeyValue",
+		"cf57fdc6617a881a88c16e768132149c",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgKeyValue)
+}
+
+type KeyValue struct {
+	Key   string `rosmsg:"key:string"`
+	Value string `rosmsg:"value:string"`
+}
+
+func (m *KeyValue) Type() ros.MessageType {
+	return MsgKeyValue
+}
+
+func (m *KeyValue) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *KeyValue) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *KeyValue) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *KeyValue) Clone() *KeyValue {
+	c := *m
+	return &c
+}
+
+func (m *KeyValue) Equal(other *KeyValue) bool {
+	if m.Key != other.Key {
+		return false
+	}
+	if m.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+func (m *KeyValue) SerializedLength() int {
+	length := 0
+	length += 4 + len(m.Key)
+	length += 4 + len(m.Value)
+	return length
+}
+
+func (m *KeyValue) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Key)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Key)
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Value)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Value)
+	return nil
+}
+
+func (m *KeyValue) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Key = string(data)
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Value = string(data)
+	}
+	return nil
+}
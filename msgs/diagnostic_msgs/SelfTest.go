@@ -0,0 +1,51 @@
+// Automatically generated from the message definition "diagnostic_msgs/SelfTest.srv"
+package diagnostic_msgs
+
+import (
+	"github.com/akio/rosgo/ros"
+)
+
+// Service type metadata
+type _SrvSelfTest struct {
+	name    string
+	md5sum  string
+	text    string
+	reqType ros.MessageType
+	resType ros.MessageType
+}
+
+func (t *_SrvSelfTest) Name() string                  { return t.name }
+func (t *_SrvSelfTest) MD5Sum() string                { return t.md5sum }
+func (t *_SrvSelfTest) Text() string                  { return t.text }
+func (t *_SrvSelfTest) RequestType() ros.MessageType  { return t.reqType }
+func (t *_SrvSelfTest) ResponseType() ros.MessageType { return t.resType }
+func (t *_SrvSelfTest) NewService() ros.Service {
+	return new(SelfTest)
+}
+
+var (
+	SrvSelfTest = &_SrvSelfTest{
+		"diagnostic_msgs/SelfTest",
+		"ac21b1bab7ab17546986536c22eb34e9",
+		`---
+string id
+byte passed
+DiagnosticStatus[] status
+`,
+		MsgSelfTestRequest,
+		MsgSelfTestResponse,
+	}
+)
+
+func init() {
+	ros.RegisterServiceType(SrvSelfTest)
+}
+
+type SelfTest struct {
+	Request  SelfTestRequest
+	Response SelfTestResponse
+}
+
+func (s *SelfTest) Type() ros.ServiceType   { return SrvSelfTest }
+func (s *SelfTest) ReqMessage() ros.Message { return &s.Request }
+func (s *SelfTest) ResMessage() ros.Message { return &s.Response }
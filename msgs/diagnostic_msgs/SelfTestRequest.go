@@ -0,0 +1,84 @@
+// Automatically generated from the message definition "diagnostic_msgs/SelfTestRequest.msg"
+package diagnostic_msgs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/ros"
+)
+
+type _MsgSelfTestRequest struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgSelfTestRequest) Text() string {
+	return t.text
+}
+
+func (t *_MsgSelfTestRequest) Name() string {
+	return t.name
+}
+
+func (t *_MsgSelfTestRequest) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgSelfTestRequest) NewMessage() ros.Message {
+	m := new(SelfTestRequest)
+	return m
+}
+
+var (
+	MsgSelfTestRequest = &_MsgSelfTestRequest{
+		``,
+		"diagnostic_msgs/SelfTestRequest",
+		"d41d8cd98f00b204e9800998ecf8427e",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgSelfTestRequest)
+}
+
+type SelfTestRequest struct {
+}
+
+func (m *SelfTestRequest) Type() ros.MessageType {
+	return MsgSelfTestRequest
+}
+
+func (m *SelfTestRequest) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *SelfTestRequest) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *SelfTestRequest) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *SelfTestRequest) Clone() *SelfTestRequest {
+	c := *m
+	return &c
+}
+
+func (m *SelfTestRequest) Equal(other *SelfTestRequest) bool {
+	return true
+}
+
+func (m *SelfTestRequest) SerializedLength() int {
+	length := 0
+	return length
+}
+
+func (m *SelfTestRequest) Serialize(buf *bytes.Buffer) error {
+	return nil
+}
+
+func (m *SelfTestRequest) Deserialize(buf *bytes.Reader) error {
+	return nil
+}
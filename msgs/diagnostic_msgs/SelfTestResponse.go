@@ -0,0 +1,191 @@
+// Automatically generated from the message definition "diagnostic_msgs/SelfTestResponse.msg"
+package diagnostic_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgSelfTestResponse struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgSelfTestResponse) Text() string {
+	return t.text
+}
+
+func (t *_MsgSelfTestResponse) Name() string {
+	return t.name
+}
+
+func (t *_MsgSelfTestResponse) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgSelfTestResponse) NewMessage() ros.Message {
+	m := new(SelfTestResponse)
+	m.Id = ""
+	m.Passed = 0
+	m.Status = []DiagnosticStatus{}
+	return m
+}
+
+var (
+	MsgSelfTestResponse = &_MsgSelfTestResponse{
+		`
+string id
+byte passed
+DiagnosticStatus[] status
+
+================================================================================
+MSG: diagnostic_msgs/DiagnosticStatus
+# This message holds the status of an individual component of the robot.
+# 
+
+# Possible levels of operations
+byte OK=0
+byte WARN=1
+byte ERROR=2
+byte STALE=3
+
+byte level # level of operation enumerated above 
+string name # a description of the test/component reporting
+string message # a description of the status
+string hardware_id # a hardware unique string
+KeyValue[] values # an array of values associated with the status
+
+
+================================================================================
+MSG: diagnostic_msgs/KeyValue
+string key # what to label this value when viewing
+string value # a value to track over time
+`,
+		"diagnostic_msgs/SelfTestResponse",
+		"ac21b1bab7ab17546986536c22eb34e9",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgSelfTestResponse)
+}
+
+type SelfTestResponse struct {
+	Id     string             `rosmsg:"id:string"`
+	Passed uint8              `rosmsg:"passed:byte"`
+	Status []DiagnosticStatus `rosmsg:"status:DiagnosticStatus[]"`
+}
+
+func (m *SelfTestResponse) Type() ros.MessageType {
+	return MsgSelfTestResponse
+}
+
+func (m *SelfTestResponse) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *SelfTestResponse) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *SelfTestResponse) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *SelfTestResponse) Clone() *SelfTestResponse {
+	c := *m
+	if m.Status != nil {
+		c.Status = make([]DiagnosticStatus, len(m.Status))
+		for i := range m.Status {
+			c.Status[i] = *m.Status[i].Clone()
+		}
+	}
+	return &c
+}
+
+func (m *SelfTestResponse) Equal(other *SelfTestResponse) bool {
+	if m.Id != other.Id {
+		return false
+	}
+	if m.Passed != other.Passed {
+		return false
+	}
+	if len(m.Status) != len(other.Status) {
+		return false
+	}
+	for i := range m.Status {
+		if !m.Status[i].Equal(&other.Status[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *SelfTestResponse) SerializedLength() int {
+	length := 0
+	length += 4 + len(m.Id)
+	length += 1
+	length += 4
+	for i := range m.Status {
+		length += m.Status[i].SerializedLength()
+	}
+	return length
+}
+
+func (m *SelfTestResponse) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Id)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Id)
+	buf.WriteByte(m.Passed)
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Status)))
+	buf.Write(b[:4])
+	for i := range m.Status {
+		if err := m.Status[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *SelfTestResponse) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Id = string(data)
+	}
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.Passed = b[:][0]
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*17 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Status = make([]DiagnosticStatus, size)
+		for i := range m.Status {
+			if err := m.Status[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+// Automatically generated from the message definition "dynamic_reconfigure/BoolParameter.msg"
+package dynamic_reconfigure
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgBoolParameter struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgBoolParameter) Text() string {
+	return t.text
+}
+
+func (t *_MsgBoolParameter) Name() string {
+	return t.name
+}
+
+func (t *_MsgBoolParameter) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgBoolParameter) NewMessage() ros.Message {
+	m := new(BoolParameter)
+	m.Name = ""
+	m.Value = false
+	return m
+}
+
+var (
+	MsgBoolParameter = &_MsgBoolParameter{
+		`string name
+bool value
+`,
+		"dynamic_reconfigure/BoolParameter",
+		"23f05028c1a699fb83e22401228c3a9e",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgBoolParameter)
+}
+
+type BoolParameter struct {
+	Name  string `rosmsg:"name:string"`
+	Value bool   `rosmsg:"value:bool"`
+}
+
+func (m *BoolParameter) Type() ros.MessageType {
+	return MsgBoolParameter
+}
+
+func (m *BoolParameter) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *BoolParameter) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *BoolParameter) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *BoolParameter) Clone() *BoolParameter {
+	c := *m
+	return &c
+}
+
+func (m *BoolParameter) Equal(other *BoolParameter) bool {
+	if m.Name != other.Name {
+		return false
+	}
+	if m.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+func (m *BoolParameter) SerializedLength() int {
+	length := 0
+	length += 4 + len(m.Name)
+	length += 1
+	return length
+}
+
+func (m *BoolParameter) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Name)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Name)
+	if m.Value {
+		buf.WriteByte(1)
+	} else {
+		buf.WriteByte(0)
+	}
+	return nil
+}
+
+func (m *BoolParameter) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Name = string(data)
+	}
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.Value = b[:][0] != 0
+	return nil
+}
@@ -0,0 +1,330 @@
+// Automatically generated from the message definition "dynamic_reconfigure/Config.msg"
+package dynamic_reconfigure
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgConfig struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgConfig) Text() string {
+	return t.text
+}
+
+func (t *_MsgConfig) Name() string {
+	return t.name
+}
+
+func (t *_MsgConfig) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgConfig) NewMessage() ros.Message {
+	m := new(Config)
+	m.Bools = []BoolParameter{}
+	m.Ints = []IntParameter{}
+	m.Strs = []StrParameter{}
+	m.Doubles = []DoubleParameter{}
+	m.Groups = []GroupState{}
+	return m
+}
+
+var (
+	MsgConfig = &_MsgConfig{
+		`BoolParameter[] bools
+IntParameter[] ints
+StrParameter[] strs
+DoubleParameter[] doubles
+GroupState[] groups
+
+================================================================================
+MSG: dynamic_reconfigure/BoolParameter
+string name
+bool value
+
+================================================================================
+MSG: dynamic_reconfigure/IntParameter
+string name
+int32 value
+
+================================================================================
+MSG: dynamic_reconfigure/StrParameter
+string name
+string value
+
+================================================================================
+MSG: dynamic_reconfigure/DoubleParameter
+string name
+float64 value
+
+================================================================================
+MSG: dynamic_reconfigure/GroupState
+string name
+bool state
+int32 id
+int32 parent
+`,
+		"dynamic_reconfigure/Config",
+		"958f16a05573709014982821e6822580",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgConfig)
+}
+
+type Config struct {
+	Bools   []BoolParameter   `rosmsg:"bools:BoolParameter[]"`
+	Ints    []IntParameter    `rosmsg:"ints:IntParameter[]"`
+	Strs    []StrParameter    `rosmsg:"strs:StrParameter[]"`
+	Doubles []DoubleParameter `rosmsg:"doubles:DoubleParameter[]"`
+	Groups  []GroupState      `rosmsg:"groups:GroupState[]"`
+}
+
+func (m *Config) Type() ros.MessageType {
+	return MsgConfig
+}
+
+func (m *Config) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Config) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Config) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Config) Clone() *Config {
+	c := *m
+	if m.Bools != nil {
+		c.Bools = make([]BoolParameter, len(m.Bools))
+		for i := range m.Bools {
+			c.Bools[i] = *m.Bools[i].Clone()
+		}
+	}
+	if m.Ints != nil {
+		c.Ints = make([]IntParameter, len(m.Ints))
+		for i := range m.Ints {
+			c.Ints[i] = *m.Ints[i].Clone()
+		}
+	}
+	if m.Strs != nil {
+		c.Strs = make([]StrParameter, len(m.Strs))
+		for i := range m.Strs {
+			c.Strs[i] = *m.Strs[i].Clone()
+		}
+	}
+	if m.Doubles != nil {
+		c.Doubles = make([]DoubleParameter, len(m.Doubles))
+		for i := range m.Doubles {
+			c.Doubles[i] = *m.Doubles[i].Clone()
+		}
+	}
+	if m.Groups != nil {
+		c.Groups = make([]GroupState, len(m.Groups))
+		for i := range m.Groups {
+			c.Groups[i] = *m.Groups[i].Clone()
+		}
+	}
+	return &c
+}
+
+func (m *Config) Equal(other *Config) bool {
+	if len(m.Bools) != len(other.Bools) {
+		return false
+	}
+	for i := range m.Bools {
+		if !m.Bools[i].Equal(&other.Bools[i]) {
+			return false
+		}
+	}
+	if len(m.Ints) != len(other.Ints) {
+		return false
+	}
+	for i := range m.Ints {
+		if !m.Ints[i].Equal(&other.Ints[i]) {
+			return false
+		}
+	}
+	if len(m.Strs) != len(other.Strs) {
+		return false
+	}
+	for i := range m.Strs {
+		if !m.Strs[i].Equal(&other.Strs[i]) {
+			return false
+		}
+	}
+	if len(m.Doubles) != len(other.Doubles) {
+		return false
+	}
+	for i := range m.Doubles {
+		if !m.Doubles[i].Equal(&other.Doubles[i]) {
+			return false
+		}
+	}
+	if len(m.Groups) != len(other.Groups) {
+		return false
+	}
+	for i := range m.Groups {
+		if !m.Groups[i].Equal(&other.Groups[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Config) SerializedLength() int {
+	length := 0
+	length += 4
+	for i := range m.Bools {
+		length += m.Bools[i].SerializedLength()
+	}
+	length += 4
+	for i := range m.Ints {
+		length += m.Ints[i].SerializedLength()
+	}
+	length += 4
+	for i := range m.Strs {
+		length += m.Strs[i].SerializedLength()
+	}
+	length += 4
+	for i := range m.Doubles {
+		length += m.Doubles[i].SerializedLength()
+	}
+	length += 4
+	for i := range m.Groups {
+		length += m.Groups[i].SerializedLength()
+	}
+	return length
+}
+
+func (m *Config) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Bools)))
+	buf.Write(b[:4])
+	for i := range m.Bools {
+		if err := m.Bools[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Ints)))
+	buf.Write(b[:4])
+	for i := range m.Ints {
+		if err := m.Ints[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Strs)))
+	buf.Write(b[:4])
+	for i := range m.Strs {
+		if err := m.Strs[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Doubles)))
+	buf.Write(b[:4])
+	for i := range m.Doubles {
+		if err := m.Doubles[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Groups)))
+	buf.Write(b[:4])
+	for i := range m.Groups {
+		if err := m.Groups[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Config) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*5 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Bools = make([]BoolParameter, size)
+		for i := range m.Bools {
+			if err := m.Bools[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*8 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Ints = make([]IntParameter, size)
+		for i := range m.Ints {
+			if err := m.Ints[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*8 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Strs = make([]StrParameter, size)
+		for i := range m.Strs {
+			if err := m.Strs[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*12 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Doubles = make([]DoubleParameter, size)
+		for i := range m.Doubles {
+			if err := m.Doubles[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*13 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Groups = make([]GroupState, size)
+		for i := range m.Groups {
+			if err := m.Groups[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
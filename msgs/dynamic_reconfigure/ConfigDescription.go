@@ -0,0 +1,224 @@
+// Automatically generated from the message definition "dynamic_reconfigure/ConfigDescription.msg"
+package dynamic_reconfigure
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgConfigDescription struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgConfigDescription) Text() string {
+	return t.text
+}
+
+func (t *_MsgConfigDescription) Name() string {
+	return t.name
+}
+
+func (t *_MsgConfigDescription) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgConfigDescription) NewMessage() ros.Message {
+	m := new(ConfigDescription)
+	m.Groups = []Group{}
+	m.Max = Config{}
+	m.Min = Config{}
+	m.Dflt = Config{}
+	return m
+}
+
+var (
+	MsgConfigDescription = &_MsgConfigDescription{
+		`Group[] groups
+Config max
+Config min
+Config dflt
+
+================================================================================
+MSG: dynamic_reconfigure/Group
+string name
+string type
+ParamDescription[] parameters
+int32 parent 
+int32 id
+
+================================================================================
+MSG: dynamic_reconfigure/ParamDescription
+string name
+string type
+uint32 level
+string description
+string edit_method
+
+================================================================================
+MSG: dynamic_reconfigure/Config
+BoolParameter[] bools
+IntParameter[] ints
+StrParameter[] strs
+DoubleParameter[] doubles
+GroupState[] groups
+
+================================================================================
+MSG: dynamic_reconfigure/BoolParameter
+string name
+bool value
+
+================================================================================
+MSG: dynamic_reconfigure/IntParameter
+string name
+int32 value
+
+================================================================================
+MSG: dynamic_reconfigure/StrParameter
+string name
+string value
+
+================================================================================
+MSG: dynamic_reconfigure/DoubleParameter
+string name
+float64 value
+
+================================================================================
+MSG: dynamic_reconfigure/GroupState
+string name
+bool state
+int32 id
+int32 parent
+`,
+		"dynamic_reconfigure/ConfigDescription",
+		"757ce9d44ba8ddd801bb30bc456f946f",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgConfigDescription)
+}
+
+type ConfigDescription struct {
+	Groups []Group `rosmsg:"groups:Group[]"`
+	Max    Config  `rosmsg:"max:Config"`
+	Min    Config  `rosmsg:"min:Config"`
+	Dflt   Config  `rosmsg:"dflt:Config"`
+}
+
+func (m *ConfigDescription) Type() ros.MessageType {
+	return MsgConfigDescription
+}
+
+func (m *ConfigDescription) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *ConfigDescription) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *ConfigDescription) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *ConfigDescription) Clone() *ConfigDescription {
+	c := *m
+	if m.Groups != nil {
+		c.Groups = make([]Group, len(m.Groups))
+		for i := range m.Groups {
+			c.Groups[i] = *m.Groups[i].Clone()
+		}
+	}
+	c.Max = *m.Max.Clone()
+	c.Min = *m.Min.Clone()
+	c.Dflt = *m.Dflt.Clone()
+	return &c
+}
+
+func (m *ConfigDescription) Equal(other *ConfigDescription) bool {
+	if len(m.Groups) != len(other.Groups) {
+		return false
+	}
+	for i := range m.Groups {
+		if !m.Groups[i].Equal(&other.Groups[i]) {
+			return false
+		}
+	}
+	if !m.Max.Equal(&other.Max) {
+		return false
+	}
+	if !m.Min.Equal(&other.Min) {
+		return false
+	}
+	if !m.Dflt.Equal(&other.Dflt) {
+		return false
+	}
+	return true
+}
+
+func (m *ConfigDescription) SerializedLength() int {
+	length := 0
+	length += 4
+	for i := range m.Groups {
+		length += m.Groups[i].SerializedLength()
+	}
+	length += m.Max.SerializedLength()
+	length += m.Min.SerializedLength()
+	length += m.Dflt.SerializedLength()
+	return length
+}
+
+func (m *ConfigDescription) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Groups)))
+	buf.Write(b[:4])
+	for i := range m.Groups {
+		if err := m.Groups[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	if err := m.Max.Serialize(buf); err != nil {
+		return err
+	}
+	if err := m.Min.Serialize(buf); err != nil {
+		return err
+	}
+	if err := m.Dflt.Serialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ConfigDescription) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*20 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Groups = make([]Group, size)
+		for i := range m.Groups {
+			if err := m.Groups[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	if err := m.Max.Deserialize(buf); err != nil {
+		return err
+	}
+	if err := m.Min.Deserialize(buf); err != nil {
+		return err
+	}
+	if err := m.Dflt.Deserialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+// Automatically generated from the message definition "dynamic_reconfigure/DoubleParameter.msg"
+package dynamic_reconfigure
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgDoubleParameter struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgDoubleParameter) Text() string {
+	return t.text
+}
+
+func (t *_MsgDoubleParameter) Name() string {
+	return t.name
+}
+
+func (t *_MsgDoubleParameter) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgDoubleParameter) NewMessage() ros.Message {
+	m := new(DoubleParameter)
+	m.Name = ""
+	m.Value = 0.0
+	return m
+}
+
+var (
+	MsgDoubleParameter = &_MsgDoubleParameter{
+		`string name
+float64 value
+`,
+		"dynamic_reconfigure/DoubleParameter",
+		"d8512f27253c0f65f928a67c329cd658",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgDoubleParameter)
+}
+
+type DoubleParameter struct {
+	Name  string  `rosmsg:"name:string"`
+	Value float64 `rosmsg:"value:float64"`
+}
+
+func (m *DoubleParameter) Type() ros.MessageType {
+	return MsgDoubleParameter
+}
+
+func (m *DoubleParameter) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *DoubleParameter) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *DoubleParameter) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *DoubleParameter) Clone() *DoubleParameter {
+	c := *m
+	return &c
+}
+
+func (m *DoubleParameter) Equal(other *DoubleParameter) bool {
+	if m.Name != other.Name {
+		return false
+	}
+	if m.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+func (m *DoubleParameter) SerializedLength() int {
+	length := 0
+	length += 4 + len(m.Name)
+	length += 8
+	return length
+}
+
+func (m *DoubleParameter) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Name)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Name)
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.Value))
+	buf.Write(b[:8])
+	return nil
+}
+
+func (m *DoubleParameter) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Name = string(data)
+	}
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Value = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	return nil
+}
@@ -0,0 +1,213 @@
+// Automatically generated from the message definition "dynamic_reconfigure/Group.msg"
+package dynamic_reconfigure
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgGroup struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgGroup) Text() string {
+	return t.text
+}
+
+func (t *_MsgGroup) Name() string {
+	return t.name
+}
+
+func (t *_MsgGroup) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgGroup) NewMessage() ros.Message {
+	m := new(Group)
+	m.Name = ""
+	m.Type_ = ""
+	m.Parameters = []ParamDescription{}
+	m.Parent = 0
+	m.Id = 0
+	return m
+}
+
+var (
+	MsgGroup = &_MsgGroup{
+		`string name
+string type
+ParamDescription[] parameters
+int32 parent 
+int32 id
+
+================================================================================
+MSG: dynamic_reconfigure/ParamDescription
+string name
+string type
+uint32 level
+string description
+string edit_method
+`,
+		"dynamic_reconfigure/Group",
+		"9e8cd9e9423c94823db3614dd8b1cf7a",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgGroup)
+}
+
+type Group struct {
+	Name       string             `rosmsg:"name:string"`
+	Type_      string             `rosmsg:"type:string"`
+	Parameters []ParamDescription `rosmsg:"parameters:ParamDescription[]"`
+	Parent     int32              `rosmsg:"parent:int32"`
+	Id         int32              `rosmsg:"id:int32"`
+}
+
+func (m *Group) Type() ros.MessageType {
+	return MsgGroup
+}
+
+func (m *Group) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Group) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Group) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Group) Clone() *Group {
+	c := *m
+	if m.Parameters != nil {
+		c.Parameters = make([]ParamDescription, len(m.Parameters))
+		for i := range m.Parameters {
+			c.Parameters[i] = *m.Parameters[i].Clone()
+		}
+	}
+	return &c
+}
+
+func (m *Group) Equal(other *Group) bool {
+	if m.Name != other.Name {
+		return false
+	}
+	if m.Type_ != other.Type_ {
+		return false
+	}
+	if len(m.Parameters) != len(other.Parameters) {
+		return false
+	}
+	for i := range m.Parameters {
+		if !m.Parameters[i].Equal(&other.Parameters[i]) {
+			return false
+		}
+	}
+	if m.Parent != other.Parent {
+		return false
+	}
+	if m.Id != other.Id {
+		return false
+	}
+	return true
+}
+
+func (m *Group) SerializedLength() int {
+	length := 0
+	length += 4 + len(m.Name)
+	length += 4 + len(m.Type_)
+	length += 4
+	for i := range m.Parameters {
+		length += m.Parameters[i].SerializedLength()
+	}
+	length += 4
+	length += 4
+	return length
+}
+
+func (m *Group) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Name)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Name)
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Type_)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Type_)
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Parameters)))
+	buf.Write(b[:4])
+	for i := range m.Parameters {
+		if err := m.Parameters[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	binary.LittleEndian.PutUint32(b[:], uint32(m.Parent))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], uint32(m.Id))
+	buf.Write(b[:4])
+	return nil
+}
+
+func (m *Group) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Name = string(data)
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Type_ = string(data)
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*20 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Parameters = make([]ParamDescription, size)
+		for i := range m.Parameters {
+			if err := m.Parameters[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Parent = int32(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Id = int32(binary.LittleEndian.Uint32(b[:]))
+	return nil
+}
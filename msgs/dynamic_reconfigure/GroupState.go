@@ -0,0 +1,154 @@
+// Automatically generated from the message definition "dynamic_reconfigure/GroupState.msg"
+package dynamic_reconfigure
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgGroupState struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgGroupState) Text() string {
+	return t.text
+}
+
+func (t *_MsgGroupState) Name() string {
+	return t.name
+}
+
+func (t *_MsgGroupState) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgGroupState) NewMessage() ros.Message {
+	m := new(GroupState)
+	m.Name = ""
+	m.State = false
+	m.Id = 0
+	m.Parent = 0
+	return m
+}
+
+var (
+	MsgGroupState = &_MsgGroupState{
+		`string name
+bool state
+int32 id
+int32 parent
+`,
+		"dynamic_reconfigure/GroupState",
+		"a2d87f51dc22930325041a2f8b1571f8",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgGroupState)
+}
+
+type GroupState struct {
+	Name   string `rosmsg:"name:string"`
+	State  bool   `rosmsg:"state:bool"`
+	Id     int32  `rosmsg:"id:int32"`
+	Parent int32  `rosmsg:"parent:int32"`
+}
+
+func (m *GroupState) Type() ros.MessageType {
+	return MsgGroupState
+}
+
+func (m *GroupState) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *GroupState) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *GroupState) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *GroupState) Clone() *GroupState {
+	c := *m
+	return &c
+}
+
+func (m *GroupState) Equal(other *GroupState) bool {
+	if m.Name != other.Name {
+		return false
+	}
+	if m.State != other.State {
+		return false
+	}
+	if m.Id != other.Id {
+		return false
+	}
+	if m.Parent != other.Parent {
+		return false
+	}
+	return true
+}
+
+func (m *GroupState) SerializedLength() int {
+	length := 0
+	length += 4 + len(m.Name)
+	length += 1
+	length += 4
+	length += 4
+	return length
+}
+
+func (m *GroupState) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Name)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Name)
+	if m.State {
+		buf.WriteByte(1)
+	} else {
+		buf.WriteByte(0)
+	}
+	binary.LittleEndian.PutUint32(b[:], uint32(m.Id))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], uint32(m.Parent))
+	buf.Write(b[:4])
+	return nil
+}
+
+func (m *GroupState) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Name = string(data)
+	}
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.State = b[:][0] != 0
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Id = int32(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Parent = int32(binary.LittleEndian.Uint32(b[:]))
+	return nil
+}
@@ -0,0 +1,125 @@
+// Automatically generated from the message definition "dynamic_reconfigure/IntParameter.msg"
+package dynamic_reconfigure
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgIntParameter struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgIntParameter) Text() string {
+	return t.text
+}
+
+func (t *_MsgIntParameter) Name() string {
+	return t.name
+}
+
+func (t *_MsgIntParameter) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgIntParameter) NewMessage() ros.Message {
+	m := new(IntParameter)
+	m.Name = ""
+	m.Value = 0
+	return m
+}
+
+var (
+	MsgIntParameter = &_MsgIntParameter{
+		`string name
+int32 value
+`,
+		"dynamic_reconfigure/IntParameter",
+		"65fedc7a0cbfb8db035e46194a350bf1",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgIntParameter)
+}
+
+type IntParameter struct {
+	Name  string `rosmsg:"name:string"`
+	Value int32  `rosmsg:"value:int32"`
+}
+
+func (m *IntParameter) Type() ros.MessageType {
+	return MsgIntParameter
+}
+
+func (m *IntParameter) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *IntParameter) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *IntParameter) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *IntParameter) Clone() *IntParameter {
+	c := *m
+	return &c
+}
+
+func (m *IntParameter) Equal(other *IntParameter) bool {
+	if m.Name != other.Name {
+		return false
+	}
+	if m.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+func (m *IntParameter) SerializedLength() int {
+	length := 0
+	length += 4 + len(m.Name)
+	length += 4
+	return length
+}
+
+func (m *IntParameter) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Name)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Name)
+	binary.LittleEndian.PutUint32(b[:], uint32(m.Value))
+	buf.Write(b[:4])
+	return nil
+}
+
+func (m *IntParameter) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Name = string(data)
+	}
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Value = int32(binary.LittleEndian.Uint32(b[:]))
+	return nil
+}
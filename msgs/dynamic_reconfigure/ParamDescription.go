@@ -0,0 +1,197 @@
+// Automatically generated from the message definition "dynamic_reconfigure/ParamDescription.msg"
+package dynamic_reconfigure
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgParamDescription struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgParamDescription) Text() string {
+	return t.text
+}
+
+func (t *_MsgParamDescription) Name() string {
+	return t.name
+}
+
+func (t *_MsgParamDescription) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgParamDescription) NewMessage() ros.Message {
+	m := new(ParamDescription)
+	m.Name = ""
+	m.Type_ = ""
+	m.Level = 0
+	m.Description = ""
+	m.EditMethod = ""
+	return m
+}
+
+var (
+	MsgParamDescription = &_MsgParamDescription{
+		`string name
+string type
+uint32 level
+string description
+string edit_method
+`,
+		"dynamic_reconfigure/ParamDescription",
+		"7434fcb9348c13054e0c3b267c8cb34d",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgParamDescription)
+}
+
+type ParamDescription struct {
+	Name        string `rosmsg:"name:string"`
+	Type_       string `rosmsg:"type:string"`
+	Level       uint32 `rosmsg:"level:uint32"`
+	Description string `rosmsg:"description:string"`
+	EditMethod  string `rosmsg:"edit_method:string"`
+}
+
+func (m *ParamDescription) Type() ros.MessageType {
+	return MsgParamDescription
+}
+
+func (m *ParamDescription) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *ParamDescription) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *ParamDescription) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *ParamDescription) Clone() *ParamDescription {
+	c := *m
+	return &c
+}
+
+func (m *ParamDescription) Equal(other *ParamDescription) bool {
+	if m.Name != other.Name {
+		return false
+	}
+	if m.Type_ != other.Type_ {
+		return false
+	}
+	if m.Level != other.Level {
+		return false
+	}
+	if m.Description != other.Description {
+		return false
+	}
+	if m.EditMethod != other.EditMethod {
+		return false
+	}
+	return true
+}
+
+func (m *ParamDescription) SerializedLength() int {
+	length := 0
+	length += 4 + len(m.Name)
+	length += 4 + len(m.Type_)
+	length += 4
+	length += 4 + len(m.Description)
+	length += 4 + len(m.EditMethod)
+	return length
+}
+
+func (m *ParamDescription) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Name)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Name)
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Type_)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Type_)
+	binary.LittleEndian.PutUint32(b[:], m.Level)
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Description)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Description)
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.EditMethod)))
+	buf.Write(b[:4])
+	buf.WriteString(m.EditMethod)
+	return nil
+}
+
+func (m *ParamDescription) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Name = string(data)
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Type_ = string(data)
+	}
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Level = binary.LittleEndian.Uint32(b[:])
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Description = string(data)
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.EditMethod = string(data)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+// Automatically generated from the message definition "dynamic_reconfigure/Reconfigure.srv"
+package dynamic_reconfigure
+
+import (
+	"github.com/akio/rosgo/ros"
+)
+
+// Service type metadata
+type _SrvReconfigure struct {
+	name    string
+	md5sum  string
+	text    string
+	reqType ros.MessageType
+	resType ros.MessageType
+}
+
+func (t *_SrvReconfigure) Name() string                  { return t.name }
+func (t *_SrvReconfigure) MD5Sum() string                { return t.md5sum }
+func (t *_SrvReconfigure) Text() string                  { return t.text }
+func (t *_SrvReconfigure) RequestType() ros.MessageType  { return t.reqType }
+func (t *_SrvReconfigure) ResponseType() ros.MessageType { return t.resType }
+func (t *_SrvReconfigure) NewService() ros.Service {
+	return new(Reconfigure)
+}
+
+var (
+	SrvReconfigure = &_SrvReconfigure{
+		"dynamic_reconfigure/Reconfigure",
+		"bb125d226a21982a4a98760418dc2672",
+		`Config config
+---
+Config config
+`,
+		MsgReconfigureRequest,
+		MsgReconfigureResponse,
+	}
+)
+
+func init() {
+	ros.RegisterServiceType(SrvReconfigure)
+}
+
+type Reconfigure struct {
+	Request  ReconfigureRequest
+	Response ReconfigureResponse
+}
+
+func (s *Reconfigure) Type() ros.ServiceType   { return SrvReconfigure }
+func (s *Reconfigure) ReqMessage() ros.Message { return &s.Request }
+func (s *Reconfigure) ResMessage() ros.Message { return &s.Response }
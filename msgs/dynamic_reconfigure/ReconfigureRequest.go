@@ -0,0 +1,133 @@
+// Automatically generated from the message definition "dynamic_reconfigure/ReconfigureRequest.msg"
+package dynamic_reconfigure
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/ros"
+)
+
+type _MsgReconfigureRequest struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgReconfigureRequest) Text() string {
+	return t.text
+}
+
+func (t *_MsgReconfigureRequest) Name() string {
+	return t.name
+}
+
+func (t *_MsgReconfigureRequest) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgReconfigureRequest) NewMessage() ros.Message {
+	m := new(ReconfigureRequest)
+	m.Config = Config{}
+	return m
+}
+
+var (
+	MsgReconfigureRequest = &_MsgReconfigureRequest{
+		`Config config
+
+================================================================================
+MSG: dynamic_reconfigure/Config
+BoolParameter[] bools
+IntParameter[] ints
+StrParameter[] strs
+DoubleParameter[] doubles
+GroupState[] groups
+
+================================================================================
+MSG: dynamic_reconfigure/BoolParameter
+string name
+bool value
+
+================================================================================
+MSG: dynamic_reconfigure/IntParameter
+string name
+int32 value
+
+================================================================================
+MSG: dynamic_reconfigure/StrParameter
+string name
+string value
+
+================================================================================
+MSG: dynamic_reconfigure/DoubleParameter
+string name
+float64 value
+
+================================================================================
+MSG: dynamic_reconfigure/GroupState
+string name
+bool state
+int32 id
+int32 parent
+`,
+		"dynamic_reconfigure/ReconfigureRequest",
+		"ac41a77620a4a0348b7001641796a8a1",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgReconfigureRequest)
+}
+
+type ReconfigureRequest struct {
+	Config Config `rosmsg:"config:Config"`
+}
+
+func (m *ReconfigureRequest) Type() ros.MessageType {
+	return MsgReconfigureRequest
+}
+
+func (m *ReconfigureRequest) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *ReconfigureRequest) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *ReconfigureRequest) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *ReconfigureRequest) Clone() *ReconfigureRequest {
+	c := *m
+	c.Config = *m.Config.Clone()
+	return &c
+}
+
+func (m *ReconfigureRequest) Equal(other *ReconfigureRequest) bool {
+	if !m.Config.Equal(&other.Config) {
+		return false
+	}
+	return true
+}
+
+func (m *ReconfigureRequest) SerializedLength() int {
+	length := 0
+	length += m.Config.SerializedLength()
+	return length
+}
+
+func (m *ReconfigureRequest) Serialize(buf *bytes.Buffer) error {
+	if err := m.Config.Serialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ReconfigureRequest) Deserialize(buf *bytes.Reader) error {
+	if err := m.Config.Deserialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
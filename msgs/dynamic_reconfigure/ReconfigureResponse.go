@@ -0,0 +1,134 @@
+// Automatically generated from the message definition "dynamic_reconfigure/ReconfigureResponse.msg"
+package dynamic_reconfigure
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/ros"
+)
+
+type _MsgReconfigureResponse struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgReconfigureResponse) Text() string {
+	return t.text
+}
+
+func (t *_MsgReconfigureResponse) Name() string {
+	return t.name
+}
+
+func (t *_MsgReconfigureResponse) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgReconfigureResponse) NewMessage() ros.Message {
+	m := new(ReconfigureResponse)
+	m.Config = Config{}
+	return m
+}
+
+var (
+	MsgReconfigureResponse = &_MsgReconfigureResponse{
+		`
+Config config
+
+================================================================================
+MSG: dynamic_reconfigure/Config
+BoolParameter[] bools
+IntParameter[] ints
+StrParameter[] strs
+DoubleParameter[] doubles
+GroupState[] groups
+
+================================================================================
+MSG: dynamic_reconfigure/BoolParameter
+string name
+bool value
+
+================================================================================
+MSG: dynamic_reconfigure/IntParameter
+string name
+int32 value
+
+================================================================================
+MSG: dynamic_reconfigure/StrParameter
+string name
+string value
+
+================================================================================
+MSG: dynamic_reconfigure/DoubleParameter
+string name
+float64 value
+
+================================================================================
+MSG: dynamic_reconfigure/GroupState
+string name
+bool state
+int32 id
+int32 parent
+`,
+		"dynamic_reconfigure/ReconfigureResponse",
+		"ac41a77620a4a0348b7001641796a8a1",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgReconfigureResponse)
+}
+
+type ReconfigureResponse struct {
+	Config Config `rosmsg:"config:Config"`
+}
+
+func (m *ReconfigureResponse) Type() ros.MessageType {
+	return MsgReconfigureResponse
+}
+
+func (m *ReconfigureResponse) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *ReconfigureResponse) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *ReconfigureResponse) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *ReconfigureResponse) Clone() *ReconfigureResponse {
+	c := *m
+	c.Config = *m.Config.Clone()
+	return &c
+}
+
+func (m *ReconfigureResponse) Equal(other *ReconfigureResponse) bool {
+	if !m.Config.Equal(&other.Config) {
+		return false
+	}
+	return true
+}
+
+func (m *ReconfigureResponse) SerializedLength() int {
+	length := 0
+	length += m.Config.SerializedLength()
+	return length
+}
+
+func (m *ReconfigureResponse) Serialize(buf *bytes.Buffer) error {
+	if err := m.Config.Serialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ReconfigureResponse) Deserialize(buf *bytes.Reader) error {
+	if err := m.Config.Deserialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
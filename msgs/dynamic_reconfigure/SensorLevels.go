@@ -0,0 +1,105 @@
+// Automatically generated from the message definition "dynamic_reconfigure/SensorLevels.msg"
+package dynamic_reconfigure
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/ros"
+)
+
+const (
+	SensorLevels_RECONFIGURE_CLOSE   byte = 3
+	SensorLevels_RECONFIGURE_STOP    byte = 1
+	SensorLevels_RECONFIGURE_RUNNING byte = 0
+)
+
+// Names of the constants before they were scoped by the message.
+const (
+	// Deprecated: Use SensorLevels_RECONFIGURE_CLOSE.
+	RECONFIGURECLOSE = SensorLevels_RECONFIGURE_CLOSE
+	// Deprecated: Use SensorLevels_RECONFIGURE_STOP.
+	RECONFIGURESTOP = SensorLevels_RECONFIGURE_STOP
+	// Deprecated: Use SensorLevels_RECONFIGURE_RUNNING.
+	RECONFIGURERUNNING = SensorLevels_RECONFIGURE_RUNNING
+)
+
+type _MsgSensorLevels struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgSensorLevels) Text() string {
+	return t.text
+}
+
+func (t *_MsgSensorLevels) Name() string {
+	return t.name
+}
+
+func (t *_MsgSensorLevels) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgSensorLevels) NewMessage() ros.Message {
+	m := new(SensorLevels)
+	return m
+}
+
+var (
+	MsgSensorLevels = &_MsgSensorLevels{
+		`# This message is deprecated, please use driver_base/SensorLevels instead.
+
+byte RECONFIGURE_CLOSE = 3  # Parameters that need a sensor to be stopped completely when changed
+byte RECONFIGURE_STOP = 1  # Parameters that need a sensor to stop streaming when changed
+byte RECONFIGURE_RUNNING = 0 # Parameters that can be changed while a sensor is streaming
+`,
+		"dynamic_reconfigure/SensorLevels",
+		"6322637bee96d5489db6e2127c47602c",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgSensorLevels)
+}
+
+type SensorLevels struct {
+}
+
+func (m *SensorLevels) Type() ros.MessageType {
+	return MsgSensorLevels
+}
+
+func (m *SensorLevels) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *SensorLevels) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *SensorLevels) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *SensorLevels) Clone() *SensorLevels {
+	c := *m
+	return &c
+}
+
+func (m *SensorLevels) Equal(other *SensorLevels) bool {
+	return true
+}
+
+func (m *SensorLevels) SerializedLength() int {
+	length := 0
+	return length
+}
+
+func (m *SensorLevels) Serialize(buf *bytes.Buffer) error {
+	return nil
+}
+
+func (m *SensorLevels) Deserialize(buf *bytes.Reader) error {
+	return nil
+}
@@ -0,0 +1,136 @@
+// Automatically generated from the message definition "dynamic_reconfigure/StrParameter.msg"
+package dynamic_reconfigure
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgStrParameter struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgStrParameter) Text() string {
+	return t.text
+}
+
+func (t *_MsgStrParameter) Name() string {
+	return t.name
+}
+
+func (t *_MsgStrParameter) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgStrParameter) NewMessage() ros.Message {
+	m := new(StrParameter)
+	m.Name = ""
+	m.Value = ""
+	return m
+}
+
+var (
+	MsgStrParameter = &_MsgStrParameter{
+		`string name
+string value
+`,
+		"dynamic_reconfigure/StrParameter",
+		"bc6ccc4a57f61779c8eaae61e9f422e0",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgStrParameter)
+}
+
+type StrParameter struct {
+	Name  string `rosmsg:"name:string"`
+	Value string `rosmsg:"value:string"`
+}
+
+func (m *StrParameter) Type() ros.MessageType {
+	return MsgStrParameter
+}
+
+func (m *StrParameter) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *StrParameter) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *StrParameter) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *StrParameter) Clone() *StrParameter {
+	c := *m
+	return &c
+}
+
+func (m *StrParameter) Equal(other *StrParameter) bool {
+	if m.Name != other.Name {
+		return false
+	}
+	if m.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+func (m *StrParameter) SerializedLength() int {
+	length := 0
+	length += 4 + len(m.Name)
+	length += 4 + len(m.Value)
+	return length
+}
+
+func (m *StrParameter) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Name)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Name)
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Value)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Value)
+	return nil
+}
+
+func (m *StrParameter) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Name = string(data)
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Value = string(data)
+	}
+	return nil
+}
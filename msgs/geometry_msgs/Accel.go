@@ -0,0 +1,126 @@
+// Automatically generated from the message definition "geometry_msgs/Accel.msg"
+package geometry_msgs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/ros"
+)
+
+type _MsgAccel struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgAccel) Text() string {
+	return t.text
+}
+
+func (t *_MsgAccel) Name() string {
+	return t.name
+}
+
+func (t *_MsgAccel) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgAccel) NewMessage() ros.Message {
+	m := new(Accel)
+	m.Linear = Vector3{}
+	m.Angular = Vector3{}
+	return m
+}
+
+var (
+	MsgAccel = &_MsgAccel{
+		`# This expresses acceleration in free space broken into its linear and angular parts.
+Vector3  linear
+Vector3  angular
+
+================================================================================
+MSG: geometry_msgs/Vector3
+# This represents a vector in free space. 
+# It is only meant to represent a direction. Therefore, it does not
+# make sense to apply a translation to it (e.g., when applying a 
+# generic rigid transformation to a Vector3, tf2 will only apply the
+# rotation). If you want your data to be translatable too, use the
+# geometry_msgs/Point message instead.
+
+float64 x
+float64 y
+float64 z
+`,
+		"geometry_msgs/Accel",
+		"9f195f881246fdfa2798d1d3eebca84a",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgAccel)
+}
+
+type Accel struct {
+	Linear  Vector3 `rosmsg:"linear:Vector3"`
+	Angular Vector3 `rosmsg:"angular:Vector3"`
+}
+
+func (m *Accel) Type() ros.MessageType {
+	return MsgAccel
+}
+
+func (m *Accel) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Accel) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Accel) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Accel) Clone() *Accel {
+	c := *m
+	c.Linear = *m.Linear.Clone()
+	c.Angular = *m.Angular.Clone()
+	return &c
+}
+
+func (m *Accel) Equal(other *Accel) bool {
+	if !m.Linear.Equal(&other.Linear) {
+		return false
+	}
+	if !m.Angular.Equal(&other.Angular) {
+		return false
+	}
+	return true
+}
+
+func (m *Accel) SerializedLength() int {
+	length := 0
+	length += m.Linear.SerializedLength()
+	length += m.Angular.SerializedLength()
+	return length
+}
+
+func (m *Accel) Serialize(buf *bytes.Buffer) error {
+	if err := m.Linear.Serialize(buf); err != nil {
+		return err
+	}
+	if err := m.Angular.Serialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Accel) Deserialize(buf *bytes.Reader) error {
+	if err := m.Linear.Deserialize(buf); err != nil {
+		return err
+	}
+	if err := m.Angular.Deserialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+// Automatically generated from the message definition "geometry_msgs/AccelStamped.msg"
+package geometry_msgs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+type _MsgAccelStamped struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgAccelStamped) Text() string {
+	return t.text
+}
+
+func (t *_MsgAccelStamped) Name() string {
+	return t.name
+}
+
+func (t *_MsgAccelStamped) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgAccelStamped) NewMessage() ros.Message {
+	m := new(AccelStamped)
+	m.Header = std_msgs.Header{}
+	m.Accel = Accel{}
+	return m
+}
+
+var (
+	MsgAccelStamped = &_MsgAccelStamped{
+		`# An accel with reference coordinate frame and timestamp
+Header header
+Accel accel
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: geometry_msgs/Accel
+# This expresses acceleration in free space broken into its linear and angular parts.
+Vector3  linear
+Vector3  angular
+
+================================================================================
+MSG: geometry_msgs/Vector3
+# This represents a vector in free space. 
+# It is only meant to represent a direction. Therefore, it does not
+# make sense to apply a translation to it (e.g., when applying a 
+# generic rigid transformation to a Vector3, tf2 will only apply the
+# rotation). If you want your data to be translatable too, use the
+# geometry_msgs/Point message instead.
+
+float64 x
+float64 y
+float64 z
+`,
+		"geometry_msgs/AccelStamped",
+		"d8a98a5d81351b6eb0578c78557e7659",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgAccelStamped)
+}
+
+type AccelStamped struct {
+	Header std_msgs.Header `rosmsg:"header:Header"`
+	Accel  Accel           `rosmsg:"accel:Accel"`
+}
+
+func (m *AccelStamped) Type() ros.MessageType {
+	return MsgAccelStamped
+}
+
+func (m *AccelStamped) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *AccelStamped) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *AccelStamped) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *AccelStamped) Clone() *AccelStamped {
+	c := *m
+	c.Header = *m.Header.Clone()
+	c.Accel = *m.Accel.Clone()
+	return &c
+}
+
+func (m *AccelStamped) Equal(other *AccelStamped) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if !m.Accel.Equal(&other.Accel) {
+		return false
+	}
+	return true
+}
+
+func (m *AccelStamped) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += m.Accel.SerializedLength()
+	return length
+}
+
+func (m *AccelStamped) Serialize(buf *bytes.Buffer) error {
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	if err := m.Accel.Serialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *AccelStamped) Deserialize(buf *bytes.Reader) error {
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	if err := m.Accel.Deserialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+// Automatically generated from the message definition "geometry_msgs/AccelWithCovariance.msg"
+package geometry_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgAccelWithCovariance struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgAccelWithCovariance) Text() string {
+	return t.text
+}
+
+func (t *_MsgAccelWithCovariance) Name() string {
+	return t.name
+}
+
+func (t *_MsgAccelWithCovariance) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgAccelWithCovariance) NewMessage() ros.Message {
+	m := new(AccelWithCovariance)
+	m.Accel = Accel{}
+	for i := 0; i < 36; i++ {
+		m.Covariance[i] = 0.0
+	}
+	return m
+}
+
+var (
+	MsgAccelWithCovariance = &_MsgAccelWithCovariance{
+		`# This expresses acceleration in free space with uncertainty.
+
+Accel accel
+
+# Row-major representation of the 6x6 covariance matrix
+# The orientation parameters use a fixed-axis representation.
+# In order, the parameters are:
+# (x, y, z, rotation about X axis, rotation about Y axis, rotation about Z axis)
+float64[36] covariance
+
+================================================================================
+MSG: geometry_msgs/Accel
+# This expresses acceleration in free space broken into its linear and angular parts.
+Vector3  linear
+Vector3  angular
+
+================================================================================
+MSG: geometry_msgs/Vector3
+# This represents a vector in free space. 
+# It is only meant to represent a direction. Therefore, it does not
+# make sense to apply a translation to it (e.g., when applying a 
+# generic rigid transformation to a Vector3, tf2 will only apply the
+# rotation). If you want your data to be translatable too, use the
+# geometry_msgs/Point message instead.
+
+float64 x
+float64 y
+float64 z
+`,
+		"geometry_msgs/AccelWithCovariance",
+		"ad5a718d699c6be72a02b8d6a139f334",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgAccelWithCovariance)
+}
+
+type AccelWithCovariance struct {
+	Accel      Accel       `rosmsg:"accel:Accel"`
+	Covariance [36]float64 `rosmsg:"covariance:float64[36]"`
+}
+
+func (m *AccelWithCovariance) Type() ros.MessageType {
+	return MsgAccelWithCovariance
+}
+
+func (m *AccelWithCovariance) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *AccelWithCovariance) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *AccelWithCovariance) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *AccelWithCovariance) Clone() *AccelWithCovariance {
+	c := *m
+	c.Accel = *m.Accel.Clone()
+	return &c
+}
+
+func (m *AccelWithCovariance) Equal(other *AccelWithCovariance) bool {
+	if !m.Accel.Equal(&other.Accel) {
+		return false
+	}
+	if m.Covariance != other.Covariance {
+		return false
+	}
+	return true
+}
+
+func (m *AccelWithCovariance) SerializedLength() int {
+	length := 0
+	length += m.Accel.SerializedLength()
+	length += 8 * len(m.Covariance)
+	return length
+}
+
+func (m *AccelWithCovariance) Serialize(buf *bytes.Buffer) error {
+	if err := m.Accel.Serialize(buf); err != nil {
+		return err
+	}
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 8 * len(m.Covariance)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.Covariance {
+			binary.LittleEndian.PutUint64(data[8*i:], math.Float64bits(e))
+		}
+		buf.Write(data)
+	}
+	return nil
+}
+
+func (m *AccelWithCovariance) Deserialize(buf *bytes.Reader) error {
+	if err := m.Accel.Deserialize(buf); err != nil {
+		return err
+	}
+	{
+		data := make([]byte, 8*len(m.Covariance))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.Covariance {
+			m.Covariance[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[8*i:]))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+// Automatically generated from the message definition "geometry_msgs/Inertia.msg"
+package geometry_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgInertia struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgInertia) Text() string {
+	return t.text
+}
+
+func (t *_MsgInertia) Name() string {
+	return t.name
+}
+
+func (t *_MsgInertia) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgInertia) NewMessage() ros.Message {
+	m := new(Inertia)
+	m.M = 0.0
+	m.Com = Vector3{}
+	m.Ixx = 0.0
+	m.Ixy = 0.0
+	m.Ixz = 0.0
+	m.Iyy = 0.0
+	m.Iyz = 0.0
+	m.Izz = 0.0
+	return m
+}
+
+var (
+	MsgInertia = &_MsgInertia{
+		`# Mass [kg]
+float64 m
+
+# Center of mass [m]
+geometry_msgs/Vector3 com
+
+# Inertia Tensor [kg-m^2]
+#     | ixx ixy ixz |
+# I = | ixy iyy iyz |
+#     | ixz iyz izz |
+float64 ixx
+float64 ixy
+float64 ixz
+float64 iyy
+float64 iyz
+float64 izz
+
+================================================================================
+MSG: geometry_msgs/Vector3
+# This represents a vector in free space. 
+# It is only meant to represent a direction. Therefore, it does not
+# make sense to apply a translation to it (e.g., when applying a 
+# generic rigid transformation to a Vector3, tf2 will only apply the
+# rotation). If you want your data to be translatable too, use the
+# geometry_msgs/Point message instead.
+
+float64 x
+float64 y
+float64 z
+`,
+		"geometry_msgs/Inertia",
+		"1d26e4bb6c83ff141c5cf0d883c2b0fe",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgInertia)
+}
+
+type Inertia struct {
+	M   float64 `rosmsg:"m:float64"`
+	Com Vector3 `rosmsg:"com:Vector3"`
+	Ixx float64 `rosmsg:"ixx:float64"`
+	Ixy float64 `rosmsg:"ixy:float64"`
+	Ixz float64 `rosmsg:"ixz:float64"`
+	Iyy float64 `rosmsg:"iyy:float64"`
+	Iyz float64 `rosmsg:"iyz:float64"`
+	Izz float64 `rosmsg:"izz:float64"`
+}
+
+func (m *Inertia) Type() ros.MessageType {
+	return MsgInertia
+}
+
+func (m *Inertia) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Inertia) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Inertia) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Inertia) Clone() *Inertia {
+	c := *m
+	c.Com = *m.Com.Clone()
+	return &c
+}
+
+func (m *Inertia) Equal(other *Inertia) bool {
+	if m.M != other.M {
+		return false
+	}
+	if !m.Com.Equal(&other.Com) {
+		return false
+	}
+	if m.Ixx != other.Ixx {
+		return false
+	}
+	if m.Ixy != other.Ixy {
+		return false
+	}
+	if m.Ixz != other.Ixz {
+		return false
+	}
+	if m.Iyy != other.Iyy {
+		return false
+	}
+	if m.Iyz != other.Iyz {
+		return false
+	}
+	if m.Izz != other.Izz {
+		return false
+	}
+	return true
+}
+
+func (m *Inertia) SerializedLength() int {
+	length := 0
+	length += 8
+	length += m.Com.SerializedLength()
+	length += 8
+	length += 8
+	length += 8
+	length += 8
+	length += 8
+	length += 8
+	return length
+}
+
+func (m *Inertia) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.M))
+	buf.Write(b[:8])
+	if err := m.Com.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.Ixx))
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.Ixy))
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.Ixz))
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.Iyy))
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.Iyz))
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.Izz))
+	buf.Write(b[:8])
+	return nil
+}
+
+func (m *Inertia) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.M = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	if err := m.Com.Deserialize(buf); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Ixx = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Ixy = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Ixz = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Iyy = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Iyz = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Izz = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	return nil
+}
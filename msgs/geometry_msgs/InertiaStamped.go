@@ -0,0 +1,161 @@
+// Automatically generated from the message definition "geometry_msgs/InertiaStamped.msg"
+package geometry_msgs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+type _MsgInertiaStamped struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgInertiaStamped) Text() string {
+	return t.text
+}
+
+func (t *_MsgInertiaStamped) Name() string {
+	return t.name
+}
+
+func (t *_MsgInertiaStamped) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgInertiaStamped) NewMessage() ros.Message {
+	m := new(InertiaStamped)
+	m.Header = std_msgs.Header{}
+	m.Inertia = Inertia{}
+	return m
+}
+
+var (
+	MsgInertiaStamped = &_MsgInertiaStamped{
+		`Header header
+Inertia inertia
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: geometry_msgs/Inertia
+# Mass [kg]
+float64 m
+
+# Center of mass [m]
+geometry_msgs/Vector3 com
+
+# Inertia Tensor [kg-m^2]
+#     | ixx ixy ixz |
+# I = | ixy iyy iyz |
+#     | ixz iyz izz |
+float64 ixx
+float64 ixy
+float64 ixz
+float64 iyy
+float64 iyz
+float64 izz
+
+================================================================================
+MSG: geometry_msgs/Vector3
+# This represents a vector in free space. 
+# It is only meant to represent a direction. Therefore, it does not
+# make sense to apply a translation to it (e.g., when applying a 
+# generic rigid transformation to a Vector3, tf2 will only apply the
+# rotation). If you want your data to be translatable too, use the
+# geometry_msgs/Point message instead.
+
+float64 x
+float64 y
+float64 z
+`,
+		"geometry_msgs/InertiaStamped",
+		"ddee48caeab5a966c5e8d166654a9ac7",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgInertiaStamped)
+}
+
+type InertiaStamped struct {
+	Header  std_msgs.Header `rosmsg:"header:Header"`
+	Inertia Inertia         `rosmsg:"inertia:Inertia"`
+}
+
+func (m *InertiaStamped) Type() ros.MessageType {
+	return MsgInertiaStamped
+}
+
+func (m *InertiaStamped) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *InertiaStamped) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *InertiaStamped) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *InertiaStamped) Clone() *InertiaStamped {
+	c := *m
+	c.Header = *m.Header.Clone()
+	c.Inertia = *m.Inertia.Clone()
+	return &c
+}
+
+func (m *InertiaStamped) Equal(other *InertiaStamped) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if !m.Inertia.Equal(&other.Inertia) {
+		return false
+	}
+	return true
+}
+
+func (m *InertiaStamped) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += m.Inertia.SerializedLength()
+	return length
+}
+
+func (m *InertiaStamped) Serialize(buf *bytes.Buffer) error {
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	if err := m.Inertia.Serialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *InertiaStamped) Deserialize(buf *bytes.Reader) error {
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	if err := m.Inertia.Deserialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
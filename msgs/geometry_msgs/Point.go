@@ -0,0 +1,129 @@
+// Automatically generated from the message definition "geometry_msgs/Point.msg"
+package geometry_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgPoint struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgPoint) Text() string {
+	return t.text
+}
+
+func (t *_MsgPoint) Name() string {
+	return t.name
+}
+
+func (t *_MsgPoint) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgPoint) NewMessage() ros.Message {
+	m := new(Point)
+	m.X = 0.0
+	m.Y = 0.0
+	m.Z = 0.0
+	return m
+}
+
+var (
+	MsgPoint = &_MsgPoint{
+		`# This contains the position of a point in free space
+float64 x
+float64 y
+float64 z
+`,
+		"geometry_msgs/Point",
+		"4a842b65f413084dc2b10fb484ea7f17",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgPoint)
+}
+
+type Point struct {
+	X float64 `rosmsg:"x:float64"`
+	Y float64 `rosmsg:"y:float64"`
+	Z float64 `rosmsg:"z:float64"`
+}
+
+func (m *Point) Type() ros.MessageType {
+	return MsgPoint
+}
+
+func (m *Point) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Point) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Point) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Point) Clone() *Point {
+	c := *m
+	return &c
+}
+
+func (m *Point) Equal(other *Point) bool {
+	if m.X != other.X {
+		return false
+	}
+	if m.Y != other.Y {
+		return false
+	}
+	if m.Z != other.Z {
+		return false
+	}
+	return true
+}
+
+func (m *Point) SerializedLength() int {
+	length := 0
+	length += 8
+	length += 8
+	length += 8
+	return length
+}
+
+func (m *Point) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.X))
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.Y))
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.Z))
+	buf.Write(b[:8])
+	return nil
+}
+
+func (m *Point) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.X = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Y = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Z = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	return nil
+}
@@ -0,0 +1,136 @@
+// Automatically generated from the message definition "geometry_msgs/Point32.msg"
+package geometry_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgPoint32 struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgPoint32) Text() string {
+	return t.text
+}
+
+func (t *_MsgPoint32) Name() string {
+	return t.name
+}
+
+func (t *_MsgPoint32) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgPoint32) NewMessage() ros.Message {
+	m := new(Point32)
+	m.X = 0.0
+	m.Y = 0.0
+	m.Z = 0.0
+	return m
+}
+
+var (
+	MsgPoint32 = &_MsgPoint32{
+		`# This contains the position of a point in free space(with 32 bits of precision).
+# It is recommeded to use Point wherever possible instead of Point32.
+#
+# This recommendation is to promote interoperability.
+#
+# This message is designed to take up less space when sending
+# lots of points at once, as in the case of a PointCloud.
+
+float32 x
+float32 y
+float32 z
+`,
+		"geometry_msgs/Point32",
+		"cc153912f1453b708d221682bc23d9ac",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgPoint32)
+}
+
+type Point32 struct {
+	X float32 `rosmsg:"x:float32"`
+	Y float32 `rosmsg:"y:float32"`
+	Z float32 `rosmsg:"z:float32"`
+}
+
+func (m *Point32) Type() ros.MessageType {
+	return MsgPoint32
+}
+
+func (m *Point32) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Point32) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Point32) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Point32) Clone() *Point32 {
+	c := *m
+	return &c
+}
+
+func (m *Point32) Equal(other *Point32) bool {
+	if m.X != other.X {
+		return false
+	}
+	if m.Y != other.Y {
+		return false
+	}
+	if m.Z != other.Z {
+		return false
+	}
+	return true
+}
+
+func (m *Point32) SerializedLength() int {
+	length := 0
+	length += 4
+	length += 4
+	length += 4
+	return length
+}
+
+func (m *Point32) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.X))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.Y))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.Z))
+	buf.Write(b[:4])
+	return nil
+}
+
+func (m *Point32) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.X = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Y = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Z = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	return nil
+}
@@ -0,0 +1,137 @@
+// Automatically generated from the message definition "geometry_msgs/PointStamped.msg"
+package geometry_msgs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+type _MsgPointStamped struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgPointStamped) Text() string {
+	return t.text
+}
+
+func (t *_MsgPointStamped) Name() string {
+	return t.name
+}
+
+func (t *_MsgPointStamped) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgPointStamped) NewMessage() ros.Message {
+	m := new(PointStamped)
+	m.Header = std_msgs.Header{}
+	m.Point = Point{}
+	return m
+}
+
+var (
+	MsgPointStamped = &_MsgPointStamped{
+		`# This represents a Point with reference coordinate frame and timestamp
+Header header
+Point point
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: geometry_msgs/Point
+# This contains the position of a point in free space
+float64 x
+float64 y
+float64 z
+`,
+		"geometry_msgs/PointStamped",
+		"c63aecb41bfdfd6b7e1fac37c7cbe7bf",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgPointStamped)
+}
+
+type PointStamped struct {
+	Header std_msgs.Header `rosmsg:"header:Header"`
+	Point  Point           `rosmsg:"point:Point"`
+}
+
+func (m *PointStamped) Type() ros.MessageType {
+	return MsgPointStamped
+}
+
+func (m *PointStamped) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *PointStamped) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *PointStamped) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *PointStamped) Clone() *PointStamped {
+	c := *m
+	c.Header = *m.Header.Clone()
+	c.Point = *m.Point.Clone()
+	return &c
+}
+
+func (m *PointStamped) Equal(other *PointStamped) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if !m.Point.Equal(&other.Point) {
+		return false
+	}
+	return true
+}
+
+func (m *PointStamped) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += m.Point.SerializedLength()
+	return length
+}
+
+func (m *PointStamped) Serialize(buf *bytes.Buffer) error {
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	if err := m.Point.Serialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *PointStamped) Deserialize(buf *bytes.Reader) error {
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	if err := m.Point.Deserialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+// Automatically generated from the message definition "geometry_msgs/Polygon.msg"
+package geometry_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgPolygon struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgPolygon) Text() string {
+	return t.text
+}
+
+func (t *_MsgPolygon) Name() string {
+	return t.name
+}
+
+func (t *_MsgPolygon) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgPolygon) NewMessage() ros.Message {
+	m := new(Polygon)
+	m.Points = []Point32{}
+	return m
+}
+
+var (
+	MsgPolygon = &_MsgPolygon{
+		`#A specification of a polygon where the first and last points are assumed to be connected
+Point32[] points
+
+================================================================================
+MSG: geometry_msgs/Point32
+# This contains the position of a point in free space(with 32 bits of precision).
+# It is recommeded to use Point wherever possible instead of Point32.
+#
+# This recommendation is to promote interoperability.
+#
+# This message is designed to take up less space when sending
+# lots of points at once, as in the case of a PointCloud.
+
+float32 x
+float32 y
+float32 z
+`,
+		"geometry_msgs/Polygon",
+		"cd60a26494a087f577976f0329fa120e",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgPolygon)
+}
+
+type Polygon struct {
+	Points []Point32 `rosmsg:"points:Point32[]"`
+}
+
+func (m *Polygon) Type() ros.MessageType {
+	return MsgPolygon
+}
+
+func (m *Polygon) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Polygon) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Polygon) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Polygon) Clone() *Polygon {
+	c := *m
+	if m.Points != nil {
+		c.Points = make([]Point32, len(m.Points))
+		for i := range m.Points {
+			c.Points[i] = *m.Points[i].Clone()
+		}
+	}
+	return &c
+}
+
+func (m *Polygon) Equal(other *Polygon) bool {
+	if len(m.Points) != len(other.Points) {
+		return false
+	}
+	for i := range m.Points {
+		if !m.Points[i].Equal(&other.Points[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Polygon) SerializedLength() int {
+	length := 0
+	length += 4
+	for i := range m.Points {
+		length += m.Points[i].SerializedLength()
+	}
+	return length
+}
+
+func (m *Polygon) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Points)))
+	buf.Write(b[:4])
+	for i := range m.Points {
+		if err := m.Points[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Polygon) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*12 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Points = make([]Point32, size)
+		for i := range m.Points {
+			if err := m.Points[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+// Automatically generated from the message definition "geometry_msgs/PolygonStamped.msg"
+package geometry_msgs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+type _MsgPolygonStamped struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgPolygonStamped) Text() string {
+	return t.text
+}
+
+func (t *_MsgPolygonStamped) Name() string {
+	return t.name
+}
+
+func (t *_MsgPolygonStamped) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgPolygonStamped) NewMessage() ros.Message {
+	m := new(PolygonStamped)
+	m.Header = std_msgs.Header{}
+	m.Polygon = Polygon{}
+	return m
+}
+
+var (
+	MsgPolygonStamped = &_MsgPolygonStamped{
+		`# This represents a Polygon with reference coordinate frame and timestamp
+Header header
+Polygon polygon
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: geometry_msgs/Polygon
+#A specification of a polygon where the first and last points are assumed to be connected
+Point32[] points
+
+================================================================================
+MSG: geometry_msgs/Point32
+# This contains the position of a point in free space(with 32 bits of precision).
+# It is recommeded to use Point wherever possible instead of Point32.
+#
+# This recommendation is to promote interoperability.
+#
+# This message is designed to take up less space when sending
+# lots of points at once, as in the case of a PointCloud.
+
+float32 x
+float32 y
+float32 z
+`,
+		"geometry_msgs/PolygonStamped",
+		"c6be8f7dc3bee7fe9e8d296070f53340",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgPolygonStamped)
+}
+
+type PolygonStamped struct {
+	Header  std_msgs.Header `rosmsg:"header:Header"`
+	Polygon Polygon         `rosmsg:"polygon:Polygon"`
+}
+
+func (m *PolygonStamped) Type() ros.MessageType {
+	return MsgPolygonStamped
+}
+
+func (m *PolygonStamped) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *PolygonStamped) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *PolygonStamped) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *PolygonStamped) Clone() *PolygonStamped {
+	c := *m
+	c.Header = *m.Header.Clone()
+	c.Polygon = *m.Polygon.Clone()
+	return &c
+}
+
+func (m *PolygonStamped) Equal(other *PolygonStamped) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if !m.Polygon.Equal(&other.Polygon) {
+		return false
+	}
+	return true
+}
+
+func (m *PolygonStamped) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += m.Polygon.SerializedLength()
+	return length
+}
+
+func (m *PolygonStamped) Serialize(buf *bytes.Buffer) error {
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	if err := m.Polygon.Serialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *PolygonStamped) Deserialize(buf *bytes.Reader) error {
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	if err := m.Polygon.Deserialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
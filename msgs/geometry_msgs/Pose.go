@@ -0,0 +1,129 @@
+// Automatically generated from the message definition "geometry_msgs/Pose.msg"
+package geometry_msgs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/ros"
+)
+
+type _MsgPose struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgPose) Text() string {
+	return t.text
+}
+
+func (t *_MsgPose) Name() string {
+	return t.name
+}
+
+func (t *_MsgPose) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgPose) NewMessage() ros.Message {
+	m := new(Pose)
+	m.Position = Point{}
+	m.Orientation = Quaternion{}
+	return m
+}
+
+var (
+	MsgPose = &_MsgPose{
+		`# A representation of pose in free space, composed of position and orientation. 
+Point position
+Quaternion orientation
+
+================================================================================
+MSG: geometry_msgs/Point
+# This contains the position of a point in free space
+float64 x
+float64 y
+float64 z
+
+================================================================================
+MSG: geometry_msgs/Quaternion
+# This represents an orientation in free space in quaternion form.
+
+float64 x
+float64 y
+float64 z
+float64 w
+`,
+		"geometry_msgs/Pose",
+		"e45d45a5a1ce597b249e23fb30fc871f",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgPose)
+}
+
+type Pose struct {
+	Position    Point      `rosmsg:"position:Point"`
+	Orientation Quaternion `rosmsg:"orientation:Quaternion"`
+}
+
+func (m *Pose) Type() ros.MessageType {
+	return MsgPose
+}
+
+func (m *Pose) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Pose) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Pose) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Pose) Clone() *Pose {
+	c := *m
+	c.Position = *m.Position.Clone()
+	c.Orientation = *m.Orientation.Clone()
+	return &c
+}
+
+func (m *Pose) Equal(other *Pose) bool {
+	if !m.Position.Equal(&other.Position) {
+		return false
+	}
+	if !m.Orientation.Equal(&other.Orientation) {
+		return false
+	}
+	return true
+}
+
+func (m *Pose) SerializedLength() int {
+	length := 0
+	length += m.Position.SerializedLength()
+	length += m.Orientation.SerializedLength()
+	return length
+}
+
+func (m *Pose) Serialize(buf *bytes.Buffer) error {
+	if err := m.Position.Serialize(buf); err != nil {
+		return err
+	}
+	if err := m.Orientation.Serialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Pose) Deserialize(buf *bytes.Reader) error {
+	if err := m.Position.Deserialize(buf); err != nil {
+		return err
+	}
+	if err := m.Orientation.Deserialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
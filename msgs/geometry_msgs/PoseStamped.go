@@ -0,0 +1,152 @@
+// Automatically generated from the message definition "geometry_msgs/PoseStamped.msg"
+package geometry_msgs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+type _MsgPoseStamped struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgPoseStamped) Text() string {
+	return t.text
+}
+
+func (t *_MsgPoseStamped) Name() string {
+	return t.name
+}
+
+func (t *_MsgPoseStamped) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgPoseStamped) NewMessage() ros.Message {
+	m := new(PoseStamped)
+	m.Header = std_msgs.Header{}
+	m.Pose = Pose{}
+	return m
+}
+
+var (
+	MsgPoseStamped = &_MsgPoseStamped{
+		`# A Pose with reference coordinate frame and timestamp
+Header header
+Pose pose
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: geometry_msgs/Pose
+# A representation of pose in free space, composed of position and orientation. 
+Point position
+Quaternion orientation
+
+================================================================================
+MSG: geometry_msgs/Point
+# This contains the position of a point in free space
+float64 x
+float64 y
+float64 z
+
+================================================================================
+MSG: geometry_msgs/Quaternion
+# This represents an orientation in free space in quaternion form.
+
+float64 x
+float64 y
+float64 z
+float64 w
+`,
+		"geometry_msgs/PoseStamped",
+		"d3812c3cbc69362b77dc0b19b345f8f5",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgPoseStamped)
+}
+
+type PoseStamped struct {
+	Header std_msgs.Header `rosmsg:"header:Header"`
+	Pose   Pose            `rosmsg:"pose:Pose"`
+}
+
+func (m *PoseStamped) Type() ros.MessageType {
+	return MsgPoseStamped
+}
+
+func (m *PoseStamped) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *PoseStamped) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *PoseStamped) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *PoseStamped) Clone() *PoseStamped {
+	c := *m
+	c.Header = *m.Header.Clone()
+	c.Pose = *m.Pose.Clone()
+	return &c
+}
+
+func (m *PoseStamped) Equal(other *PoseStamped) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if !m.Pose.Equal(&other.Pose) {
+		return false
+	}
+	return true
+}
+
+func (m *PoseStamped) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += m.Pose.SerializedLength()
+	return length
+}
+
+func (m *PoseStamped) Serialize(buf *bytes.Buffer) error {
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	if err := m.Pose.Serialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *PoseStamped) Deserialize(buf *bytes.Reader) error {
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	if err := m.Pose.Deserialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
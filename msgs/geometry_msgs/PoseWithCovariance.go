@@ -0,0 +1,159 @@
+// Automatically generated from the message definition "geometry_msgs/PoseWithCovariance.msg"
+package geometry_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgPoseWithCovariance struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgPoseWithCovariance) Text() string {
+	return t.text
+}
+
+func (t *_MsgPoseWithCovariance) Name() string {
+	return t.name
+}
+
+func (t *_MsgPoseWithCovariance) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgPoseWithCovariance) NewMessage() ros.Message {
+	m := new(PoseWithCovariance)
+	m.Pose = Pose{}
+	for i := 0; i < 36; i++ {
+		m.Covariance[i] = 0.0
+	}
+	return m
+}
+
+var (
+	MsgPoseWithCovariance = &_MsgPoseWithCovariance{
+		`# This represents a pose in free space with uncertainty.
+
+Pose pose
+
+# Row-major representation of the 6x6 covariance matrix
+# The orientation parameters use a fixed-axis representation.
+# In order, the parameters are:
+# (x, y, z, rotation about X axis, rotation about Y axis, rotation about Z axis)
+float64[36] covariance
+
+================================================================================
+MSG: geometry_msgs/Pose
+# A representation of pose in free space, composed of position and orientation. 
+Point position
+Quaternion orientation
+
+================================================================================
+MSG: geometry_msgs/Point
+# This contains the position of a point in free space
+float64 x
+float64 y
+float64 z
+
+================================================================================
+MSG: geometry_msgs/Quaternion
+# This represents an orientation in free space in quaternion form.
+
+float64 x
+float64 y
+float64 z
+float64 w
+`,
+		"geometry_msgs/PoseWithCovariance",
+		"c23e848cf1b7533a8d7c259073a97e6f",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgPoseWithCovariance)
+}
+
+type PoseWithCovariance struct {
+	Pose       Pose        `rosmsg:"pose:Pose"`
+	Covariance [36]float64 `rosmsg:"covariance:float64[36]"`
+}
+
+func (m *PoseWithCovariance) Type() ros.MessageType {
+	return MsgPoseWithCovariance
+}
+
+func (m *PoseWithCovariance) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *PoseWithCovariance) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *PoseWithCovariance) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *PoseWithCovariance) Clone() *PoseWithCovariance {
+	c := *m
+	c.Pose = *m.Pose.Clone()
+	return &c
+}
+
+func (m *PoseWithCovariance) Equal(other *PoseWithCovariance) bool {
+	if !m.Pose.Equal(&other.Pose) {
+		return false
+	}
+	if m.Covariance != other.Covariance {
+		return false
+	}
+	return true
+}
+
+func (m *PoseWithCovariance) SerializedLength() int {
+	length := 0
+	length += m.Pose.SerializedLength()
+	length += 8 * len(m.Covariance)
+	return length
+}
+
+func (m *PoseWithCovariance) Serialize(buf *bytes.Buffer) error {
+	if err := m.Pose.Serialize(buf); err != nil {
+		return err
+	}
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 8 * len(m.Covariance)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.Covariance {
+			binary.LittleEndian.PutUint64(data[8*i:], math.Float64bits(e))
+		}
+		buf.Write(data)
+	}
+	return nil
+}
+
+func (m *PoseWithCovariance) Deserialize(buf *bytes.Reader) error {
+	if err := m.Pose.Deserialize(buf); err != nil {
+		return err
+	}
+	{
+		data := make([]byte, 8*len(m.Covariance))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.Covariance {
+			m.Covariance[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[8*i:]))
+		}
+	}
+	return nil
+}
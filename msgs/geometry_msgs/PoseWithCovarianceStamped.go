@@ -0,0 +1,165 @@
+// Automatically generated from the message definition "geometry_msgs/PoseWithCovarianceStamped.msg"
+package geometry_msgs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+type _MsgPoseWithCovarianceStamped struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgPoseWithCovarianceStamped) Text() string {
+	return t.text
+}
+
+func (t *_MsgPoseWithCovarianceStamped) Name() string {
+	return t.name
+}
+
+func (t *_MsgPoseWithCovarianceStamped) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgPoseWithCovarianceStamped) NewMessage() ros.Message {
+	m := new(PoseWithCovarianceStamped)
+	m.Header = std_msgs.Header{}
+	m.Pose = PoseWithCovariance{}
+	return m
+}
+
+var (
+	MsgPoseWithCovarianceStamped = &_MsgPoseWithCovarianceStamped{
+		`# This expresses an estimated pose with a reference coordinate frame and timestamp
+
+Header header
+PoseWithCovariance pose
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: geometry_msgs/PoseWithCovariance
+# This represents a pose in free space with uncertainty.
+
+Pose pose
+
+# Row-major representation of the 6x6 covariance matrix
+# The orientation parameters use a fixed-axis representation.
+# In order, the parameters are:
+# (x, y, z, rotation about X axis, rotation about Y axis, rotation about Z axis)
+float64[36] covariance
+
+================================================================================
+MSG: geometry_msgs/Pose
+# A representation of pose in free space, composed of position and orientation. 
+Point position
+Quaternion orientation
+
+================================================================================
+MSG: geometry_msgs/Point
+# This contains the position of a point in free space
+float64 x
+float64 y
+float64 z
+
+================================================================================
+MSG: geometry_msgs/Quaternion
+# This represents an orientation in free space in quaternion form.
+
+float64 x
+float64 y
+float64 z
+float64 w
+`,
+		"geometry_msgs/PoseWithCovarianceStamped",
+		"953b798c0f514ff060a53a3498ce6246",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgPoseWithCovarianceStamped)
+}
+
+type PoseWithCovarianceStamped struct {
+	Header std_msgs.Header    `rosmsg:"header:Header"`
+	Pose   PoseWithCovariance `rosmsg:"pose:PoseWithCovariance"`
+}
+
+func (m *PoseWithCovarianceStamped) Type() ros.MessageType {
+	return MsgPoseWithCovarianceStamped
+}
+
+func (m *PoseWithCovarianceStamped) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *PoseWithCovarianceStamped) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *PoseWithCovarianceStamped) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *PoseWithCovarianceStamped) Clone() *PoseWithCovarianceStamped {
+	c := *m
+	c.Header = *m.Header.Clone()
+	c.Pose = *m.Pose.Clone()
+	return &c
+}
+
+func (m *PoseWithCovarianceStamped) Equal(other *PoseWithCovarianceStamped) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if !m.Pose.Equal(&other.Pose) {
+		return false
+	}
+	return true
+}
+
+func (m *PoseWithCovarianceStamped) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += m.Pose.SerializedLength()
+	return length
+}
+
+func (m *PoseWithCovarianceStamped) Serialize(buf *bytes.Buffer) error {
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	if err := m.Pose.Serialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *PoseWithCovarianceStamped) Deserialize(buf *bytes.Reader) error {
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	if err := m.Pose.Deserialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
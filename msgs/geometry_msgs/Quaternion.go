@@ -0,0 +1,143 @@
+// Automatically generated from the message definition "geometry_msgs/Quaternion.msg"
+package geometry_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgQuaternion struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgQuaternion) Text() string {
+	return t.text
+}
+
+func (t *_MsgQuaternion) Name() string {
+	return t.name
+}
+
+func (t *_MsgQuaternion) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgQuaternion) NewMessage() ros.Message {
+	m := new(Quaternion)
+	m.X = 0.0
+	m.Y = 0.0
+	m.Z = 0.0
+	m.W = 0.0
+	return m
+}
+
+var (
+	MsgQuaternion = &_MsgQuaternion{
+		`# This represents an orientation in free space in quaternion form.
+
+float64 x
+float64 y
+float64 z
+float64 w
+`,
+		"geometry_msgs/Quaternion",
+		"a779879fadf0160734f906b8c19c7004",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgQuaternion)
+}
+
+type Quaternion struct {
+	X float64 `rosmsg:"x:float64"`
+	Y float64 `rosmsg:"y:float64"`
+	Z float64 `rosmsg:"z:float64"`
+	W float64 `rosmsg:"w:float64"`
+}
+
+func (m *Quaternion) Type() ros.MessageType {
+	return MsgQuaternion
+}
+
+func (m *Quaternion) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Quaternion) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Quaternion) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Quaternion) Clone() *Quaternion {
+	c := *m
+	return &c
+}
+
+func (m *Quaternion) Equal(other *Quaternion) bool {
+	if m.X != other.X {
+		return false
+	}
+	if m.Y != other.Y {
+		return false
+	}
+	if m.Z != other.Z {
+		return false
+	}
+	if m.W != other.W {
+		return false
+	}
+	return true
+}
+
+func (m *Quaternion) SerializedLength() int {
+	length := 0
+	length += 8
+	length += 8
+	length += 8
+	length += 8
+	return length
+}
+
+func (m *Quaternion) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.X))
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.Y))
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.Z))
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.W))
+	buf.Write(b[:8])
+	return nil
+}
+
+func (m *Quaternion) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.X = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Y = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Z = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.W = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	return nil
+}
@@ -0,0 +1,140 @@
+// Automatically generated from the message definition "geometry_msgs/QuaternionStamped.msg"
+package geometry_msgs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+type _MsgQuaternionStamped struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgQuaternionStamped) Text() string {
+	return t.text
+}
+
+func (t *_MsgQuaternionStamped) Name() string {
+	return t.name
+}
+
+func (t *_MsgQuaternionStamped) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgQuaternionStamped) NewMessage() ros.Message {
+	m := new(QuaternionStamped)
+	m.Header = std_msgs.Header{}
+	m.Quaternion = Quaternion{}
+	return m
+}
+
+var (
+	MsgQuaternionStamped = &_MsgQuaternionStamped{
+		`# This represents an orientation with reference coordinate frame and timestamp.
+
+Header header
+Quaternion quaternion
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: geometry_msgs/Quaternion
+# This represents an orientation in free space in quaternion form.
+
+float64 x
+float64 y
+float64 z
+float64 w
+`,
+		"geometry_msgs/QuaternionStamped",
+		"e57f1e547e0e1fd13504588ffc8334e2",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgQuaternionStamped)
+}
+
+type QuaternionStamped struct {
+	Header     std_msgs.Header `rosmsg:"header:Header"`
+	Quaternion Quaternion      `rosmsg:"quaternion:Quaternion"`
+}
+
+func (m *QuaternionStamped) Type() ros.MessageType {
+	return MsgQuaternionStamped
+}
+
+func (m *QuaternionStamped) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *QuaternionStamped) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *QuaternionStamped) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *QuaternionStamped) Clone() *QuaternionStamped {
+	c := *m
+	c.Header = *m.Header.Clone()
+	c.Quaternion = *m.Quaternion.Clone()
+	return &c
+}
+
+func (m *QuaternionStamped) Equal(other *QuaternionStamped) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if !m.Quaternion.Equal(&other.Quaternion) {
+		return false
+	}
+	return true
+}
+
+func (m *QuaternionStamped) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += m.Quaternion.SerializedLength()
+	return length
+}
+
+func (m *QuaternionStamped) Serialize(buf *bytes.Buffer) error {
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	if err := m.Quaternion.Serialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *QuaternionStamped) Deserialize(buf *bytes.Reader) error {
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	if err := m.Quaternion.Deserialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
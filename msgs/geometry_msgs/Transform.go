@@ -0,0 +1,136 @@
+// Automatically generated from the message definition "geometry_msgs/Transform.msg"
+package geometry_msgs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/ros"
+)
+
+type _MsgTransform struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgTransform) Text() string {
+	return t.text
+}
+
+func (t *_MsgTransform) Name() string {
+	return t.name
+}
+
+func (t *_MsgTransform) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgTransform) NewMessage() ros.Message {
+	m := new(Transform)
+	m.Translation = Vector3{}
+	m.Rotation = Quaternion{}
+	return m
+}
+
+var (
+	MsgTransform = &_MsgTransform{
+		`# This represents the transform between two coordinate frames in free space.
+
+Vector3 translation
+Quaternion rotation
+
+================================================================================
+MSG: geometry_msgs/Vector3
+# This represents a vector in free space. 
+# It is only meant to represent a direction. Therefore, it does not
+# make sense to apply a translation to it (e.g., when applying a 
+# generic rigid transformation to a Vector3, tf2 will only apply the
+# rotation). If you want your data to be translatable too, use the
+# geometry_msgs/Point message instead.
+
+float64 x
+float64 y
+float64 z
+
+================================================================================
+MSG: geometry_msgs/Quaternion
+# This represents an orientation in free space in quaternion form.
+
+float64 x
+float64 y
+float64 z
+float64 w
+`,
+		"geometry_msgs/Transform",
+		"ac9eff44abf714214112b05d54a3cf9b",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgTransform)
+}
+
+type Transform struct {
+	Translation Vector3    `rosmsg:"translation:Vector3"`
+	Rotation    Quaternion `rosmsg:"rotation:Quaternion"`
+}
+
+func (m *Transform) Type() ros.MessageType {
+	return MsgTransform
+}
+
+func (m *Transform) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Transform) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Transform) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Transform) Clone() *Transform {
+	c := *m
+	c.Translation = *m.Translation.Clone()
+	c.Rotation = *m.Rotation.Clone()
+	return &c
+}
+
+func (m *Transform) Equal(other *Transform) bool {
+	if !m.Translation.Equal(&other.Translation) {
+		return false
+	}
+	if !m.Rotation.Equal(&other.Rotation) {
+		return false
+	}
+	return true
+}
+
+func (m *Transform) SerializedLength() int {
+	length := 0
+	length += m.Translation.SerializedLength()
+	length += m.Rotation.SerializedLength()
+	return length
+}
+
+func (m *Transform) Serialize(buf *bytes.Buffer) error {
+	if err := m.Translation.Serialize(buf); err != nil {
+		return err
+	}
+	if err := m.Rotation.Serialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Transform) Deserialize(buf *bytes.Reader) error {
+	if err := m.Translation.Deserialize(buf); err != nil {
+		return err
+	}
+	if err := m.Rotation.Deserialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
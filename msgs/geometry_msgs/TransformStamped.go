@@ -0,0 +1,193 @@
+// Automatically generated from the message definition "geometry_msgs/TransformStamped.msg"
+package geometry_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgTransformStamped struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgTransformStamped) Text() string {
+	return t.text
+}
+
+func (t *_MsgTransformStamped) Name() string {
+	return t.name
+}
+
+func (t *_MsgTransformStamped) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgTransformStamped) NewMessage() ros.Message {
+	m := new(TransformStamped)
+	m.Header = std_msgs.Header{}
+	m.ChildFrameId = ""
+	m.Transform = Transform{}
+	return m
+}
+
+var (
+	MsgTransformStamped = &_MsgTransformStamped{
+		`# This expresses a transform from coordinate frame header.frame_id
+# to the coordinate frame child_frame_id
+#
+# This message is mostly used by the 
+# <a href="http://wiki.ros.org/tf">tf</a> package. 
+# See its documentation for more information.
+
+Header header
+string child_frame_id # the frame id of the child frame
+Transform transform
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: geometry_msgs/Transform
+# This represents the transform between two coordinate frames in free space.
+
+Vector3 translation
+Quaternion rotation
+
+================================================================================
+MSG: geometry_msgs/Vector3
+# This represents a vector in free space. 
+# It is only meant to represent a direction. Therefore, it does not
+# make sense to apply a translation to it (e.g., when applying a 
+# generic rigid transformation to a Vector3, tf2 will only apply the
+# rotation). If you want your data to be translatable too, use the
+# geometry_msgs/Point message instead.
+
+float64 x
+float64 y
+float64 z
+
+================================================================================
+MSG: geometry_msgs/Quaternion
+# This represents an orientation in free space in quaternion form.
+
+float64 x
+float64 y
+float64 z
+float64 w
+`,
+		"geometry_msgs/TransformStamped",
+		"b5764a33bfeb3588febc2682852579b0",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgTransformStamped)
+}
+
+type TransformStamped struct {
+	Header       std_msgs.Header `rosmsg:"header:Header"`
+	ChildFrameId string          `rosmsg:"child_frame_id:string"`
+	Transform    Transform       `rosmsg:"transform:Transform"`
+}
+
+func (m *TransformStamped) Type() ros.MessageType {
+	return MsgTransformStamped
+}
+
+func (m *TransformStamped) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *TransformStamped) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *TransformStamped) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *TransformStamped) Clone() *TransformStamped {
+	c := *m
+	c.Header = *m.Header.Clone()
+	c.Transform = *m.Transform.Clone()
+	return &c
+}
+
+func (m *TransformStamped) Equal(other *TransformStamped) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if m.ChildFrameId != other.ChildFrameId {
+		return false
+	}
+	if !m.Transform.Equal(&other.Transform) {
+		return false
+	}
+	return true
+}
+
+func (m *TransformStamped) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += 4 + len(m.ChildFrameId)
+	length += m.Transform.SerializedLength()
+	return length
+}
+
+func (m *TransformStamped) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.ChildFrameId)))
+	buf.Write(b[:4])
+	buf.WriteString(m.ChildFrameId)
+	if err := m.Transform.Serialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *TransformStamped) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.ChildFrameId = string(data)
+	}
+	if err := m.Transform.Deserialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+// Automatically generated from the message definition "geometry_msgs/Twist.msg"
+package geometry_msgs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/ros"
+)
+
+type _MsgTwist struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgTwist) Text() string {
+	return t.text
+}
+
+func (t *_MsgTwist) Name() string {
+	return t.name
+}
+
+func (t *_MsgTwist) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgTwist) NewMessage() ros.Message {
+	m := new(Twist)
+	m.Linear = Vector3{}
+	m.Angular = Vector3{}
+	return m
+}
+
+var (
+	MsgTwist = &_MsgTwist{
+		`# This expresses velocity in free space broken into its linear and angular parts.
+Vector3  linear
+Vector3  angular
+
+================================================================================
+MSG: geometry_msgs/Vector3
+# This represents a vector in free space. 
+# It is only meant to represent a direction. Therefore, it does not
+# make sense to apply a translation to it (e.g., when applying a 
+# generic rigid transformation to a Vector3, tf2 will only apply the
+# rotation). If you want your data to be translatable too, use the
+# geometry_msgs/Point message instead.
+
+float64 x
+float64 y
+float64 z
+`,
+		"geometry_msgs/Twist",
+		"9f195f881246fdfa2798d1d3eebca84a",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgTwist)
+}
+
+type Twist struct {
+	Linear  Vector3 `rosmsg:"linear:Vector3"`
+	Angular Vector3 `rosmsg:"angular:Vector3"`
+}
+
+func (m *Twist) Type() ros.MessageType {
+	return MsgTwist
+}
+
+func (m *Twist) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Twist) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Twist) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Twist) Clone() *Twist {
+	c := *m
+	c.Linear = *m.Linear.Clone()
+	c.Angular = *m.Angular.Clone()
+	return &c
+}
+
+func (m *Twist) Equal(other *Twist) bool {
+	if !m.Linear.Equal(&other.Linear) {
+		return false
+	}
+	if !m.Angular.Equal(&other.Angular) {
+		return false
+	}
+	return true
+}
+
+func (m *Twist) SerializedLength() int {
+	length := 0
+	length += m.Linear.SerializedLength()
+	length += m.Angular.SerializedLength()
+	return length
+}
+
+func (m *Twist) Serialize(buf *bytes.Buffer) error {
+	if err := m.Linear.Serialize(buf); err != nil {
+		return err
+	}
+	if err := m.Angular.Serialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Twist) Deserialize(buf *bytes.Reader) error {
+	if err := m.Linear.Deserialize(buf); err != nil {
+		return err
+	}
+	if err := m.Angular.Deserialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
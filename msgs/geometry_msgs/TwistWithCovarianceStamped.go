@@ -0,0 +1,161 @@
+// Automatically generated from the message definition "geometry_msgs/TwistWithCovarianceStamped.msg"
+package geometry_msgs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+type _MsgTwistWithCovarianceStamped struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgTwistWithCovarianceStamped) Text() string {
+	return t.text
+}
+
+func (t *_MsgTwistWithCovarianceStamped) Name() string {
+	return t.name
+}
+
+func (t *_MsgTwistWithCovarianceStamped) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgTwistWithCovarianceStamped) NewMessage() ros.Message {
+	m := new(TwistWithCovarianceStamped)
+	m.Header = std_msgs.Header{}
+	m.Twist = TwistWithCovariance{}
+	return m
+}
+
+var (
+	MsgTwistWithCovarianceStamped = &_MsgTwistWithCovarianceStamped{
+		`# This represents an estimated twist with reference coordinate frame and timestamp.
+Header header
+TwistWithCovariance twist
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: geometry_msgs/TwistWithCovariance
+# This expresses velocity in free space with uncertainty.
+
+Twist twist
+
+# Row-major representation of the 6x6 covariance matrix
+# The orientation parameters use a fixed-axis representation.
+# In order, the parameters are:
+# (x, y, z, rotation about X axis, rotation about Y axis, rotation about Z axis)
+float64[36] covariance
+
+================================================================================
+MSG: geometry_msgs/Twist
+# This expresses velocity in free space broken into its linear and angular parts.
+Vector3  linear
+Vector3  angular
+
+================================================================================
+MSG: geometry_msgs/Vector3
+# This represents a vector in free space. 
+# It is only meant to represent a direction. Therefore, it does not
+# make sense to apply a translation to it (e.g., when applying a 
+# generic rigid transformation to a Vector3, tf2 will only apply the
+# rotation). If you want your data to be translatable too, use the
+# geometry_msgs/Point message instead.
+
+float64 x
+float64 y
+float64 z
+`,
+		"geometry_msgs/TwistWithCovarianceStamped",
+		"8927a1a12fb2607ceea095b2dc440a96",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgTwistWithCovarianceStamped)
+}
+
+type TwistWithCovarianceStamped struct {
+	Header std_msgs.Header     `rosmsg:"header:Header"`
+	Twist  TwistWithCovariance `rosmsg:"twist:TwistWithCovariance"`
+}
+
+func (m *TwistWithCovarianceStamped) Type() ros.MessageType {
+	return MsgTwistWithCovarianceStamped
+}
+
+func (m *TwistWithCovarianceStamped) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *TwistWithCovarianceStamped) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *TwistWithCovarianceStamped) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *TwistWithCovarianceStamped) Clone() *TwistWithCovarianceStamped {
+	c := *m
+	c.Header = *m.Header.Clone()
+	c.Twist = *m.Twist.Clone()
+	return &c
+}
+
+func (m *TwistWithCovarianceStamped) Equal(other *TwistWithCovarianceStamped) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if !m.Twist.Equal(&other.Twist) {
+		return false
+	}
+	return true
+}
+
+func (m *TwistWithCovarianceStamped) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += m.Twist.SerializedLength()
+	return length
+}
+
+func (m *TwistWithCovarianceStamped) Serialize(buf *bytes.Buffer) error {
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	if err := m.Twist.Serialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *TwistWithCovarianceStamped) Deserialize(buf *bytes.Reader) error {
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	if err := m.Twist.Deserialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+// Automatically generated from the message definition "geometry_msgs/Vector3.msg"
+package geometry_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgVector3 struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgVector3) Text() string {
+	return t.text
+}
+
+func (t *_MsgVector3) Name() string {
+	return t.name
+}
+
+func (t *_MsgVector3) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgVector3) NewMessage() ros.Message {
+	m := new(Vector3)
+	m.X = 0.0
+	m.Y = 0.0
+	m.Z = 0.0
+	return m
+}
+
+var (
+	MsgVector3 = &_MsgVector3{
+		`# This represents a vector in free space. 
+# It is only meant to represent a direction. Therefore, it does not
+# make sense to apply a translation to it (e.g., when applying a 
+# generic rigid transformation to a Vector3, tf2 will only apply the
+# rotation). If you want your data to be translatable too, use the
+# geometry_msgs/Point message instead.
+
+float64 x
+float64 y
+float64 z
+`,
+		"geometry_msgs/Vector3",
+		"4a842b65f413084dc2b10fb484ea7f17",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgVector3)
+}
+
+type Vector3 struct {
+	X float64 `rosmsg:"x:float64"`
+	Y float64 `rosmsg:"y:float64"`
+	Z float64 `rosmsg:"z:float64"`
+}
+
+func (m *Vector3) Type() ros.MessageType {
+	return MsgVector3
+}
+
+func (m *Vector3) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Vector3) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Vector3) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Vector3) Clone() *Vector3 {
+	c := *m
+	return &c
+}
+
+func (m *Vector3) Equal(other *Vector3) bool {
+	if m.X != other.X {
+		return false
+	}
+	if m.Y != other.Y {
+		return false
+	}
+	if m.Z != other.Z {
+		return false
+	}
+	return true
+}
+
+func (m *Vector3) SerializedLength() int {
+	length := 0
+	length += 8
+	length += 8
+	length += 8
+	return length
+}
+
+func (m *Vector3) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.X))
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.Y))
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.Z))
+	buf.Write(b[:8])
+	return nil
+}
+
+func (m *Vector3) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.X = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Y = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Z = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	return nil
+}
@@ -0,0 +1,143 @@
+// Automatically generated from the message definition "geometry_msgs/Vector3Stamped.msg"
+package geometry_msgs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+type _MsgVector3Stamped struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgVector3Stamped) Text() string {
+	return t.text
+}
+
+func (t *_MsgVector3Stamped) Name() string {
+	return t.name
+}
+
+func (t *_MsgVector3Stamped) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgVector3Stamped) NewMessage() ros.Message {
+	m := new(Vector3Stamped)
+	m.Header = std_msgs.Header{}
+	m.Vector = Vector3{}
+	return m
+}
+
+var (
+	MsgVector3Stamped = &_MsgVector3Stamped{
+		`# This represents a Vector3 with reference coordinate frame and timestamp
+Header header
+Vector3 vector
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: geometry_msgs/Vector3
+# This represents a vector in free space. 
+# It is only meant to represent a direction. Therefore, it does not
+# make sense to apply a translation to it (e.g., when applying a 
+# generic rigid transformation to a Vector3, tf2 will only apply the
+# rotation). If you want your data to be translatable too, use the
+# geometry_msgs/Point message instead.
+
+float64 x
+float64 y
+float64 z
+`,
+		"geometry_msgs/Vector3Stamped",
+		"7b324c7325e683bf02a9b14b01090ec7",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgVector3Stamped)
+}
+
+type Vector3Stamped struct {
+	Header std_msgs.Header `rosmsg:"header:Header"`
+	Vector Vector3         `rosmsg:"vector:Vector3"`
+}
+
+func (m *Vector3Stamped) Type() ros.MessageType {
+	return MsgVector3Stamped
+}
+
+func (m *Vector3Stamped) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Vector3Stamped) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Vector3Stamped) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Vector3Stamped) Clone() *Vector3Stamped {
+	c := *m
+	c.Header = *m.Header.Clone()
+	c.Vector = *m.Vector.Clone()
+	return &c
+}
+
+func (m *Vector3Stamped) Equal(other *Vector3Stamped) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if !m.Vector.Equal(&other.Vector) {
+		return false
+	}
+	return true
+}
+
+func (m *Vector3Stamped) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += m.Vector.SerializedLength()
+	return length
+}
+
+func (m *Vector3Stamped) Serialize(buf *bytes.Buffer) error {
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	if err := m.Vector.Serialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Vector3Stamped) Deserialize(buf *bytes.Reader) error {
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	if err := m.Vector.Deserialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+// Automatically generated from the message definition "geometry_msgs/Wrench.msg"
+package geometry_msgs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/ros"
+)
+
+type _MsgWrench struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgWrench) Text() string {
+	return t.text
+}
+
+func (t *_MsgWrench) Name() string {
+	return t.name
+}
+
+func (t *_MsgWrench) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgWrench) NewMessage() ros.Message {
+	m := new(Wrench)
+	m.Force = Vector3{}
+	m.Torque = Vector3{}
+	return m
+}
+
+var (
+	MsgWrench = &_MsgWrench{
+		`# This represents force in free space, separated into
+# its linear and angular parts.
+Vector3  force
+Vector3  torque
+
+================================================================================
+MSG: geometry_msgs/Vector3
+# This represents a vector in free space. 
+# It is only meant to represent a direction. Therefore, it does not
+# make sense to apply a translation to it (e.g., when applying a 
+# generic rigid transformation to a Vector3, tf2 will only apply the
+# rotation). If you want your data to be translatable too, use the
+# geometry_msgs/Point message instead.
+
+float64 x
+float64 y
+float64 z
+`,
+		"geometry_msgs/Wrench",
+		"4f539cf138b23283b520fd271b567936",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgWrench)
+}
+
+type Wrench struct {
+	Force  Vector3 `rosmsg:"force:Vector3"`
+	Torque Vector3 `rosmsg:"torque:Vector3"`
+}
+
+func (m *Wrench) Type() ros.MessageType {
+	return MsgWrench
+}
+
+func (m *Wrench) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Wrench) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Wrench) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Wrench) Clone() *Wrench {
+	c := *m
+	c.Force = *m.Force.Clone()
+	c.Torque = *m.Torque.Clone()
+	return &c
+}
+
+func (m *Wrench) Equal(other *Wrench) bool {
+	if !m.Force.Equal(&other.Force) {
+		return false
+	}
+	if !m.Torque.Equal(&other.Torque) {
+		return false
+	}
+	return true
+}
+
+func (m *Wrench) SerializedLength() int {
+	length := 0
+	length += m.Force.SerializedLength()
+	length += m.Torque.SerializedLength()
+	return length
+}
+
+func (m *Wrench) Serialize(buf *bytes.Buffer) error {
+	if err := m.Force.Serialize(buf); err != nil {
+		return err
+	}
+	if err := m.Torque.Serialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Wrench) Deserialize(buf *bytes.Reader) error {
+	if err := m.Force.Deserialize(buf); err != nil {
+		return err
+	}
+	if err := m.Torque.Deserialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+// Automatically generated from the message definition "geometry_msgs/WrenchStamped.msg"
+package geometry_msgs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+type _MsgWrenchStamped struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgWrenchStamped) Text() string {
+	return t.text
+}
+
+func (t *_MsgWrenchStamped) Name() string {
+	return t.name
+}
+
+func (t *_MsgWrenchStamped) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgWrenchStamped) NewMessage() ros.Message {
+	m := new(WrenchStamped)
+	m.Header = std_msgs.Header{}
+	m.Wrench = Wrench{}
+	return m
+}
+
+var (
+	MsgWrenchStamped = &_MsgWrenchStamped{
+		`# A wrench with reference coordinate frame and timestamp
+Header header
+Wrench wrench
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: geometry_msgs/Wrench
+# This represents force in free space, separated into
+# its linear and angular parts.
+Vector3  force
+Vector3  torque
+
+================================================================================
+MSG: geometry_msgs/Vector3
+# This represents a vector in free space. 
+# It is only meant to represent a direction. Therefore, it does not
+# make sense to apply a translation to it (e.g., when applying a 
+# generic rigid transformation to a Vector3, tf2 will only apply the
+# rotation). If you want your data to be translatable too, use the
+# geometry_msgs/Point message instead.
+
+float64 x
+float64 y
+float64 z
+`,
+		"geometry_msgs/WrenchStamped",
+		"d78d3cb249ce23087ade7e7d0c40cfa7",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgWrenchStamped)
+}
+
+type WrenchStamped struct {
+	Header std_msgs.Header `rosmsg:"header:Header"`
+	Wrench Wrench          `rosmsg:"wrench:Wrench"`
+}
+
+func (m *WrenchStamped) Type() ros.MessageType {
+	return MsgWrenchStamped
+}
+
+func (m *WrenchStamped) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *WrenchStamped) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *WrenchStamped) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *WrenchStamped) Clone() *WrenchStamped {
+	c := *m
+	c.Header = *m.Header.Clone()
+	c.Wrench = *m.Wrench.Clone()
+	return &c
+}
+
+func (m *WrenchStamped) Equal(other *WrenchStamped) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if !m.Wrench.Equal(&other.Wrench) {
+		return false
+	}
+	return true
+}
+
+func (m *WrenchStamped) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += m.Wrench.SerializedLength()
+	return length
+}
+
+func (m *WrenchStamped) Serialize(buf *bytes.Buffer) error {
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	if err := m.Wrench.Serialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *WrenchStamped) Deserialize(buf *bytes.Reader) error {
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	if err := m.Wrench.Deserialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
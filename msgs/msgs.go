@@ -0,0 +1,20 @@
+// Package msgs provides the definitions of the standard ROS messages and
+// services that are shipped with rosgo. Go packages generated from them
+// are the subdirectories, e.g. github.com/akio/rosgo/msgs/std_msgs.
+//
+// gengo uses these definitions for ROS packages that are not found in
+// ROS_PACKAGE_PATH, so nodes can be built without a ROS installation.
+package msgs
+
+import "embed"
+
+//go:generate go run github.com/akio/rosgo/gengo -skip-unchanged -path . -out . -import github.com/akio/rosgo/msgs all
+
+// Definitions holds the message and service definitions as
+// <ROS package>/msg/<Name>.msg and <ROS package>/srv/<Name>.srv.
+//
+//go:embed */msg/*.msg */srv/*.srv
+var Definitions embed.FS
+
+// ImportPrefix is the import path of the generated packages.
+const ImportPrefix = "github.com/akio/rosgo/msgs"
@@ -0,0 +1,263 @@
+package msgs_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/akio/rosgo/msgs/actionlib_msgs"
+	"github.com/akio/rosgo/msgs/diagnostic_msgs"
+	"github.com/akio/rosgo/msgs/dynamic_reconfigure"
+	"github.com/akio/rosgo/msgs/geometry_msgs"
+	"github.com/akio/rosgo/msgs/nav_msgs"
+	"github.com/akio/rosgo/msgs/rosgraph_msgs"
+	"github.com/akio/rosgo/msgs/sensor_msgs"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/msgs/std_srvs"
+	"github.com/akio/rosgo/msgs/tf2_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+// MD5 sums reported by the ROS tools (rosmsg md5 / rossrv md5).
+func TestMD5Sum(t *testing.T) {
+	var tests = []struct {
+		msgType ros.MessageType
+		md5sum  string
+	}{
+		{std_msgs.MsgString, "992ce8a1687cec8c8bd883ec73ca41d1"},
+		{std_msgs.MsgHeader, "2176decaecbce78abc3b96ef049fabed"},
+		{std_msgs.MsgBool, "8b94c1b53db61fb6aed406028ad6332a"},
+		{std_msgs.MsgInt32, "da5909fbe378aeaf85e547e830cc1bb7"},
+		{std_msgs.MsgFloat64, "fdb28210bfa9d7c91146260178d9a584"},
+		{std_msgs.MsgEmpty, "d41d8cd98f00b204e9800998ecf8427e"},
+		{std_msgs.MsgTime, "cd7166c74c552c311fbcc2fe5a7bc289"},
+		{std_msgs.MsgDuration, "3e286caf4241d664e55f3ad380e2ae46"},
+		{std_msgs.MsgColorRGBA, "a29a96539573343b1310c73607334b00"},
+		{std_msgs.MsgByte, "ad736a2e8818154c487bb80fe42ce43b"},
+		{std_msgs.MsgChar, "1bf77f25acecdedba0e224b162199717"},
+		{std_msgs.MsgInt8, "27ffa0c9c4b8fb8492252bcad9e5c57b"},
+		{std_msgs.MsgUInt8, "7c8164229e7d2c17eb95e9231617fdee"},
+		{std_msgs.MsgInt16, "8524586e34fbd7cb1c08c5f5f1ca0e57"},
+		{std_msgs.MsgUInt16, "1df79edf208b629fe6b81923a544552d"},
+		{std_msgs.MsgUInt32, "304a39449588c7f8ce2df6e8001c5fce"},
+		{std_msgs.MsgInt64, "34add168574510e6e17f5d23ecc077ef"},
+		{std_msgs.MsgUInt64, "1b2a79973e8bf53d7b53acb71299cb57"},
+		{std_msgs.MsgFloat32, "73fcbf46b49191e672908e50842a83d4"},
+		{std_msgs.MsgMultiArrayLayout, "0fed2a11c13e11c5571b4e2a995a91a3"},
+		{std_msgs.MsgMultiArrayDimension, "4cd0c83a8683deae40ecdac60e53bfa8"},
+		{std_msgs.MsgFloat64MultiArray, "4b7d974086d4060e7db4613a7e6c3ba4"},
+		{std_msgs.MsgUInt8MultiArray, "82373f1612381bb6ee473b5cd6f5d89c"},
+		{std_msgs.MsgInt32MultiArray, "1d99f79f8b325b44fee908053e9c945b"},
+		{geometry_msgs.MsgPoint, "4a842b65f413084dc2b10fb484ea7f17"},
+		{geometry_msgs.MsgVector3, "4a842b65f413084dc2b10fb484ea7f17"},
+		{geometry_msgs.MsgQuaternion, "a779879fadf0160734f906b8c19c7004"},
+		{geometry_msgs.MsgPose, "e45d45a5a1ce597b249e23fb30fc871f"},
+		{geometry_msgs.MsgPoseStamped, "d3812c3cbc69362b77dc0b19b345f8f5"},
+		{geometry_msgs.MsgTwist, "9f195f881246fdfa2798d1d3eebca84a"},
+		{geometry_msgs.MsgAccel, "9f195f881246fdfa2798d1d3eebca84a"},
+		{geometry_msgs.MsgTransform, "ac9eff44abf714214112b05d54a3cf9b"},
+		{geometry_msgs.MsgTransformStamped, "b5764a33bfeb3588febc2682852579b0"},
+		{geometry_msgs.MsgPoseWithCovariance, "c23e848cf1b7533a8d7c259073a97e6f"},
+		{geometry_msgs.MsgTwistWithCovariance, "1fe8a28e6890a4cc3ae4c3ca5c7d82e6"},
+		{geometry_msgs.MsgWrench, "4f539cf138b23283b520fd271b567936"},
+		{geometry_msgs.MsgPolygon, "cd60a26494a087f577976f0329fa120e"},
+		{geometry_msgs.MsgPoseArray, "916c28c5764443f268b296bb671b9d97"},
+		{geometry_msgs.MsgPoint32, "cc153912f1453b708d221682bc23d9ac"},
+		{geometry_msgs.MsgPose2D, "938fa65709584ad8e77d238529be13b8"},
+		{geometry_msgs.MsgTwistStamped, "98d34b0043a2093cf9d9345ab6eef12e"},
+		{geometry_msgs.MsgPoseWithCovarianceStamped, "953b798c0f514ff060a53a3498ce6246"},
+		{geometry_msgs.MsgPointStamped, "c63aecb41bfdfd6b7e1fac37c7cbe7bf"},
+		{geometry_msgs.MsgVector3Stamped, "7b324c7325e683bf02a9b14b01090ec7"},
+		{geometry_msgs.MsgQuaternionStamped, "e57f1e547e0e1fd13504588ffc8334e2"},
+		{geometry_msgs.MsgWrenchStamped, "d78d3cb249ce23087ade7e7d0c40cfa7"},
+		{nav_msgs.MsgOdometry, "cd5e73d190d741a2f92e81eda573aca7"},
+		{nav_msgs.MsgPath, "6227e2b7e9cce15051f669a5e197bbf7"},
+		{nav_msgs.MsgOccupancyGrid, "3381f2d731d4076ec5c71b0759edbe4e"},
+		{nav_msgs.MsgMapMetaData, "10cfc8a2818024d3248802c00c95f11b"},
+		{sensor_msgs.MsgImage, "060021388200f6f0f447d0fcd9c64743"},
+		{sensor_msgs.MsgLaserScan, "90c7ef2dc6895d81024acba2ac42f369"},
+		{sensor_msgs.MsgImu, "6a62c6daae103f4ff57a132d6f95cec2"},
+		{sensor_msgs.MsgJointState, "3066dcd76a6cfaef579bd0f34173e9fd"},
+		{sensor_msgs.MsgPointCloud2, "1158d486dd51d683ce2f1be655c3c181"},
+		{sensor_msgs.MsgCameraInfo, "c9a58c1b0b154e0e6da7578cb991d214"},
+		{sensor_msgs.MsgNavSatFix, "2d3a8cd499b9b4a0249fb98fd05cfa48"},
+		{sensor_msgs.MsgJoy, "5a9ea5f83505693b71e785041e67a8bb"},
+		{sensor_msgs.MsgCompressedImage, "8f7a12909da2c9d3332d540a0977563f"},
+		{sensor_msgs.MsgRange, "c005c34273dc426c67a020a87bc24148"},
+		{sensor_msgs.MsgMagneticField, "2f3b0b43eed0c9501de0fa3ff89a45aa"},
+		{sensor_msgs.MsgBatteryState, "4ddae7f048e32fda22cac764685e3974"},
+		{sensor_msgs.MsgTemperature, "ff71b307acdbe7c871a5a6d7ed359100"},
+		{sensor_msgs.MsgFluidPressure, "804dc5cea1c5306d6a2eb80b9833befe"},
+		{sensor_msgs.MsgRegionOfInterest, "bdb633039d588fcccb441a4d43ccfe09"},
+		{sensor_msgs.MsgPointField, "268eacb2962780ceac86cbd17e328150"},
+		{sensor_msgs.MsgChannelFloat32, "3d40139cdd33dfedcb71ffeeeb42ae7f"},
+		{sensor_msgs.MsgPointCloud, "d8e9c3f5afbdd8a130fd1d2763945fca"},
+		{rosgraph_msgs.MsgLog, "acffd30cd6b6de30f120938c17c593fb"},
+		{rosgraph_msgs.MsgClock, "a9c97c1d230cfc112e270351a944ee47"},
+		{tf2_msgs.MsgTFMessage, "94810edda583a504dfda3829e70d7eec"},
+		{actionlib_msgs.MsgGoalID, "302881f31927c1df708a2dbab0e80ee8"},
+		{actionlib_msgs.MsgGoalStatus, "d388f9b87b3c471f784434d671988d4a"},
+		{actionlib_msgs.MsgGoalStatusArray, "8b2b82f13216d0a8ea88bd3af735e619"},
+		{diagnostic_msgs.MsgKeyValue, "cf57fdc6617a881a88c16e768132149c"},
+		{diagnostic_msgs.MsgDiagnosticStatus, "d0ce08bc6e5ba34c7754f563a9cabaf1"},
+		{diagnostic_msgs.MsgDiagnosticArray, "60810da900de1dd6ddd437c3503511da"},
+		{dynamic_reconfigure.MsgConfig, "958f16a05573709014982821e6822580"},
+		{dynamic_reconfigure.MsgConfigDescription, "757ce9d44ba8ddd801bb30bc456f946f"},
+		{dynamic_reconfigure.MsgGroup, "9e8cd9e9423c94823db3614dd8b1cf7a"},
+		{dynamic_reconfigure.MsgParamDescription, "7434fcb9348c13054e0c3b267c8cb34d"},
+	}
+	for _, test := range tests {
+		if test.msgType.MD5Sum() != test.md5sum {
+			t.Errorf("%s: expected %s but %s", test.msgType.Name(), test.md5sum, test.msgType.MD5Sum())
+		}
+	}
+}
+
+func TestSrvMD5Sum(t *testing.T) {
+	var tests = []struct {
+		srvType ros.ServiceType
+		md5sum  string
+	}{
+		{std_srvs.SrvTrigger, "937c9679a518e3a18d831e57125ea522"},
+		{std_srvs.SrvSetBool, "09fb03525b03e7ea1fd3992bafd87e16"},
+		{std_srvs.SrvEmpty, "d41d8cd98f00b204e9800998ecf8427e"},
+		{nav_msgs.SrvGetMap, "6cdd0a18e0aff5b0a3ca2326a89b54ff"},
+		{dynamic_reconfigure.SrvReconfigure, "bb125d226a21982a4a98760418dc2672"},
+	}
+	for _, test := range tests {
+		if test.srvType.MD5Sum() != test.md5sum {
+			t.Errorf("%s: expected %s but %s", test.srvType.Name(), test.md5sum, test.srvType.MD5Sum())
+		}
+	}
+}
+
+func TestClone(t *testing.T) {
+	var path nav_msgs.Path
+	path.Header.FrameId = "map"
+	path.Poses = make([]geometry_msgs.PoseStamped, 2)
+	path.Poses[1].Pose.Position.X = 1.0
+
+	c := path.Clone()
+	if !c.Equal(&path) {
+		t.Fatalf("clone differs: %v", c)
+	}
+	c.Poses[1].Pose.Position.X = 2.0
+	if path.Poses[1].Pose.Position.X != 1.0 {
+		t.Error("clone shares an array with the original")
+	}
+	if c.Equal(&path) {
+		t.Error("modified clone equals to the original")
+	}
+
+	image := sensor_msgs.Image{Data: []uint8{1, 2, 3}}
+	imageClone := image.Clone()
+	imageClone.Data[0] = 0
+	if image.Data[0] != 1 {
+		t.Error("clone shares data with the original")
+	}
+	if imageClone.Equal(&image) {
+		t.Error("modified clone equals to the original")
+	}
+}
+
+// A length prefix which the remaining bytes cannot hold is rejected
+// before allocating the array.
+func TestDeserializeHugeArray(t *testing.T) {
+	var buf bytes.Buffer
+	(&std_msgs.Header{}).Serialize(&buf)
+	binary.Write(&buf, binary.LittleEndian, uint32(0x7fffffff))
+	var msg geometry_msgs.PoseArray
+	if err := msg.Deserialize(bytes.NewReader(buf.Bytes())); err != io.ErrUnexpectedEOF {
+		t.Errorf("expected io.ErrUnexpectedEOF but %v", err)
+	}
+}
+
+func TestString(t *testing.T) {
+	msg := geometry_msgs.PointStamped{}
+	msg.Header.Seq = 3
+	msg.Header.Stamp = ros.NewTime(10, 500)
+	msg.Header.FrameId = "base_link"
+	msg.Point.X = 1.5
+	expected := `header: 
+  seq: 3
+  stamp: 
+    secs: 10
+    nsecs:       500
+  frame_id: "base_link"
+point: 
+  x: 1.5
+  y: 0.0
+  z: 0.0`
+	if msg.String() != expected {
+		t.Errorf("expected:\n%s\nbut:\n%s", expected, msg.String())
+	}
+}
+
+// Generated packages register their types when imported.
+func TestRegistry(t *testing.T) {
+	for _, name := range []string{"sensor_msgs/Image", "std_msgs/Header", "std_srvs/TriggerRequest"} {
+		msgType, ok := ros.DefaultRegistry.MessageType(name)
+		if !ok {
+			t.Errorf("%s is not registered", name)
+			continue
+		}
+		if msg := msgType.NewMessage(); msg.Type().Name() != name {
+			t.Errorf("%s: unexpected type %s", name, msg.Type().Name())
+		}
+	}
+	if msgType, _ := ros.DefaultRegistry.MessageType("geometry_msgs/Point"); msgType != geometry_msgs.MsgPoint {
+		t.Errorf("unexpected type %v", msgType)
+	}
+	byMD5 := ros.DefaultRegistry.MessageTypesByMD5Sum(sensor_msgs.MsgImage.MD5Sum())
+	if len(byMD5) != 1 || byMD5[0] != sensor_msgs.MsgImage {
+		t.Errorf("unexpected types %v", byMD5)
+	}
+	if srvType, ok := ros.DefaultRegistry.ServiceType("std_srvs/Trigger"); !ok || srvType != std_srvs.SrvTrigger {
+		t.Errorf("unexpected type %v", srvType)
+	}
+	msg, err := ros.DefaultRegistry.NewMessage("std_msgs/String", std_msgs.MsgString.MD5Sum())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := msg.(*std_msgs.String); !ok {
+		t.Errorf("unexpected message %T", msg)
+	}
+}
+
+type recordingPublisher struct {
+	published []ros.Message
+}
+
+func (p *recordingPublisher) Publish(msg ros.Message) { p.published = append(p.published, msg) }
+func (p *recordingPublisher) Shutdown()               {}
+
+// Generated messages with std_msgs/Header are stamped.
+func TestStampingPublisher(t *testing.T) {
+	pub := ros.NewStampingPublisher(&recordingPublisher{})
+	pub.Clock = func() ros.Time { return ros.NewTime(3, 4) }
+	for i := 0; i < 2; i++ {
+		msg := &geometry_msgs.PointStamped{}
+		pub.Publish(msg)
+		expected := std_msgs.Header{Seq: uint32(i), Stamp: ros.NewTime(3, 4)}
+		if msg.Header != expected {
+			t.Errorf("expected %v but %v", expected, msg.Header)
+		}
+	}
+	// std_msgs/Header itself has no header.
+	header := &std_msgs.Header{}
+	pub.Publish(header)
+	if *header != (std_msgs.Header{}) {
+		t.Errorf("unexpected header %v", header)
+	}
+}
+
+// Generated services report their type.
+func TestServiceType(t *testing.T) {
+	if srvType := (&std_srvs.Trigger{}).Type(); srvType != std_srvs.SrvTrigger {
+		t.Errorf("unexpected type %v", srvType)
+	}
+	if srvType := (&nav_msgs.GetPlan{}).Type(); srvType.Name() != "nav_msgs/GetPlan" {
+		t.Errorf("unexpected type %v", srvType)
+	}
+}
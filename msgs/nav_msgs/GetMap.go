@@ -0,0 +1,50 @@
+// Automatically generated from the message definition "nav_msgs/GetMap.srv"
+package nav_msgs
+
+import (
+	"github.com/akio/rosgo/ros"
+)
+
+// Service type metadata
+type _SrvGetMap struct {
+	name    string
+	md5sum  string
+	text    string
+	reqType ros.MessageType
+	resType ros.MessageType
+}
+
+func (t *_SrvGetMap) Name() string                  { return t.name }
+func (t *_SrvGetMap) MD5Sum() string                { return t.md5sum }
+func (t *_SrvGetMap) Text() string                  { return t.text }
+func (t *_SrvGetMap) RequestType() ros.MessageType  { return t.reqType }
+func (t *_SrvGetMap) ResponseType() ros.MessageType { return t.resType }
+func (t *_SrvGetMap) NewService() ros.Service {
+	return new(GetMap)
+}
+
+var (
+	SrvGetMap = &_SrvGetMap{
+		"nav_msgs/GetMap",
+		"6cdd0a18e0aff5b0a3ca2326a89b54ff",
+		`# Get the map as a nav_msgs/OccupancyGrid
+---
+nav_msgs/OccupancyGrid map
+`,
+		MsgGetMapRequest,
+		MsgGetMapResponse,
+	}
+)
+
+func init() {
+	ros.RegisterServiceType(SrvGetMap)
+}
+
+type GetMap struct {
+	Request  GetMapRequest
+	Response GetMapResponse
+}
+
+func (s *GetMap) Type() ros.ServiceType   { return SrvGetMap }
+func (s *GetMap) ReqMessage() ros.Message { return &s.Request }
+func (s *GetMap) ResMessage() ros.Message { return &s.Response }
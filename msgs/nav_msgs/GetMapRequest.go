@@ -0,0 +1,85 @@
+// Automatically generated from the message definition "nav_msgs/GetMapRequest.msg"
+package nav_msgs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/ros"
+)
+
+type _MsgGetMapRequest struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgGetMapRequest) Text() string {
+	return t.text
+}
+
+func (t *_MsgGetMapRequest) Name() string {
+	return t.name
+}
+
+func (t *_MsgGetMapRequest) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgGetMapRequest) NewMessage() ros.Message {
+	m := new(GetMapRequest)
+	return m
+}
+
+var (
+	MsgGetMapRequest = &_MsgGetMapRequest{
+		`# Get the map as a nav_msgs/OccupancyGrid
+`,
+		"nav_msgs/GetMapRequest",
+		"d41d8cd98f00b204e9800998ecf8427e",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgGetMapRequest)
+}
+
+type GetMapRequest struct {
+}
+
+func (m *GetMapRequest) Type() ros.MessageType {
+	return MsgGetMapRequest
+}
+
+func (m *GetMapRequest) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *GetMapRequest) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *GetMapRequest) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *GetMapRequest) Clone() *GetMapRequest {
+	c := *m
+	return &c
+}
+
+func (m *GetMapRequest) Equal(other *GetMapRequest) bool {
+	return true
+}
+
+func (m *GetMapRequest) SerializedLength() int {
+	length := 0
+	return length
+}
+
+func (m *GetMapRequest) Serialize(buf *bytes.Buffer) error {
+	return nil
+}
+
+func (m *GetMapRequest) Deserialize(buf *bytes.Reader) error {
+	return nil
+}
@@ -0,0 +1,167 @@
+// Automatically generated from the message definition "nav_msgs/GetMapResponse.msg"
+package nav_msgs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/ros"
+)
+
+type _MsgGetMapResponse struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgGetMapResponse) Text() string {
+	return t.text
+}
+
+func (t *_MsgGetMapResponse) Name() string {
+	return t.name
+}
+
+func (t *_MsgGetMapResponse) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgGetMapResponse) NewMessage() ros.Message {
+	m := new(GetMapResponse)
+	m.Map = OccupancyGrid{}
+	return m
+}
+
+var (
+	MsgGetMapResponse = &_MsgGetMapResponse{
+		`
+nav_msgs/OccupancyGrid map
+
+================================================================================
+MSG: nav_msgs/OccupancyGrid
+# This represents a 2-D grid map, in which each cell represents the probability of
+# occupancy.
+
+Header header 
+
+#MetaData for the map
+MapMetaData info
+
+# The map data, in row-major order, starting with (0,0).  Occupancy
+# probabilities are in the range [0,100].  Unknown is -1.
+int8[] data
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: nav_msgs/MapMetaData
+# This hold basic information about the characterists of the OccupancyGrid
+
+# The time at which the map was loaded
+time map_load_time
+# The map resolution [m/cell]
+float32 resolution
+# Map width [cells]
+uint32 width
+# Map height [cells]
+uint32 height
+# The origin of the map [m, m, rad].  This is the real-world pose of the
+# cell (0,0) in the map.
+geometry_msgs/Pose origin
+
+================================================================================
+MSG: geometry_msgs/Pose
+# A representation of pose in free space, composed of position and orientation. 
+Point position
+Quaternion orientation
+
+================================================================================
+MSG: geometry_msgs/Point
+# This contains the position of a point in free space
+float64 x
+float64 y
+float64 z
+
+================================================================================
+MSG: geometry_msgs/Quaternion
+# This represents an orientation in free space in quaternion form.
+
+float64 x
+float64 y
+float64 z
+float64 w
+`,
+		"nav_msgs/GetMapResponse",
+		"6cdd0a18e0aff5b0a3ca2326a89b54ff",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgGetMapResponse)
+}
+
+type GetMapResponse struct {
+	Map OccupancyGrid `rosmsg:"map:OccupancyGrid"`
+}
+
+func (m *GetMapResponse) Type() ros.MessageType {
+	return MsgGetMapResponse
+}
+
+func (m *GetMapResponse) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *GetMapResponse) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *GetMapResponse) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *GetMapResponse) Clone() *GetMapResponse {
+	c := *m
+	c.Map = *m.Map.Clone()
+	return &c
+}
+
+func (m *GetMapResponse) Equal(other *GetMapResponse) bool {
+	if !m.Map.Equal(&other.Map) {
+		return false
+	}
+	return true
+}
+
+func (m *GetMapResponse) SerializedLength() int {
+	length := 0
+	length += m.Map.SerializedLength()
+	return length
+}
+
+func (m *GetMapResponse) Serialize(buf *bytes.Buffer) error {
+	if err := m.Map.Serialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *GetMapResponse) Deserialize(buf *bytes.Reader) error {
+	if err := m.Map.Deserialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
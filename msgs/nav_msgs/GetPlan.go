@@ -0,0 +1,60 @@
+// Automatically generated from the message definition "nav_msgs/GetPlan.srv"
+package nav_msgs
+
+import (
+	"github.com/akio/rosgo/ros"
+)
+
+// Service type metadata
+type _SrvGetPlan struct {
+	name    string
+	md5sum  string
+	text    string
+	reqType ros.MessageType
+	resType ros.MessageType
+}
+
+func (t *_SrvGetPlan) Name() string                  { return t.name }
+func (t *_SrvGetPlan) MD5Sum() string                { return t.md5sum }
+func (t *_SrvGetPlan) Text() string                  { return t.text }
+func (t *_SrvGetPlan) RequestType() ros.MessageType  { return t.reqType }
+func (t *_SrvGetPlan) ResponseType() ros.MessageType { return t.resType }
+func (t *_SrvGetPlan) NewService() ros.Service {
+	return new(GetPlan)
+}
+
+var (
+	SrvGetPlan = &_SrvGetPlan{
+		"nav_msgs/GetPlan",
+		"421c8ea4d21c6c9db7054b4bbdf1e024",
+		`# Get a plan from the current position to the goal Pose 
+
+# The start pose for the plan
+geometry_msgs/PoseStamped start
+
+# The final pose of the goal position
+geometry_msgs/PoseStamped goal
+
+# If the goal is obstructed, how many meters the planner can 
+# relax the constraint in x and y before failing. 
+float32 tolerance
+---
+nav_msgs/Path plan
+`,
+		MsgGetPlanRequest,
+		MsgGetPlanResponse,
+	}
+)
+
+func init() {
+	ros.RegisterServiceType(SrvGetPlan)
+}
+
+type GetPlan struct {
+	Request  GetPlanRequest
+	Response GetPlanResponse
+}
+
+func (s *GetPlan) Type() ros.ServiceType   { return SrvGetPlan }
+func (s *GetPlan) ReqMessage() ros.Message { return &s.Request }
+func (s *GetPlan) ResMessage() ros.Message { return &s.Response }
@@ -0,0 +1,183 @@
+// Automatically generated from the message definition "nav_msgs/GetPlanRequest.msg"
+package nav_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/geometry_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgGetPlanRequest struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgGetPlanRequest) Text() string {
+	return t.text
+}
+
+func (t *_MsgGetPlanRequest) Name() string {
+	return t.name
+}
+
+func (t *_MsgGetPlanRequest) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgGetPlanRequest) NewMessage() ros.Message {
+	m := new(GetPlanRequest)
+	m.Start = geometry_msgs.PoseStamped{}
+	m.Goal = geometry_msgs.PoseStamped{}
+	m.Tolerance = 0.0
+	return m
+}
+
+var (
+	MsgGetPlanRequest = &_MsgGetPlanRequest{
+		`# Get a plan from the current position to the goal Pose 
+
+# The start pose for the plan
+geometry_msgs/PoseStamped start
+
+# The final pose of the goal position
+geometry_msgs/PoseStamped goal
+
+# If the goal is obstructed, how many meters the planner can 
+# relax the constraint in x and y before failing. 
+float32 tolerance
+
+================================================================================
+MSG: geometry_msgs/PoseStamped
+# A Pose with reference coordinate frame and timestamp
+Header header
+Pose pose
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: geometry_msgs/Pose
+# A representation of pose in free space, composed of position and orientation. 
+Point position
+Quaternion orientation
+
+================================================================================
+MSG: geometry_msgs/Point
+# This contains the position of a point in free space
+float64 x
+float64 y
+float64 z
+
+================================================================================
+MSG: geometry_msgs/Quaternion
+# This represents an orientation in free space in quaternion form.
+
+float64 x
+float64 y
+float64 z
+float64 w
+`,
+		"nav_msgs/GetPlanRequest",
+		"e25a43e0752bcca599a8c2eef8282df8",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgGetPlanRequest)
+}
+
+type GetPlanRequest struct {
+	Start     geometry_msgs.PoseStamped `rosmsg:"start:PoseStamped"`
+	Goal      geometry_msgs.PoseStamped `rosmsg:"goal:PoseStamped"`
+	Tolerance float32                   `rosmsg:"tolerance:float32"`
+}
+
+func (m *GetPlanRequest) Type() ros.MessageType {
+	return MsgGetPlanRequest
+}
+
+func (m *GetPlanRequest) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *GetPlanRequest) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *GetPlanRequest) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *GetPlanRequest) Clone() *GetPlanRequest {
+	c := *m
+	c.Start = *m.Start.Clone()
+	c.Goal = *m.Goal.Clone()
+	return &c
+}
+
+func (m *GetPlanRequest) Equal(other *GetPlanRequest) bool {
+	if !m.Start.Equal(&other.Start) {
+		return false
+	}
+	if !m.Goal.Equal(&other.Goal) {
+		return false
+	}
+	if m.Tolerance != other.Tolerance {
+		return false
+	}
+	return true
+}
+
+func (m *GetPlanRequest) SerializedLength() int {
+	length := 0
+	length += m.Start.SerializedLength()
+	length += m.Goal.SerializedLength()
+	length += 4
+	return length
+}
+
+func (m *GetPlanRequest) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Start.Serialize(buf); err != nil {
+		return err
+	}
+	if err := m.Goal.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.Tolerance))
+	buf.Write(b[:4])
+	return nil
+}
+
+func (m *GetPlanRequest) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Start.Deserialize(buf); err != nil {
+		return err
+	}
+	if err := m.Goal.Deserialize(buf); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Tolerance = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	return nil
+}
@@ -0,0 +1,149 @@
+// Automatically generated from the message definition "nav_msgs/GetPlanResponse.msg"
+package nav_msgs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/ros"
+)
+
+type _MsgGetPlanResponse struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgGetPlanResponse) Text() string {
+	return t.text
+}
+
+func (t *_MsgGetPlanResponse) Name() string {
+	return t.name
+}
+
+func (t *_MsgGetPlanResponse) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgGetPlanResponse) NewMessage() ros.Message {
+	m := new(GetPlanResponse)
+	m.Plan = Path{}
+	return m
+}
+
+var (
+	MsgGetPlanResponse = &_MsgGetPlanResponse{
+		`
+nav_msgs/Path plan
+
+================================================================================
+MSG: nav_msgs/Path
+#An array of poses that represents a Path for a robot to follow
+Header header
+geometry_msgs/PoseStamped[] poses
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: geometry_msgs/PoseStamped
+# A Pose with reference coordinate frame and timestamp
+Header header
+Pose pose
+
+================================================================================
+MSG: geometry_msgs/Pose
+# A representation of pose in free space, composed of position and orientation. 
+Point position
+Quaternion orientation
+
+================================================================================
+MSG: geometry_msgs/Point
+# This contains the position of a point in free space
+float64 x
+float64 y
+float64 z
+
+================================================================================
+MSG: geometry_msgs/Quaternion
+# This represents an orientation in free space in quaternion form.
+
+float64 x
+float64 y
+float64 z
+float64 w
+`,
+		"nav_msgs/GetPlanResponse",
+		"0002bc113c0259d71f6cf8cbc9430e18",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgGetPlanResponse)
+}
+
+type GetPlanResponse struct {
+	Plan Path `rosmsg:"plan:Path"`
+}
+
+func (m *GetPlanResponse) Type() ros.MessageType {
+	return MsgGetPlanResponse
+}
+
+func (m *GetPlanResponse) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *GetPlanResponse) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *GetPlanResponse) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *GetPlanResponse) Clone() *GetPlanResponse {
+	c := *m
+	c.Plan = *m.Plan.Clone()
+	return &c
+}
+
+func (m *GetPlanResponse) Equal(other *GetPlanResponse) bool {
+	if !m.Plan.Equal(&other.Plan) {
+		return false
+	}
+	return true
+}
+
+func (m *GetPlanResponse) SerializedLength() int {
+	length := 0
+	length += m.Plan.SerializedLength()
+	return length
+}
+
+func (m *GetPlanResponse) Serialize(buf *bytes.Buffer) error {
+	if err := m.Plan.Serialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *GetPlanResponse) Deserialize(buf *bytes.Reader) error {
+	if err := m.Plan.Deserialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+// Automatically generated from the message definition "nav_msgs/GridCells.msg"
+package nav_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/geometry_msgs"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgGridCells struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgGridCells) Text() string {
+	return t.text
+}
+
+func (t *_MsgGridCells) Name() string {
+	return t.name
+}
+
+func (t *_MsgGridCells) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgGridCells) NewMessage() ros.Message {
+	m := new(GridCells)
+	m.Header = std_msgs.Header{}
+	m.CellWidth = 0.0
+	m.CellHeight = 0.0
+	m.Cells = []geometry_msgs.Point{}
+	return m
+}
+
+var (
+	MsgGridCells = &_MsgGridCells{
+		`#an array of cells in a 2D grid
+Header header
+float32 cell_width
+float32 cell_height
+geometry_msgs/Point[] cells
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: geometry_msgs/Point
+# This contains the position of a point in free space
+float64 x
+float64 y
+float64 z
+`,
+		"nav_msgs/GridCells",
+		"b9e4f5df6d28e272ebde00a3994830f5",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgGridCells)
+}
+
+type GridCells struct {
+	Header     std_msgs.Header       `rosmsg:"header:Header"`
+	CellWidth  float32               `rosmsg:"cell_width:float32"`
+	CellHeight float32               `rosmsg:"cell_height:float32"`
+	Cells      []geometry_msgs.Point `rosmsg:"cells:Point[]"`
+}
+
+func (m *GridCells) Type() ros.MessageType {
+	return MsgGridCells
+}
+
+func (m *GridCells) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *GridCells) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *GridCells) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *GridCells) Clone() *GridCells {
+	c := *m
+	c.Header = *m.Header.Clone()
+	if m.Cells != nil {
+		c.Cells = make([]geometry_msgs.Point, len(m.Cells))
+		for i := range m.Cells {
+			c.Cells[i] = *m.Cells[i].Clone()
+		}
+	}
+	return &c
+}
+
+func (m *GridCells) Equal(other *GridCells) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if m.CellWidth != other.CellWidth {
+		return false
+	}
+	if m.CellHeight != other.CellHeight {
+		return false
+	}
+	if len(m.Cells) != len(other.Cells) {
+		return false
+	}
+	for i := range m.Cells {
+		if !m.Cells[i].Equal(&other.Cells[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *GridCells) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += 4
+	length += 4
+	length += 4
+	for i := range m.Cells {
+		length += m.Cells[i].SerializedLength()
+	}
+	return length
+}
+
+func (m *GridCells) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.CellWidth))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.CellHeight))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Cells)))
+	buf.Write(b[:4])
+	for i := range m.Cells {
+		if err := m.Cells[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *GridCells) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.CellWidth = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.CellHeight = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*24 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Cells = make([]geometry_msgs.Point, size)
+		for i := range m.Cells {
+			if err := m.Cells[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+// Automatically generated from the message definition "nav_msgs/MapMetaData.msg"
+package nav_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/geometry_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgMapMetaData struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgMapMetaData) Text() string {
+	return t.text
+}
+
+func (t *_MsgMapMetaData) Name() string {
+	return t.name
+}
+
+func (t *_MsgMapMetaData) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgMapMetaData) NewMessage() ros.Message {
+	m := new(MapMetaData)
+	m.MapLoadTime = ros.Time{}
+	m.Resolution = 0.0
+	m.Width = 0
+	m.Height = 0
+	m.Origin = geometry_msgs.Pose{}
+	return m
+}
+
+var (
+	MsgMapMetaData = &_MsgMapMetaData{
+		`# This hold basic information about the characterists of the OccupancyGrid
+
+# The time at which the map was loaded
+time map_load_time
+# The map resolution [m/cell]
+float32 resolution
+# Map width [cells]
+uint32 width
+# Map height [cells]
+uint32 height
+# The origin of the map [m, m, rad].  This is the real-world pose of the
+# cell (0,0) in the map.
+geometry_msgs/Pose origin
+
+================================================================================
+MSG: geometry_msgs/Pose
+# A representation of pose in free space, composed of position and orientation. 
+Point position
+Quaternion orientation
+
+================================================================================
+MSG: geometry_msgs/Point
+# This contains the position of a point in free space
+float64 x
+float64 y
+float64 z
+
+================================================================================
+MSG: geometry_msgs/Quaternion
+# This represents an orientation in free space in quaternion form.
+
+float64 x
+float64 y
+float64 z
+float64 w
+`,
+		"nav_msgs/MapMetaData",
+		"10cfc8a2818024d3248802c00c95f11b",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgMapMetaData)
+}
+
+type MapMetaData struct {
+	MapLoadTime ros.Time           `rosmsg:"map_load_time:time"`
+	Resolution  float32            `rosmsg:"resolution:float32"`
+	Width       uint32             `rosmsg:"width:uint32"`
+	Height      uint32             `rosmsg:"height:uint32"`
+	Origin      geometry_msgs.Pose `rosmsg:"origin:Pose"`
+}
+
+func (m *MapMetaData) Type() ros.MessageType {
+	return MsgMapMetaData
+}
+
+func (m *MapMetaData) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *MapMetaData) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *MapMetaData) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *MapMetaData) Clone() *MapMetaData {
+	c := *m
+	c.Origin = *m.Origin.Clone()
+	return &c
+}
+
+func (m *MapMetaData) Equal(other *MapMetaData) bool {
+	if m.MapLoadTime != other.MapLoadTime {
+		return false
+	}
+	if m.Resolution != other.Resolution {
+		return false
+	}
+	if m.Width != other.Width {
+		return false
+	}
+	if m.Height != other.Height {
+		return false
+	}
+	if !m.Origin.Equal(&other.Origin) {
+		return false
+	}
+	return true
+}
+
+func (m *MapMetaData) SerializedLength() int {
+	length := 0
+	length += 8
+	length += 4
+	length += 4
+	length += 4
+	length += m.Origin.SerializedLength()
+	return length
+}
+
+func (m *MapMetaData) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:], m.MapLoadTime.Sec)
+	binary.LittleEndian.PutUint32(b[4:], m.MapLoadTime.NSec)
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.Resolution))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], m.Width)
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], m.Height)
+	buf.Write(b[:4])
+	if err := m.Origin.Serialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *MapMetaData) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.MapLoadTime.Sec = binary.LittleEndian.Uint32(b[:])
+	m.MapLoadTime.NSec = binary.LittleEndian.Uint32(b[4:])
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Resolution = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Width = binary.LittleEndian.Uint32(b[:])
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Height = binary.LittleEndian.Uint32(b[:])
+	if err := m.Origin.Deserialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
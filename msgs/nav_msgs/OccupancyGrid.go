@@ -0,0 +1,226 @@
+// Automatically generated from the message definition "nav_msgs/OccupancyGrid.msg"
+package nav_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgOccupancyGrid struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgOccupancyGrid) Text() string {
+	return t.text
+}
+
+func (t *_MsgOccupancyGrid) Name() string {
+	return t.name
+}
+
+func (t *_MsgOccupancyGrid) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgOccupancyGrid) NewMessage() ros.Message {
+	m := new(OccupancyGrid)
+	m.Header = std_msgs.Header{}
+	m.Info = MapMetaData{}
+	m.Data = []int8{}
+	return m
+}
+
+var (
+	MsgOccupancyGrid = &_MsgOccupancyGrid{
+		`# This represents a 2-D grid map, in which each cell represents the probability of
+# occupancy.
+
+Header header 
+
+#MetaData for the map
+MapMetaData info
+
+# The map data, in row-major order, starting with (0,0).  Occupancy
+# probabilities are in the range [0,100].  Unknown is -1.
+int8[] data
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: nav_msgs/MapMetaData
+# This hold basic information about the characterists of the OccupancyGrid
+
+# The time at which the map was loaded
+time map_load_time
+# The map resolution [m/cell]
+float32 resolution
+# Map width [cells]
+uint32 width
+# Map height [cells]
+uint32 height
+# The origin of the map [m, m, rad].  This is the real-world pose of the
+# cell (0,0) in the map.
+geometry_msgs/Pose origin
+
+================================================================================
+MSG: geometry_msgs/Pose
+# A representation of pose in free space, composed of position and orientation. 
+Point position
+Quaternion orientation
+
+================================================================================
+MSG: geometry_msgs/Point
+# This contains the position of a point in free space
+float64 x
+float64 y
+float64 z
+
+================================================================================
+MSG: geometry_msgs/Quaternion
+# This represents an orientation in free space in quaternion form.
+
+float64 x
+float64 y
+float64 z
+float64 w
+`,
+		"nav_msgs/OccupancyGrid",
+		"3381f2d731d4076ec5c71b0759edbe4e",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgOccupancyGrid)
+}
+
+type OccupancyGrid struct {
+	Header std_msgs.Header `rosmsg:"header:Header"`
+	Info   MapMetaData     `rosmsg:"info:MapMetaData"`
+	Data   []int8          `rosmsg:"data:int8[]"`
+}
+
+func (m *OccupancyGrid) Type() ros.MessageType {
+	return MsgOccupancyGrid
+}
+
+func (m *OccupancyGrid) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *OccupancyGrid) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *OccupancyGrid) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *OccupancyGrid) Clone() *OccupancyGrid {
+	c := *m
+	c.Header = *m.Header.Clone()
+	c.Info = *m.Info.Clone()
+	if m.Data != nil {
+		c.Data = make([]int8, len(m.Data))
+		copy(c.Data, m.Data)
+	}
+	return &c
+}
+
+func (m *OccupancyGrid) Equal(other *OccupancyGrid) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if !m.Info.Equal(&other.Info) {
+		return false
+	}
+	if len(m.Data) != len(other.Data) {
+		return false
+	}
+	for i := range m.Data {
+		if m.Data[i] != other.Data[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *OccupancyGrid) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += m.Info.SerializedLength()
+	length += 4
+	length += 1 * len(m.Data)
+	return length
+}
+
+func (m *OccupancyGrid) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	if err := m.Info.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Data)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 1 * len(m.Data)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.Data {
+			data[1*i:][0] = uint8(e)
+		}
+		buf.Write(data)
+	}
+	return nil
+}
+
+func (m *OccupancyGrid) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	if err := m.Info.Deserialize(buf); err != nil {
+		return err
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*1 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Data = make([]int8, size)
+		data := make([]byte, 1*len(m.Data))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.Data {
+			m.Data[i] = int8(data[1*i:][0])
+		}
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+// Automatically generated from the message definition "nav_msgs/Path.msg"
+package nav_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/geometry_msgs"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgPath struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgPath) Text() string {
+	return t.text
+}
+
+func (t *_MsgPath) Name() string {
+	return t.name
+}
+
+func (t *_MsgPath) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgPath) NewMessage() ros.Message {
+	m := new(Path)
+	m.Header = std_msgs.Header{}
+	m.Poses = []geometry_msgs.PoseStamped{}
+	return m
+}
+
+var (
+	MsgPath = &_MsgPath{
+		`#An array of poses that represents a Path for a robot to follow
+Header header
+geometry_msgs/PoseStamped[] poses
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: geometry_msgs/PoseStamped
+# A Pose with reference coordinate frame and timestamp
+Header header
+Pose pose
+
+================================================================================
+MSG: geometry_msgs/Pose
+# A representation of pose in free space, composed of position and orientation. 
+Point position
+Quaternion orientation
+
+================================================================================
+MSG: geometry_msgs/Point
+# This contains the position of a point in free space
+float64 x
+float64 y
+float64 z
+
+================================================================================
+MSG: geometry_msgs/Quaternion
+# This represents an orientation in free space in quaternion form.
+
+float64 x
+float64 y
+float64 z
+float64 w
+`,
+		"nav_msgs/Path",
+		"6227e2b7e9cce15051f669a5e197bbf7",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgPath)
+}
+
+type Path struct {
+	Header std_msgs.Header             `rosmsg:"header:Header"`
+	Poses  []geometry_msgs.PoseStamped `rosmsg:"poses:PoseStamped[]"`
+}
+
+func (m *Path) Type() ros.MessageType {
+	return MsgPath
+}
+
+func (m *Path) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Path) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Path) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Path) Clone() *Path {
+	c := *m
+	c.Header = *m.Header.Clone()
+	if m.Poses != nil {
+		c.Poses = make([]geometry_msgs.PoseStamped, len(m.Poses))
+		for i := range m.Poses {
+			c.Poses[i] = *m.Poses[i].Clone()
+		}
+	}
+	return &c
+}
+
+func (m *Path) Equal(other *Path) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if len(m.Poses) != len(other.Poses) {
+		return false
+	}
+	for i := range m.Poses {
+		if !m.Poses[i].Equal(&other.Poses[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Path) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += 4
+	for i := range m.Poses {
+		length += m.Poses[i].SerializedLength()
+	}
+	return length
+}
+
+func (m *Path) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Poses)))
+	buf.Write(b[:4])
+	for i := range m.Poses {
+		if err := m.Poses[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Path) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*72 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Poses = make([]geometry_msgs.PoseStamped, size)
+		for i := range m.Poses {
+			if err := m.Poses[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+// Automatically generated from the message definition "nav_msgs/SetMap.srv"
+package nav_msgs
+
+import (
+	"github.com/akio/rosgo/ros"
+)
+
+// Service type metadata
+type _SrvSetMap struct {
+	name    string
+	md5sum  string
+	text    string
+	reqType ros.MessageType
+	resType ros.MessageType
+}
+
+func (t *_SrvSetMap) Name() string                  { return t.name }
+func (t *_SrvSetMap) MD5Sum() string                { return t.md5sum }
+func (t *_SrvSetMap) Text() string                  { return t.text }
+func (t *_SrvSetMap) RequestType() ros.MessageType  { return t.reqType }
+func (t *_SrvSetMap) ResponseType() ros.MessageType { return t.resType }
+func (t *_SrvSetMap) NewService() ros.Service {
+	return new(SetMap)
+}
+
+var (
+	SrvSetMap = &_SrvSetMap{
+		"nav_msgs/SetMap",
+		"c36922319011e63ed7784112ad4fdd32",
+		`# Set a new map together with an initial pose
+nav_msgs/OccupancyGrid map
+geometry_msgs/PoseWithCovarianceStamped initial_pose
+---
+bool success
+`,
+		MsgSetMapRequest,
+		MsgSetMapResponse,
+	}
+)
+
+func init() {
+	ros.RegisterServiceType(SrvSetMap)
+}
+
+type SetMap struct {
+	Request  SetMapRequest
+	Response SetMapResponse
+}
+
+func (s *SetMap) Type() ros.ServiceType   { return SrvSetMap }
+func (s *SetMap) ReqMessage() ros.Message { return &s.Request }
+func (s *SetMap) ResMessage() ros.Message { return &s.Response }
@@ -0,0 +1,201 @@
+// Automatically generated from the message definition "nav_msgs/SetMapRequest.msg"
+package nav_msgs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/msgs/geometry_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+type _MsgSetMapRequest struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgSetMapRequest) Text() string {
+	return t.text
+}
+
+func (t *_MsgSetMapRequest) Name() string {
+	return t.name
+}
+
+func (t *_MsgSetMapRequest) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgSetMapRequest) NewMessage() ros.Message {
+	m := new(SetMapRequest)
+	m.Map = OccupancyGrid{}
+	m.InitialPose = geometry_msgs.PoseWithCovarianceStamped{}
+	return m
+}
+
+var (
+	MsgSetMapRequest = &_MsgSetMapRequest{
+		`# Set a new map together with an initial pose
+nav_msgs/OccupancyGrid map
+geometry_msgs/PoseWithCovarianceStamped initial_pose
+
+================================================================================
+MSG: nav_msgs/OccupancyGrid
+# This represents a 2-D grid map, in which each cell represents the probability of
+# occupancy.
+
+Header header 
+
+#MetaData for the map
+MapMetaData info
+
+# The map data, in row-major order, starting with (0,0).  Occupancy
+# probabilities are in the range [0,100].  Unknown is -1.
+int8[] data
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: nav_msgs/MapMetaData
+# This hold basic information about the characterists of the OccupancyGrid
+
+# The time at which the map was loaded
+time map_load_time
+# The map resolution [m/cell]
+float32 resolution
+# Map width [cells]
+uint32 width
+# Map height [cells]
+uint32 height
+# The origin of the map [m, m, rad].  This is the real-world pose of the
+# cell (0,0) in the map.
+geometry_msgs/Pose origin
+
+================================================================================
+MSG: geometry_msgs/Pose
+# A representation of pose in free space, composed of position and orientation. 
+Point position
+Quaternion orientation
+
+================================================================================
+MSG: geometry_msgs/Point
+# This contains the position of a point in free space
+float64 x
+float64 y
+float64 z
+
+================================================================================
+MSG: geometry_msgs/Quaternion
+# This represents an orientation in free space in quaternion form.
+
+float64 x
+float64 y
+float64 z
+float64 w
+
+================================================================================
+MSG: geometry_msgs/PoseWithCovarianceStamped
+# This expresses an estimated pose with a reference coordinate frame and timestamp
+
+Header header
+PoseWithCovariance pose
+
+================================================================================
+MSG: geometry_msgs/PoseWithCovariance
+# This represents a pose in free space with uncertainty.
+
+Pose pose
+
+# Row-major representation of the 6x6 covariance matrix
+# The orientation parameters use a fixed-axis representation.
+# In order, the parameters are:
+# (x, y, z, rotation about X axis, rotation about Y axis, rotation about Z axis)
+float64[36] covariance
+`,
+		"nav_msgs/SetMapRequest",
+		"91149a20d7be299b87c340df8cc94fd4",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgSetMapRequest)
+}
+
+type SetMapRequest struct {
+	Map         OccupancyGrid                           `rosmsg:"map:OccupancyGrid"`
+	InitialPose geometry_msgs.PoseWithCovarianceStamped `rosmsg:"initial_pose:PoseWithCovarianceStamped"`
+}
+
+func (m *SetMapRequest) Type() ros.MessageType {
+	return MsgSetMapRequest
+}
+
+func (m *SetMapRequest) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *SetMapRequest) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *SetMapRequest) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *SetMapRequest) Clone() *SetMapRequest {
+	c := *m
+	c.Map = *m.Map.Clone()
+	c.InitialPose = *m.InitialPose.Clone()
+	return &c
+}
+
+func (m *SetMapRequest) Equal(other *SetMapRequest) bool {
+	if !m.Map.Equal(&other.Map) {
+		return false
+	}
+	if !m.InitialPose.Equal(&other.InitialPose) {
+		return false
+	}
+	return true
+}
+
+func (m *SetMapRequest) SerializedLength() int {
+	length := 0
+	length += m.Map.SerializedLength()
+	length += m.InitialPose.SerializedLength()
+	return length
+}
+
+func (m *SetMapRequest) Serialize(buf *bytes.Buffer) error {
+	if err := m.Map.Serialize(buf); err != nil {
+		return err
+	}
+	if err := m.InitialPose.Serialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *SetMapRequest) Deserialize(buf *bytes.Reader) error {
+	if err := m.Map.Deserialize(buf); err != nil {
+		return err
+	}
+	if err := m.InitialPose.Deserialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
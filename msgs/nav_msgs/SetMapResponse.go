@@ -0,0 +1,103 @@
+// Automatically generated from the message definition "nav_msgs/SetMapResponse.msg"
+package nav_msgs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgSetMapResponse struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgSetMapResponse) Text() string {
+	return t.text
+}
+
+func (t *_MsgSetMapResponse) Name() string {
+	return t.name
+}
+
+func (t *_MsgSetMapResponse) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgSetMapResponse) NewMessage() ros.Message {
+	m := new(SetMapResponse)
+	m.Success = false
+	return m
+}
+
+var (
+	MsgSetMapResponse = &_MsgSetMapResponse{
+		`
+bool success
+`,
+		"nav_msgs/SetMapResponse",
+		"358e233cde0c8a8bcfea4ce193f8fc15",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgSetMapResponse)
+}
+
+type SetMapResponse struct {
+	Success bool `rosmsg:"success:bool"`
+}
+
+func (m *SetMapResponse) Type() ros.MessageType {
+	return MsgSetMapResponse
+}
+
+func (m *SetMapResponse) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *SetMapResponse) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *SetMapResponse) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *SetMapResponse) Clone() *SetMapResponse {
+	c := *m
+	return &c
+}
+
+func (m *SetMapResponse) Equal(other *SetMapResponse) bool {
+	if m.Success != other.Success {
+		return false
+	}
+	return true
+}
+
+func (m *SetMapResponse) SerializedLength() int {
+	length := 0
+	length += 1
+	return length
+}
+
+func (m *SetMapResponse) Serialize(buf *bytes.Buffer) error {
+	if m.Success {
+		buf.WriteByte(1)
+	} else {
+		buf.WriteByte(0)
+	}
+	return nil
+}
+
+func (m *SetMapResponse) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.Success = b[:][0] != 0
+	return nil
+}
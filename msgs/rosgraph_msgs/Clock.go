@@ -0,0 +1,106 @@
+// Automatically generated from the message definition "rosgraph_msgs/Clock.msg"
+package rosgraph_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgClock struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgClock) Text() string {
+	return t.text
+}
+
+func (t *_MsgClock) Name() string {
+	return t.name
+}
+
+func (t *_MsgClock) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgClock) NewMessage() ros.Message {
+	m := new(Clock)
+	m.Clock = ros.Time{}
+	return m
+}
+
+var (
+	MsgClock = &_MsgClock{
+		`# roslib/Clock is used for publishing simulated time in ROS. 
+# This message simply communicates the current time.
+# For more information, see http://www.ros.org/wiki/Clock
+time clock
+`,
+		"rosgraph_msgs/Clock",
+		"a9c97c1d230cfc112e270351a944ee47",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgClock)
+}
+
+type Clock struct {
+	Clock ros.Time `rosmsg:"clock:time"`
+}
+
+func (m *Clock) Type() ros.MessageType {
+	return MsgClock
+}
+
+func (m *Clock) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Clock) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Clock) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Clock) Clone() *Clock {
+	c := *m
+	return &c
+}
+
+func (m *Clock) Equal(other *Clock) bool {
+	if m.Clock != other.Clock {
+		return false
+	}
+	return true
+}
+
+func (m *Clock) SerializedLength() int {
+	length := 0
+	length += 8
+	return length
+}
+
+func (m *Clock) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:], m.Clock.Sec)
+	binary.LittleEndian.PutUint32(b[4:], m.Clock.NSec)
+	buf.Write(b[:8])
+	return nil
+}
+
+func (m *Clock) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Clock.Sec = binary.LittleEndian.Uint32(b[:])
+	m.Clock.NSec = binary.LittleEndian.Uint32(b[4:])
+	return nil
+}
@@ -0,0 +1,323 @@
+// Automatically generated from the message definition "rosgraph_msgs/Log.msg"
+package rosgraph_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+const (
+	Log_DEBUG byte = 1
+	Log_INFO  byte = 2
+	Log_WARN  byte = 4
+	Log_ERROR byte = 8
+	Log_FATAL byte = 16
+)
+
+// Names of the constants before they were scoped by the message.
+const (
+	// Deprecated: Use Log_DEBUG.
+	DEBUG = Log_DEBUG
+	// Deprecated: Use Log_INFO.
+	INFO = Log_INFO
+	// Deprecated: Use Log_WARN.
+	WARN = Log_WARN
+	// Deprecated: Use Log_ERROR.
+	ERROR = Log_ERROR
+	// Deprecated: Use Log_FATAL.
+	FATAL = Log_FATAL
+)
+
+type _MsgLog struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgLog) Text() string {
+	return t.text
+}
+
+func (t *_MsgLog) Name() string {
+	return t.name
+}
+
+func (t *_MsgLog) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgLog) NewMessage() ros.Message {
+	m := new(Log)
+	m.Header = std_msgs.Header{}
+	m.Level = 0
+	m.Name = ""
+	m.Msg = ""
+	m.File = ""
+	m.Function = ""
+	m.Line = 0
+	m.Topics = []string{}
+	return m
+}
+
+var (
+	MsgLog = &_MsgLog{
+		`##
+## Severity level constants
+##
+byte DEBUG=1 #debug level
+byte INFO=2  #general level
+byte WARN=4  #warning level
+byte ERROR=8 #error level
+byte FATAL=16 #fatal/critical level
+##
+## Fields
+##
+Header header
+byte level
+string name # name of the node
+string msg # message 
+string file # file the message came from
+string function # function the message came from
+uint32 line # line the message came from
+string[] topics # topic names that the node publishes
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+`,
+		"rosgraph_msgs/Log",
+		"acffd30cd6b6de30f120938c17c593fb",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgLog)
+}
+
+type Log struct {
+	Header   std_msgs.Header `rosmsg:"header:Header"`
+	Level    uint8           `rosmsg:"level:byte"`
+	Name     string          `rosmsg:"name:string"`
+	Msg      string          `rosmsg:"msg:string"`
+	File     string          `rosmsg:"file:string"`
+	Function string          `rosmsg:"function:string"`
+	Line     uint32          `rosmsg:"line:uint32"`
+	Topics   []string        `rosmsg:"topics:string[]"`
+}
+
+func (m *Log) Type() ros.MessageType {
+	return MsgLog
+}
+
+func (m *Log) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Log) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Log) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Log) Clone() *Log {
+	c := *m
+	c.Header = *m.Header.Clone()
+	if m.Topics != nil {
+		c.Topics = make([]string, len(m.Topics))
+		copy(c.Topics, m.Topics)
+	}
+	return &c
+}
+
+func (m *Log) Equal(other *Log) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if m.Level != other.Level {
+		return false
+	}
+	if m.Name != other.Name {
+		return false
+	}
+	if m.Msg != other.Msg {
+		return false
+	}
+	if m.File != other.File {
+		return false
+	}
+	if m.Function != other.Function {
+		return false
+	}
+	if m.Line != other.Line {
+		return false
+	}
+	if len(m.Topics) != len(other.Topics) {
+		return false
+	}
+	for i := range m.Topics {
+		if m.Topics[i] != other.Topics[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Log) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += 1
+	length += 4 + len(m.Name)
+	length += 4 + len(m.Msg)
+	length += 4 + len(m.File)
+	length += 4 + len(m.Function)
+	length += 4
+	length += 4
+	for _, e := range m.Topics {
+		length += 4 + len(e)
+	}
+	return length
+}
+
+func (m *Log) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	buf.WriteByte(m.Level)
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Name)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Name)
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Msg)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Msg)
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.File)))
+	buf.Write(b[:4])
+	buf.WriteString(m.File)
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Function)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Function)
+	binary.LittleEndian.PutUint32(b[:], m.Line)
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Topics)))
+	buf.Write(b[:4])
+	for _, e := range m.Topics {
+		binary.LittleEndian.PutUint32(b[:4], uint32(len(e)))
+		buf.Write(b[:4])
+		buf.WriteString(e)
+	}
+	return nil
+}
+
+func (m *Log) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.Level = b[:][0]
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Name = string(data)
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Msg = string(data)
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.File = string(data)
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Function = string(data)
+	}
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Line = binary.LittleEndian.Uint32(b[:])
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*4 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Topics = make([]string, size)
+		for i := range m.Topics {
+			if _, err := io.ReadFull(buf, b[:4]); err != nil {
+				return err
+			}
+			n := int(binary.LittleEndian.Uint32(b[:4]))
+			if int64(n) > int64(buf.Len()) {
+				return io.ErrUnexpectedEOF
+			}
+			data := make([]byte, n)
+			if _, err := io.ReadFull(buf, data); err != nil {
+				return err
+			}
+			m.Topics[i] = string(data)
+		}
+	}
+	return nil
+}
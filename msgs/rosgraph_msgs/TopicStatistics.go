@@ -0,0 +1,337 @@
+// Automatically generated from the message definition "rosgraph_msgs/TopicStatistics.msg"
+package rosgraph_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgTopicStatistics struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgTopicStatistics) Text() string {
+	return t.text
+}
+
+func (t *_MsgTopicStatistics) Name() string {
+	return t.name
+}
+
+func (t *_MsgTopicStatistics) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgTopicStatistics) NewMessage() ros.Message {
+	m := new(TopicStatistics)
+	m.Topic = ""
+	m.NodePub = ""
+	m.NodeSub = ""
+	m.WindowStart = ros.Time{}
+	m.WindowStop = ros.Time{}
+	m.DeliveredMsgs = 0
+	m.DroppedMsgs = 0
+	m.Traffic = 0
+	m.PeriodMean = ros.Duration{}
+	m.PeriodStddev = ros.Duration{}
+	m.PeriodMax = ros.Duration{}
+	m.StampAgeMean = ros.Duration{}
+	m.StampAgeStddev = ros.Duration{}
+	m.StampAgeMax = ros.Duration{}
+	return m
+}
+
+var (
+	MsgTopicStatistics = &_MsgTopicStatistics{
+		`# name of the topic
+string topic
+
+# node id of the publisher
+string node_pub
+
+# node id of the subscriber
+string node_sub
+
+# the statistics apply to this time window
+time window_start
+time window_stop
+
+# number of messages delivered during the window
+int32 delivered_msgs
+# numbers of messages dropped during the window
+int32 dropped_msgs
+
+# traffic during the window, in bytes
+int32 traffic
+
+# mean/stddev/max period between two messages
+duration period_mean
+duration period_stddev
+duration period_max
+
+# mean/stddev/max age of the message based on the
+# timestamp in the message header. In case the
+# message does not have a header, it will be 0.
+duration stamp_age_mean
+duration stamp_age_stddev
+duration stamp_age_max
+`,
+		"rosgraph_msgs/TopicStatistics",
+		"10152ed868c5097a5e2e4a89d7daa710",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgTopicStatistics)
+}
+
+type TopicStatistics struct {
+	Topic          string       `rosmsg:"topic:string"`
+	NodePub        string       `rosmsg:"node_pub:string"`
+	NodeSub        string       `rosmsg:"node_sub:string"`
+	WindowStart    ros.Time     `rosmsg:"window_start:time"`
+	WindowStop     ros.Time     `rosmsg:"window_stop:time"`
+	DeliveredMsgs  int32        `rosmsg:"delivered_msgs:int32"`
+	DroppedMsgs    int32        `rosmsg:"dropped_msgs:int32"`
+	Traffic        int32        `rosmsg:"traffic:int32"`
+	PeriodMean     ros.Duration `rosmsg:"period_mean:duration"`
+	PeriodStddev   ros.Duration `rosmsg:"period_stddev:duration"`
+	PeriodMax      ros.Duration `rosmsg:"period_max:duration"`
+	StampAgeMean   ros.Duration `rosmsg:"stamp_age_mean:duration"`
+	StampAgeStddev ros.Duration `rosmsg:"stamp_age_stddev:duration"`
+	StampAgeMax    ros.Duration `rosmsg:"stamp_age_max:duration"`
+}
+
+func (m *TopicStatistics) Type() ros.MessageType {
+	return MsgTopicStatistics
+}
+
+func (m *TopicStatistics) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *TopicStatistics) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *TopicStatistics) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *TopicStatistics) Clone() *TopicStatistics {
+	c := *m
+	return &c
+}
+
+func (m *TopicStatistics) Equal(other *TopicStatistics) bool {
+	if m.Topic != other.Topic {
+		return false
+	}
+	if m.NodePub != other.NodePub {
+		return false
+	}
+	if m.NodeSub != other.NodeSub {
+		return false
+	}
+	if m.WindowStart != other.WindowStart {
+		return false
+	}
+	if m.WindowStop != other.WindowStop {
+		return false
+	}
+	if m.DeliveredMsgs != other.DeliveredMsgs {
+		return false
+	}
+	if m.DroppedMsgs != other.DroppedMsgs {
+		return false
+	}
+	if m.Traffic != other.Traffic {
+		return false
+	}
+	if m.PeriodMean != other.PeriodMean {
+		return false
+	}
+	if m.PeriodStddev != other.PeriodStddev {
+		return false
+	}
+	if m.PeriodMax != other.PeriodMax {
+		return false
+	}
+	if m.StampAgeMean != other.StampAgeMean {
+		return false
+	}
+	if m.StampAgeStddev != other.StampAgeStddev {
+		return false
+	}
+	if m.StampAgeMax != other.StampAgeMax {
+		return false
+	}
+	return true
+}
+
+func (m *TopicStatistics) SerializedLength() int {
+	length := 0
+	length += 4 + len(m.Topic)
+	length += 4 + len(m.NodePub)
+	length += 4 + len(m.NodeSub)
+	length += 8
+	length += 8
+	length += 4
+	length += 4
+	length += 4
+	length += 8
+	length += 8
+	length += 8
+	length += 8
+	length += 8
+	length += 8
+	return length
+}
+
+func (m *TopicStatistics) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Topic)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Topic)
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.NodePub)))
+	buf.Write(b[:4])
+	buf.WriteString(m.NodePub)
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.NodeSub)))
+	buf.Write(b[:4])
+	buf.WriteString(m.NodeSub)
+	binary.LittleEndian.PutUint32(b[:], m.WindowStart.Sec)
+	binary.LittleEndian.PutUint32(b[4:], m.WindowStart.NSec)
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint32(b[:], m.WindowStop.Sec)
+	binary.LittleEndian.PutUint32(b[4:], m.WindowStop.NSec)
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint32(b[:], uint32(m.DeliveredMsgs))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], uint32(m.DroppedMsgs))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], uint32(m.Traffic))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], m.PeriodMean.Sec)
+	binary.LittleEndian.PutUint32(b[4:], m.PeriodMean.NSec)
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint32(b[:], m.PeriodStddev.Sec)
+	binary.LittleEndian.PutUint32(b[4:], m.PeriodStddev.NSec)
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint32(b[:], m.PeriodMax.Sec)
+	binary.LittleEndian.PutUint32(b[4:], m.PeriodMax.NSec)
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint32(b[:], m.StampAgeMean.Sec)
+	binary.LittleEndian.PutUint32(b[4:], m.StampAgeMean.NSec)
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint32(b[:], m.StampAgeStddev.Sec)
+	binary.LittleEndian.PutUint32(b[4:], m.StampAgeStddev.NSec)
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint32(b[:], m.StampAgeMax.Sec)
+	binary.LittleEndian.PutUint32(b[4:], m.StampAgeMax.NSec)
+	buf.Write(b[:8])
+	return nil
+}
+
+func (m *TopicStatistics) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Topic = string(data)
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.NodePub = string(data)
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.NodeSub = string(data)
+	}
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.WindowStart.Sec = binary.LittleEndian.Uint32(b[:])
+	m.WindowStart.NSec = binary.LittleEndian.Uint32(b[4:])
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.WindowStop.Sec = binary.LittleEndian.Uint32(b[:])
+	m.WindowStop.NSec = binary.LittleEndian.Uint32(b[4:])
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.DeliveredMsgs = int32(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.DroppedMsgs = int32(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Traffic = int32(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.PeriodMean.Sec = binary.LittleEndian.Uint32(b[:])
+	m.PeriodMean.NSec = binary.LittleEndian.Uint32(b[4:])
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.PeriodStddev.Sec = binary.LittleEndian.Uint32(b[:])
+	m.PeriodStddev.NSec = binary.LittleEndian.Uint32(b[4:])
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.PeriodMax.Sec = binary.LittleEndian.Uint32(b[:])
+	m.PeriodMax.NSec = binary.LittleEndian.Uint32(b[4:])
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.StampAgeMean.Sec = binary.LittleEndian.Uint32(b[:])
+	m.StampAgeMean.NSec = binary.LittleEndian.Uint32(b[4:])
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.StampAgeStddev.Sec = binary.LittleEndian.Uint32(b[:])
+	m.StampAgeStddev.NSec = binary.LittleEndian.Uint32(b[4:])
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.StampAgeMax.Sec = binary.LittleEndian.Uint32(b[:])
+	m.StampAgeMax.NSec = binary.LittleEndian.Uint32(b[4:])
+	return nil
+}
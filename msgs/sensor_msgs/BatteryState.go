@@ -0,0 +1,510 @@
+// Automatically generated from the message definition "sensor_msgs/BatteryState.msg"
+package sensor_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+const (
+	BatteryState_POWER_SUPPLY_STATUS_UNKNOWN               uint8 = 0
+	BatteryState_POWER_SUPPLY_STATUS_CHARGING              uint8 = 1
+	BatteryState_POWER_SUPPLY_STATUS_DISCHARGING           uint8 = 2
+	BatteryState_POWER_SUPPLY_STATUS_NOT_CHARGING          uint8 = 3
+	BatteryState_POWER_SUPPLY_STATUS_FULL                  uint8 = 4
+	BatteryState_POWER_SUPPLY_HEALTH_UNKNOWN               uint8 = 0
+	BatteryState_POWER_SUPPLY_HEALTH_GOOD                  uint8 = 1
+	BatteryState_POWER_SUPPLY_HEALTH_OVERHEAT              uint8 = 2
+	BatteryState_POWER_SUPPLY_HEALTH_DEAD                  uint8 = 3
+	BatteryState_POWER_SUPPLY_HEALTH_OVERVOLTAGE           uint8 = 4
+	BatteryState_POWER_SUPPLY_HEALTH_UNSPEC_FAILURE        uint8 = 5
+	BatteryState_POWER_SUPPLY_HEALTH_COLD                  uint8 = 6
+	BatteryState_POWER_SUPPLY_HEALTH_WATCHDOG_TIMER_EXPIRE uint8 = 7
+	BatteryState_POWER_SUPPLY_HEALTH_SAFETY_TIMER_EXPIRE   uint8 = 8
+	BatteryState_POWER_SUPPLY_TECHNOLOGY_UNKNOWN           uint8 = 0
+	BatteryState_POWER_SUPPLY_TECHNOLOGY_NIMH              uint8 = 1
+	BatteryState_POWER_SUPPLY_TECHNOLOGY_LION              uint8 = 2
+	BatteryState_POWER_SUPPLY_TECHNOLOGY_LIPO              uint8 = 3
+	BatteryState_POWER_SUPPLY_TECHNOLOGY_LIFE              uint8 = 4
+	BatteryState_POWER_SUPPLY_TECHNOLOGY_NICD              uint8 = 5
+	BatteryState_POWER_SUPPLY_TECHNOLOGY_LIMN              uint8 = 6
+)
+
+// Names of the constants before they were scoped by the message.
+const (
+	// Deprecated: Use BatteryState_POWER_SUPPLY_STATUS_UNKNOWN.
+	POWERSUPPLYSTATUSUNKNOWN = BatteryState_POWER_SUPPLY_STATUS_UNKNOWN
+	// Deprecated: Use BatteryState_POWER_SUPPLY_STATUS_CHARGING.
+	POWERSUPPLYSTATUSCHARGING = BatteryState_POWER_SUPPLY_STATUS_CHARGING
+	// Deprecated: Use BatteryState_POWER_SUPPLY_STATUS_DISCHARGING.
+	POWERSUPPLYSTATUSDISCHARGING = BatteryState_POWER_SUPPLY_STATUS_DISCHARGING
+	// Deprecated: Use BatteryState_POWER_SUPPLY_STATUS_NOT_CHARGING.
+	POWERSUPPLYSTATUSNOTCHARGING = BatteryState_POWER_SUPPLY_STATUS_NOT_CHARGING
+	// Deprecated: Use BatteryState_POWER_SUPPLY_STATUS_FULL.
+	POWERSUPPLYSTATUSFULL = BatteryState_POWER_SUPPLY_STATUS_FULL
+	// Deprecated: Use BatteryState_POWER_SUPPLY_HEALTH_UNKNOWN.
+	POWERSUPPLYHEALTHUNKNOWN = BatteryState_POWER_SUPPLY_HEALTH_UNKNOWN
+	// Deprecated: Use BatteryState_POWER_SUPPLY_HEALTH_GOOD.
+	POWERSUPPLYHEALTHGOOD = BatteryState_POWER_SUPPLY_HEALTH_GOOD
+	// Deprecated: Use BatteryState_POWER_SUPPLY_HEALTH_OVERHEAT.
+	POWERSUPPLYHEALTHOVERHEAT = BatteryState_POWER_SUPPLY_HEALTH_OVERHEAT
+	// Deprecated: Use BatteryState_POWER_SUPPLY_HEALTH_DEAD.
+	POWERSUPPLYHEALTHDEAD = BatteryState_POWER_SUPPLY_HEALTH_DEAD
+	// Deprecated: Use BatteryState_POWER_SUPPLY_HEALTH_OVERVOLTAGE.
+	POWERSUPPLYHEALTHOVERVOLTAGE = BatteryState_POWER_SUPPLY_HEALTH_OVERVOLTAGE
+	// Deprecated: Use BatteryState_POWER_SUPPLY_HEALTH_UNSPEC_FAILURE.
+	POWERSUPPLYHEALTHUNSPECFAILURE = BatteryState_POWER_SUPPLY_HEALTH_UNSPEC_FAILURE
+	// Deprecated: Use BatteryState_POWER_SUPPLY_HEALTH_COLD.
+	POWERSUPPLYHEALTHCOLD = BatteryState_POWER_SUPPLY_HEALTH_COLD
+	// Deprecated: Use BatteryState_POWER_SUPPLY_HEALTH_WATCHDOG_TIMER_EXPIRE.
+	POWERSUPPLYHEALTHWATCHDOGTIMEREXPIRE = BatteryState_POWER_SUPPLY_HEALTH_WATCHDOG_TIMER_EXPIRE
+	// Deprecated: Use BatteryState_POWER_SUPPLY_HEALTH_SAFETY_TIMER_EXPIRE.
+	POWERSUPPLYHEALTHSAFETYTIMEREXPIRE = BatteryState_POWER_SUPPLY_HEALTH_SAFETY_TIMER_EXPIRE
+	// Deprecated: Use BatteryState_POWER_SUPPLY_TECHNOLOGY_UNKNOWN.
+	POWERSUPPLYTECHNOLOGYUNKNOWN = BatteryState_POWER_SUPPLY_TECHNOLOGY_UNKNOWN
+	// Deprecated: Use BatteryState_POWER_SUPPLY_TECHNOLOGY_NIMH.
+	POWERSUPPLYTECHNOLOGYNIMH = BatteryState_POWER_SUPPLY_TECHNOLOGY_NIMH
+	// Deprecated: Use BatteryState_POWER_SUPPLY_TECHNOLOGY_LION.
+	POWERSUPPLYTECHNOLOGYLION = BatteryState_POWER_SUPPLY_TECHNOLOGY_LION
+	// Deprecated: Use BatteryState_POWER_SUPPLY_TECHNOLOGY_LIPO.
+	POWERSUPPLYTECHNOLOGYLIPO = BatteryState_POWER_SUPPLY_TECHNOLOGY_LIPO
+	// Deprecated: Use BatteryState_POWER_SUPPLY_TECHNOLOGY_LIFE.
+	POWERSUPPLYTECHNOLOGYLIFE = BatteryState_POWER_SUPPLY_TECHNOLOGY_LIFE
+	// Deprecated: Use BatteryState_POWER_SUPPLY_TECHNOLOGY_NICD.
+	POWERSUPPLYTECHNOLOGYNICD = BatteryState_POWER_SUPPLY_TECHNOLOGY_NICD
+	// Deprecated: Use BatteryState_POWER_SUPPLY_TECHNOLOGY_LIMN.
+	POWERSUPPLYTECHNOLOGYLIMN = BatteryState_POWER_SUPPLY_TECHNOLOGY_LIMN
+)
+
+type _MsgBatteryState struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgBatteryState) Text() string {
+	return t.text
+}
+
+func (t *_MsgBatteryState) Name() string {
+	return t.name
+}
+
+func (t *_MsgBatteryState) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgBatteryState) NewMessage() ros.Message {
+	m := new(BatteryState)
+	m.Header = std_msgs.Header{}
+	m.Voltage = 0.0
+	m.Temperature = 0.0
+	m.Current = 0.0
+	m.Charge = 0.0
+	m.Capacity = 0.0
+	m.DesignCapacity = 0.0
+	m.Percentage = 0.0
+	m.PowerSupplyStatus = 0
+	m.PowerSupplyHealth = 0
+	m.PowerSupplyTechnology = 0
+	m.Present = false
+	m.CellVoltage = []float32{}
+	m.CellTemperature = []float32{}
+	m.Location = ""
+	m.SerialNumber = ""
+	return m
+}
+
+var (
+	MsgBatteryState = &_MsgBatteryState{
+		`# Constants are chosen to match the enums in the linux kernel
+# defined in include/linux/power_supply.h as of version 3.7
+# The one difference is for style reasons the constants are
+# all uppercase not mixed case.
+
+# Power supply status constants
+uint8 POWER_SUPPLY_STATUS_UNKNOWN = 0
+uint8 POWER_SUPPLY_STATUS_CHARGING = 1
+uint8 POWER_SUPPLY_STATUS_DISCHARGING = 2
+uint8 POWER_SUPPLY_STATUS_NOT_CHARGING = 3
+uint8 POWER_SUPPLY_STATUS_FULL = 4
+
+# Power supply health constants
+uint8 POWER_SUPPLY_HEALTH_UNKNOWN = 0
+uint8 POWER_SUPPLY_HEALTH_GOOD = 1
+uint8 POWER_SUPPLY_HEALTH_OVERHEAT = 2
+uint8 POWER_SUPPLY_HEALTH_DEAD = 3
+uint8 POWER_SUPPLY_HEALTH_OVERVOLTAGE = 4
+uint8 POWER_SUPPLY_HEALTH_UNSPEC_FAILURE = 5
+uint8 POWER_SUPPLY_HEALTH_COLD = 6
+uint8 POWER_SUPPLY_HEALTH_WATCHDOG_TIMER_EXPIRE = 7
+uint8 POWER_SUPPLY_HEALTH_SAFETY_TIMER_EXPIRE = 8
+
+# Power supply technology (chemistry) constants
+uint8 POWER_SUPPLY_TECHNOLOGY_UNKNOWN = 0
+uint8 POWER_SUPPLY_TECHNOLOGY_NIMH = 1
+uint8 POWER_SUPPLY_TECHNOLOGY_LION = 2
+uint8 POWER_SUPPLY_TECHNOLOGY_LIPO = 3
+uint8 POWER_SUPPLY_TECHNOLOGY_LIFE = 4
+uint8 POWER_SUPPLY_TECHNOLOGY_NICD = 5
+uint8 POWER_SUPPLY_TECHNOLOGY_LIMN = 6
+
+Header  header
+float32 voltage          # Voltage in Volts (Mandatory)
+float32 temperature      # Temperature in Degrees Celsius (If unmeasured NaN)
+float32 current          # Negative when discharging (A)  (If unmeasured NaN)
+float32 charge           # Current charge in Ah  (If unmeasured NaN)
+float32 capacity         # Capacity in Ah (last full capacity)  (If unmeasured NaN)
+float32 design_capacity  # Capacity in Ah (design capacity)  (If unmeasured NaN)
+float32 percentage       # Charge percentage on 0 to 1 range  (If unmeasured NaN)
+uint8   power_supply_status     # The charging status as reported. Values defined above
+uint8   power_supply_health     # The battery health metric. Values defined above
+uint8   power_supply_technology # The battery chemistry. Values defined above
+bool    present          # True if the battery is present
+
+float32[] cell_voltage   # An array of individual cell voltages for each cell in the pack
+                         # If individual voltages unknown but number of cells known set each to NaN
+float32[] cell_temperature # An array of individual cell temperatures for each cell in the pack
+                           # If individual temperatures unknown but number of cells known set each to NaN
+string location          # The location into which the battery is inserted. (slot number or plug)
+string serial_number     # The best approximation of the battery serial number
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+`,
+		"sensor_msgs/BatteryState",
+		"4ddae7f048e32fda22cac764685e3974",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgBatteryState)
+}
+
+type BatteryState struct {
+	Header                std_msgs.Header `rosmsg:"header:Header"`
+	Voltage               float32         `rosmsg:"voltage:float32"`
+	Temperature           float32         `rosmsg:"temperature:float32"`
+	Current               float32         `rosmsg:"current:float32"`
+	Charge                float32         `rosmsg:"charge:float32"`
+	Capacity              float32         `rosmsg:"capacity:float32"`
+	DesignCapacity        float32         `rosmsg:"design_capacity:float32"`
+	Percentage            float32         `rosmsg:"percentage:float32"`
+	PowerSupplyStatus     uint8           `rosmsg:"power_supply_status:uint8"`
+	PowerSupplyHealth     uint8           `rosmsg:"power_supply_health:uint8"`
+	PowerSupplyTechnology uint8           `rosmsg:"power_supply_technology:uint8"`
+	Present               bool            `rosmsg:"present:bool"`
+	CellVoltage           []float32       `rosmsg:"cell_voltage:float32[]"`
+	CellTemperature       []float32       `rosmsg:"cell_temperature:float32[]"`
+	Location              string          `rosmsg:"location:string"`
+	SerialNumber          string          `rosmsg:"serial_number:string"`
+}
+
+func (m *BatteryState) Type() ros.MessageType {
+	return MsgBatteryState
+}
+
+func (m *BatteryState) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *BatteryState) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *BatteryState) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *BatteryState) Clone() *BatteryState {
+	c := *m
+	c.Header = *m.Header.Clone()
+	if m.CellVoltage != nil {
+		c.CellVoltage = make([]float32, len(m.CellVoltage))
+		copy(c.CellVoltage, m.CellVoltage)
+	}
+	if m.CellTemperature != nil {
+		c.CellTemperature = make([]float32, len(m.CellTemperature))
+		copy(c.CellTemperature, m.CellTemperature)
+	}
+	return &c
+}
+
+func (m *BatteryState) Equal(other *BatteryState) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if m.Voltage != other.Voltage {
+		return false
+	}
+	if m.Temperature != other.Temperature {
+		return false
+	}
+	if m.Current != other.Current {
+		return false
+	}
+	if m.Charge != other.Charge {
+		return false
+	}
+	if m.Capacity != other.Capacity {
+		return false
+	}
+	if m.DesignCapacity != other.DesignCapacity {
+		return false
+	}
+	if m.Percentage != other.Percentage {
+		return false
+	}
+	if m.PowerSupplyStatus != other.PowerSupplyStatus {
+		return false
+	}
+	if m.PowerSupplyHealth != other.PowerSupplyHealth {
+		return false
+	}
+	if m.PowerSupplyTechnology != other.PowerSupplyTechnology {
+		return false
+	}
+	if m.Present != other.Present {
+		return false
+	}
+	if len(m.CellVoltage) != len(other.CellVoltage) {
+		return false
+	}
+	for i := range m.CellVoltage {
+		if m.CellVoltage[i] != other.CellVoltage[i] {
+			return false
+		}
+	}
+	if len(m.CellTemperature) != len(other.CellTemperature) {
+		return false
+	}
+	for i := range m.CellTemperature {
+		if m.CellTemperature[i] != other.CellTemperature[i] {
+			return false
+		}
+	}
+	if m.Location != other.Location {
+		return false
+	}
+	if m.SerialNumber != other.SerialNumber {
+		return false
+	}
+	return true
+}
+
+func (m *BatteryState) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += 4
+	length += 4
+	length += 4
+	length += 4
+	length += 4
+	length += 4
+	length += 4
+	length += 1
+	length += 1
+	length += 1
+	length += 1
+	length += 4
+	length += 4 * len(m.CellVoltage)
+	length += 4
+	length += 4 * len(m.CellTemperature)
+	length += 4 + len(m.Location)
+	length += 4 + len(m.SerialNumber)
+	return length
+}
+
+func (m *BatteryState) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.Voltage))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.Temperature))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.Current))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.Charge))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.Capacity))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.DesignCapacity))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.Percentage))
+	buf.Write(b[:4])
+	buf.WriteByte(m.PowerSupplyStatus)
+	buf.WriteByte(m.PowerSupplyHealth)
+	buf.WriteByte(m.PowerSupplyTechnology)
+	if m.Present {
+		buf.WriteByte(1)
+	} else {
+		buf.WriteByte(0)
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.CellVoltage)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 4 * len(m.CellVoltage)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.CellVoltage {
+			binary.LittleEndian.PutUint32(data[4*i:], math.Float32bits(e))
+		}
+		buf.Write(data)
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.CellTemperature)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 4 * len(m.CellTemperature)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.CellTemperature {
+			binary.LittleEndian.PutUint32(data[4*i:], math.Float32bits(e))
+		}
+		buf.Write(data)
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Location)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Location)
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.SerialNumber)))
+	buf.Write(b[:4])
+	buf.WriteString(m.SerialNumber)
+	return nil
+}
+
+func (m *BatteryState) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Voltage = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Temperature = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Current = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Charge = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Capacity = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.DesignCapacity = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Percentage = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.PowerSupplyStatus = b[:][0]
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.PowerSupplyHealth = b[:][0]
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.PowerSupplyTechnology = b[:][0]
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.Present = b[:][0] != 0
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*4 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.CellVoltage = make([]float32, size)
+		data := make([]byte, 4*len(m.CellVoltage))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.CellVoltage {
+			m.CellVoltage[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[4*i:]))
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*4 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.CellTemperature = make([]float32, size)
+		data := make([]byte, 4*len(m.CellTemperature))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.CellTemperature {
+			m.CellTemperature[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[4*i:]))
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Location = string(data)
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.SerialNumber = string(data)
+	}
+	return nil
+}
@@ -0,0 +1,389 @@
+// Automatically generated from the message definition "sensor_msgs/CameraInfo.msg"
+package sensor_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgCameraInfo struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgCameraInfo) Text() string {
+	return t.text
+}
+
+func (t *_MsgCameraInfo) Name() string {
+	return t.name
+}
+
+func (t *_MsgCameraInfo) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgCameraInfo) NewMessage() ros.Message {
+	m := new(CameraInfo)
+	m.Header = std_msgs.Header{}
+	m.Height = 0
+	m.Width = 0
+	m.DistortionModel = ""
+	m.D = []float64{}
+	for i := 0; i < 9; i++ {
+		m.K[i] = 0.0
+	}
+	for i := 0; i < 9; i++ {
+		m.R[i] = 0.0
+	}
+	for i := 0; i < 12; i++ {
+		m.P[i] = 0.0
+	}
+	m.BinningX = 0
+	m.BinningY = 0
+	m.Roi = RegionOfInterest{}
+	return m
+}
+
+var (
+	MsgCameraInfo = &_MsgCameraInfo{
+		`# This message defines meta information for a camera. It should be in a
+# camera namespace on topic "camera_info" and accompanied by up to five
+# image topics named:
+#
+#   image_raw - raw data from the camera driver, possibly Bayer encoded
+#   image            - monochrome, distorted
+#   image_color      - color, distorted
+#   image_rect       - monochrome, rectified
+#   image_rect_color - color, rectified
+#
+# See the image_proc and camera_calibration packages for the full
+# description of the fields.
+
+Header header    # Header timestamp should be acquisition time of image
+                 # Header frame_id should be optical frame of camera
+
+uint32 height    # The image dimensions with which the camera was calibrated.
+uint32 width
+
+string distortion_model # The distortion model used. Supported models are
+                        # listed in sensor_msgs/distortion_models.h.
+
+float64[] D      # The distortion parameters, size depending on the distortion model.
+
+float64[9]  K    # 3x3 row-major matrix: intrinsic camera matrix for the raw images.
+
+float64[9]  R    # 3x3 row-major matrix: rectification matrix (stereo cameras only)
+
+float64[12] P    # 3x4 row-major matrix: projection/camera matrix
+
+uint32 binning_x # Binning refers to any camera setting which combines
+uint32 binning_y # rectangular neighborhoods of pixels into larger "super-pixels."
+
+RegionOfInterest roi # Region of interest (subwindow of full camera resolution)
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: sensor_msgs/RegionOfInterest
+# This message is used to specify a region of interest within an image.
+#
+# When used to specify the ROI setting of the camera when the image was
+# taken, the height and width fields should either match the height and
+# width fields for the associated image; or height = width = 0
+# indicates that the full resolution image was captured.
+
+uint32 x_offset  # Leftmost pixel of the ROI
+                 # (0 if the ROI includes the left edge of the image)
+uint32 y_offset  # Topmost pixel of the ROI
+                 # (0 if the ROI includes the top edge of the image)
+uint32 height    # Height of ROI
+uint32 width     # Width of ROI
+
+# True if a distinct rectified ROI should be calculated from the "raw"
+# ROI in this message. Typically this should be False if the full image
+# is captured (ROI not used), and True if a subwindow is captured (ROI
+# used).
+bool do_rectify
+`,
+		"sensor_msgs/CameraInfo",
+		"c9a58c1b0b154e0e6da7578cb991d214",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgCameraInfo)
+}
+
+type CameraInfo struct {
+	Header          std_msgs.Header  `rosmsg:"header:Header"`
+	Height          uint32           `rosmsg:"height:uint32"`
+	Width           uint32           `rosmsg:"width:uint32"`
+	DistortionModel string           `rosmsg:"distortion_model:string"`
+	D               []float64        `rosmsg:"D:float64[]"`
+	K               [9]float64       `rosmsg:"K:float64[9]"`
+	R               [9]float64       `rosmsg:"R:float64[9]"`
+	P               [12]float64      `rosmsg:"P:float64[12]"`
+	BinningX        uint32           `rosmsg:"binning_x:uint32"`
+	BinningY        uint32           `rosmsg:"binning_y:uint32"`
+	Roi             RegionOfInterest `rosmsg:"roi:RegionOfInterest"`
+}
+
+func (m *CameraInfo) Type() ros.MessageType {
+	return MsgCameraInfo
+}
+
+func (m *CameraInfo) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *CameraInfo) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *CameraInfo) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *CameraInfo) Clone() *CameraInfo {
+	c := *m
+	c.Header = *m.Header.Clone()
+	if m.D != nil {
+		c.D = make([]float64, len(m.D))
+		copy(c.D, m.D)
+	}
+	c.Roi = *m.Roi.Clone()
+	return &c
+}
+
+func (m *CameraInfo) Equal(other *CameraInfo) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if m.Height != other.Height {
+		return false
+	}
+	if m.Width != other.Width {
+		return false
+	}
+	if m.DistortionModel != other.DistortionModel {
+		return false
+	}
+	if len(m.D) != len(other.D) {
+		return false
+	}
+	for i := range m.D {
+		if m.D[i] != other.D[i] {
+			return false
+		}
+	}
+	if m.K != other.K {
+		return false
+	}
+	if m.R != other.R {
+		return false
+	}
+	if m.P != other.P {
+		return false
+	}
+	if m.BinningX != other.BinningX {
+		return false
+	}
+	if m.BinningY != other.BinningY {
+		return false
+	}
+	if !m.Roi.Equal(&other.Roi) {
+		return false
+	}
+	return true
+}
+
+func (m *CameraInfo) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += 4
+	length += 4
+	length += 4 + len(m.DistortionModel)
+	length += 4
+	length += 8 * len(m.D)
+	length += 8 * len(m.K)
+	length += 8 * len(m.R)
+	length += 8 * len(m.P)
+	length += 4
+	length += 4
+	length += m.Roi.SerializedLength()
+	return length
+}
+
+func (m *CameraInfo) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b[:], m.Height)
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], m.Width)
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.DistortionModel)))
+	buf.Write(b[:4])
+	buf.WriteString(m.DistortionModel)
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.D)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 8 * len(m.D)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.D {
+			binary.LittleEndian.PutUint64(data[8*i:], math.Float64bits(e))
+		}
+		buf.Write(data)
+	}
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 8 * len(m.K)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.K {
+			binary.LittleEndian.PutUint64(data[8*i:], math.Float64bits(e))
+		}
+		buf.Write(data)
+	}
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 8 * len(m.R)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.R {
+			binary.LittleEndian.PutUint64(data[8*i:], math.Float64bits(e))
+		}
+		buf.Write(data)
+	}
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 8 * len(m.P)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.P {
+			binary.LittleEndian.PutUint64(data[8*i:], math.Float64bits(e))
+		}
+		buf.Write(data)
+	}
+	binary.LittleEndian.PutUint32(b[:], m.BinningX)
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], m.BinningY)
+	buf.Write(b[:4])
+	if err := m.Roi.Serialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *CameraInfo) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Height = binary.LittleEndian.Uint32(b[:])
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Width = binary.LittleEndian.Uint32(b[:])
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.DistortionModel = string(data)
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*8 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.D = make([]float64, size)
+		data := make([]byte, 8*len(m.D))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.D {
+			m.D[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[8*i:]))
+		}
+	}
+	{
+		data := make([]byte, 8*len(m.K))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.K {
+			m.K[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[8*i:]))
+		}
+	}
+	{
+		data := make([]byte, 8*len(m.R))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.R {
+			m.R[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[8*i:]))
+		}
+	}
+	{
+		data := make([]byte, 8*len(m.P))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.P {
+			m.P[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[8*i:]))
+		}
+	}
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.BinningX = binary.LittleEndian.Uint32(b[:])
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.BinningY = binary.LittleEndian.Uint32(b[:])
+	if err := m.Roi.Deserialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+// Automatically generated from the message definition "sensor_msgs/ChannelFloat32.msg"
+package sensor_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgChannelFloat32 struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgChannelFloat32) Text() string {
+	return t.text
+}
+
+func (t *_MsgChannelFloat32) Name() string {
+	return t.name
+}
+
+func (t *_MsgChannelFloat32) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgChannelFloat32) NewMessage() ros.Message {
+	m := new(ChannelFloat32)
+	m.Name = ""
+	m.Values = []float32{}
+	return m
+}
+
+var (
+	MsgChannelFloat32 = &_MsgChannelFloat32{
+		`# This message is used by the PointCloud message to hold optional data
+# associated with each point in the cloud. The length of the values
+# array should be the same as the length of the points array in the
+# PointCloud, and each value should be associated with the corresponding
+# point.
+
+string name
+float32[] values
+`,
+		"sensor_msgs/ChannelFloat32",
+		"3d40139cdd33dfedcb71ffeeeb42ae7f",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgChannelFloat32)
+}
+
+type ChannelFloat32 struct {
+	Name   string    `rosmsg:"name:string"`
+	Values []float32 `rosmsg:"values:float32[]"`
+}
+
+func (m *ChannelFloat32) Type() ros.MessageType {
+	return MsgChannelFloat32
+}
+
+func (m *ChannelFloat32) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *ChannelFloat32) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *ChannelFloat32) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *ChannelFloat32) Clone() *ChannelFloat32 {
+	c := *m
+	if m.Values != nil {
+		c.Values = make([]float32, len(m.Values))
+		copy(c.Values, m.Values)
+	}
+	return &c
+}
+
+func (m *ChannelFloat32) Equal(other *ChannelFloat32) bool {
+	if m.Name != other.Name {
+		return false
+	}
+	if len(m.Values) != len(other.Values) {
+		return false
+	}
+	for i := range m.Values {
+		if m.Values[i] != other.Values[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *ChannelFloat32) SerializedLength() int {
+	length := 0
+	length += 4 + len(m.Name)
+	length += 4
+	length += 4 * len(m.Values)
+	return length
+}
+
+func (m *ChannelFloat32) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Name)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Name)
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Values)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 4 * len(m.Values)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.Values {
+			binary.LittleEndian.PutUint32(data[4*i:], math.Float32bits(e))
+		}
+		buf.Write(data)
+	}
+	return nil
+}
+
+func (m *ChannelFloat32) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Name = string(data)
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*4 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Values = make([]float32, size)
+		data := make([]byte, 4*len(m.Values))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.Values {
+			m.Values[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[4*i:]))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+// Automatically generated from the message definition "sensor_msgs/CompressedImage.msg"
+package sensor_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgCompressedImage struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgCompressedImage) Text() string {
+	return t.text
+}
+
+func (t *_MsgCompressedImage) Name() string {
+	return t.name
+}
+
+func (t *_MsgCompressedImage) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgCompressedImage) NewMessage() ros.Message {
+	m := new(CompressedImage)
+	m.Header = std_msgs.Header{}
+	m.Format = ""
+	m.Data = []uint8{}
+	return m
+}
+
+var (
+	MsgCompressedImage = &_MsgCompressedImage{
+		`# This message contains a compressed image
+
+Header header        # Header timestamp should be acquisition time of image
+                     # Header frame_id should be optical frame of camera
+
+string format        # Specifies the format of the data
+                     #   Acceptable values:
+                     #     jpeg, png
+
+uint8[] data         # Compressed image buffer
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+`,
+		"sensor_msgs/CompressedImage",
+		"8f7a12909da2c9d3332d540a0977563f",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgCompressedImage)
+}
+
+type CompressedImage struct {
+	Header std_msgs.Header `rosmsg:"header:Header"`
+	Format string          `rosmsg:"format:string"`
+	Data   []uint8         `rosmsg:"data:uint8[]"`
+}
+
+func (m *CompressedImage) Type() ros.MessageType {
+	return MsgCompressedImage
+}
+
+func (m *CompressedImage) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *CompressedImage) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *CompressedImage) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *CompressedImage) Clone() *CompressedImage {
+	c := *m
+	c.Header = *m.Header.Clone()
+	if m.Data != nil {
+		c.Data = make([]uint8, len(m.Data))
+		copy(c.Data, m.Data)
+	}
+	return &c
+}
+
+func (m *CompressedImage) Equal(other *CompressedImage) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if m.Format != other.Format {
+		return false
+	}
+	if !bytes.Equal(m.Data, other.Data) {
+		return false
+	}
+	return true
+}
+
+func (m *CompressedImage) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += 4 + len(m.Format)
+	length += 4
+	length += 1 * len(m.Data)
+	return length
+}
+
+func (m *CompressedImage) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Format)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Format)
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Data)))
+	buf.Write(b[:4])
+	buf.Write(m.Data[:])
+	return nil
+}
+
+func (m *CompressedImage) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Format = string(data)
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*1 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Data = make([]uint8, size)
+		if _, err := io.ReadFull(buf, m.Data[:]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
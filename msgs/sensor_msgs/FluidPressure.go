@@ -0,0 +1,153 @@
+// Automatically generated from the message definition "sensor_msgs/FluidPressure.msg"
+package sensor_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgFluidPressure struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgFluidPressure) Text() string {
+	return t.text
+}
+
+func (t *_MsgFluidPressure) Name() string {
+	return t.name
+}
+
+func (t *_MsgFluidPressure) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgFluidPressure) NewMessage() ros.Message {
+	m := new(FluidPressure)
+	m.Header = std_msgs.Header{}
+	m.FluidPressure = 0.0
+	m.Variance = 0.0
+	return m
+}
+
+var (
+	MsgFluidPressure = &_MsgFluidPressure{
+		` # Single pressure reading.  This message is appropriate for measuring the
+ # pressure inside of a fluid (air, water, etc).  This also includes
+ # atmospheric or barometric pressure.
+
+ Header header           # timestamp of the measurement
+                         # frame_id is the location of the pressure sensor
+
+ float64 fluid_pressure  # Absolute pressure reading in Pascals.
+
+ float64 variance        # 0 is interpreted as variance unknown
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+`,
+		"sensor_msgs/FluidPressure",
+		"804dc5cea1c5306d6a2eb80b9833befe",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgFluidPressure)
+}
+
+type FluidPressure struct {
+	Header        std_msgs.Header `rosmsg:"header:Header"`
+	FluidPressure float64         `rosmsg:"fluid_pressure:float64"`
+	Variance      float64         `rosmsg:"variance:float64"`
+}
+
+func (m *FluidPressure) Type() ros.MessageType {
+	return MsgFluidPressure
+}
+
+func (m *FluidPressure) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *FluidPressure) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *FluidPressure) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *FluidPressure) Clone() *FluidPressure {
+	c := *m
+	c.Header = *m.Header.Clone()
+	return &c
+}
+
+func (m *FluidPressure) Equal(other *FluidPressure) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if m.FluidPressure != other.FluidPressure {
+		return false
+	}
+	if m.Variance != other.Variance {
+		return false
+	}
+	return true
+}
+
+func (m *FluidPressure) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += 8
+	length += 8
+	return length
+}
+
+func (m *FluidPressure) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.FluidPressure))
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.Variance))
+	buf.Write(b[:8])
+	return nil
+}
+
+func (m *FluidPressure) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.FluidPressure = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Variance = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	return nil
+}
@@ -0,0 +1,151 @@
+// Automatically generated from the message definition "sensor_msgs/Illuminance.msg"
+package sensor_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgIlluminance struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgIlluminance) Text() string {
+	return t.text
+}
+
+func (t *_MsgIlluminance) Name() string {
+	return t.name
+}
+
+func (t *_MsgIlluminance) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgIlluminance) NewMessage() ros.Message {
+	m := new(Illuminance)
+	m.Header = std_msgs.Header{}
+	m.Illuminance = 0.0
+	m.Variance = 0.0
+	return m
+}
+
+var (
+	MsgIlluminance = &_MsgIlluminance{
+		` # Single photometric illuminance measurement in Lux.
+
+ Header header           # timestamp is the time the illuminance was measured
+                         # frame_id is the location and direction of the reading
+
+ float64 illuminance     # Measurement of the Photometric Illuminance in Lux.
+
+ float64 variance        # 0 is interpreted as variance unknown
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+`,
+		"sensor_msgs/Illuminance",
+		"8cf5febb0952fca9d650c3d11a81a188",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgIlluminance)
+}
+
+type Illuminance struct {
+	Header      std_msgs.Header `rosmsg:"header:Header"`
+	Illuminance float64         `rosmsg:"illuminance:float64"`
+	Variance    float64         `rosmsg:"variance:float64"`
+}
+
+func (m *Illuminance) Type() ros.MessageType {
+	return MsgIlluminance
+}
+
+func (m *Illuminance) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Illuminance) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Illuminance) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Illuminance) Clone() *Illuminance {
+	c := *m
+	c.Header = *m.Header.Clone()
+	return &c
+}
+
+func (m *Illuminance) Equal(other *Illuminance) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if m.Illuminance != other.Illuminance {
+		return false
+	}
+	if m.Variance != other.Variance {
+		return false
+	}
+	return true
+}
+
+func (m *Illuminance) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += 8
+	length += 8
+	return length
+}
+
+func (m *Illuminance) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.Illuminance))
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.Variance))
+	buf.Write(b[:8])
+	return nil
+}
+
+func (m *Illuminance) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Illuminance = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Variance = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	return nil
+}
@@ -0,0 +1,231 @@
+// Automatically generated from the message definition "sensor_msgs/Image.msg"
+package sensor_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgImage struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgImage) Text() string {
+	return t.text
+}
+
+func (t *_MsgImage) Name() string {
+	return t.name
+}
+
+func (t *_MsgImage) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgImage) NewMessage() ros.Message {
+	m := new(Image)
+	m.Header = std_msgs.Header{}
+	m.Height = 0
+	m.Width = 0
+	m.Encoding = ""
+	m.IsBigendian = 0
+	m.Step = 0
+	m.Data = []uint8{}
+	return m
+}
+
+var (
+	MsgImage = &_MsgImage{
+		`# This message contains an uncompressed image
+# (0, 0) is at top-left corner of image
+#
+
+Header header        # Header timestamp should be acquisition time of image
+                     # Header frame_id should be optical frame of camera
+
+uint32 height         # image height, that is, number of rows
+uint32 width          # image width, that is, number of columns
+
+string encoding       # Encoding of pixels -- channel meaning, ordering, size
+                      # taken from the list of strings in include/sensor_msgs/image_encodings.h
+
+uint8 is_bigendian    # is this data bigendian?
+uint32 step           # Full row length in bytes
+uint8[] data          # actual matrix data, size is (step * rows)
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+`,
+		"sensor_msgs/Image",
+		"060021388200f6f0f447d0fcd9c64743",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgImage)
+}
+
+type Image struct {
+	Header      std_msgs.Header `rosmsg:"header:Header"`
+	Height      uint32          `rosmsg:"height:uint32"`
+	Width       uint32          `rosmsg:"width:uint32"`
+	Encoding    string          `rosmsg:"encoding:string"`
+	IsBigendian uint8           `rosmsg:"is_bigendian:uint8"`
+	Step        uint32          `rosmsg:"step:uint32"`
+	Data        []uint8         `rosmsg:"data:uint8[]"`
+}
+
+func (m *Image) Type() ros.MessageType {
+	return MsgImage
+}
+
+func (m *Image) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Image) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Image) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Image) Clone() *Image {
+	c := *m
+	c.Header = *m.Header.Clone()
+	if m.Data != nil {
+		c.Data = make([]uint8, len(m.Data))
+		copy(c.Data, m.Data)
+	}
+	return &c
+}
+
+func (m *Image) Equal(other *Image) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if m.Height != other.Height {
+		return false
+	}
+	if m.Width != other.Width {
+		return false
+	}
+	if m.Encoding != other.Encoding {
+		return false
+	}
+	if m.IsBigendian != other.IsBigendian {
+		return false
+	}
+	if m.Step != other.Step {
+		return false
+	}
+	if !bytes.Equal(m.Data, other.Data) {
+		return false
+	}
+	return true
+}
+
+func (m *Image) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += 4
+	length += 4
+	length += 4 + len(m.Encoding)
+	length += 1
+	length += 4
+	length += 4
+	length += 1 * len(m.Data)
+	return length
+}
+
+func (m *Image) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b[:], m.Height)
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], m.Width)
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Encoding)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Encoding)
+	buf.WriteByte(m.IsBigendian)
+	binary.LittleEndian.PutUint32(b[:], m.Step)
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Data)))
+	buf.Write(b[:4])
+	buf.Write(m.Data[:])
+	return nil
+}
+
+func (m *Image) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Height = binary.LittleEndian.Uint32(b[:])
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Width = binary.LittleEndian.Uint32(b[:])
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Encoding = string(data)
+	}
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.IsBigendian = b[:][0]
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Step = binary.LittleEndian.Uint32(b[:])
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*1 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Data = make([]uint8, size)
+		if _, err := io.ReadFull(buf, m.Data[:]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
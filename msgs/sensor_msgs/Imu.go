@@ -0,0 +1,287 @@
+// Automatically generated from the message definition "sensor_msgs/Imu.msg"
+package sensor_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/geometry_msgs"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgImu struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgImu) Text() string {
+	return t.text
+}
+
+func (t *_MsgImu) Name() string {
+	return t.name
+}
+
+func (t *_MsgImu) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgImu) NewMessage() ros.Message {
+	m := new(Imu)
+	m.Header = std_msgs.Header{}
+	m.Orientation = geometry_msgs.Quaternion{}
+	for i := 0; i < 9; i++ {
+		m.OrientationCovariance[i] = 0.0
+	}
+	m.AngularVelocity = geometry_msgs.Vector3{}
+	for i := 0; i < 9; i++ {
+		m.AngularVelocityCovariance[i] = 0.0
+	}
+	m.LinearAcceleration = geometry_msgs.Vector3{}
+	for i := 0; i < 9; i++ {
+		m.LinearAccelerationCovariance[i] = 0.0
+	}
+	return m
+}
+
+var (
+	MsgImu = &_MsgImu{
+		`# This is a message to hold data from an IMU (Inertial Measurement Unit)
+#
+# Accelerations should be in m/s^2 (not in g's), and rotational velocity should be in rad/sec
+#
+# If the covariance of the measurement is known, it should be filled in (if all you know is the
+# variance of each measurement, e.g. from the datasheet, just put those along the diagonal)
+# A covariance matrix of all zeros will be interpreted as "covariance unknown", and to use the
+# data a covariance will have to be assumed or gotten from some other source
+#
+# If you have no estimate for one of the data elements (e.g. your IMU doesn't produce an
+# orientation estimate), please set element 0 of the associated covariance matrix to -1
+# If you are interpreting this message, please check for a value of -1 in the first element of each
+# covariance matrix, and disregard the associated estimate.
+
+Header header
+
+geometry_msgs/Quaternion orientation
+float64[9] orientation_covariance # Row major about x, y, z axes
+
+geometry_msgs/Vector3 angular_velocity
+float64[9] angular_velocity_covariance # Row major about x, y, z axes
+
+geometry_msgs/Vector3 linear_acceleration
+float64[9] linear_acceleration_covariance # Row major x, y z 
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: geometry_msgs/Quaternion
+# This represents an orientation in free space in quaternion form.
+
+float64 x
+float64 y
+float64 z
+float64 w
+
+================================================================================
+MSG: geometry_msgs/Vector3
+# This represents a vector in free space. 
+# It is only meant to represent a direction. Therefore, it does not
+# make sense to apply a translation to it (e.g., when applying a 
+# generic rigid transformation to a Vector3, tf2 will only apply the
+# rotation). If you want your data to be translatable too, use the
+# geometry_msgs/Point message instead.
+
+float64 x
+float64 y
+float64 z
+`,
+		"sensor_msgs/Imu",
+		"6a62c6daae103f4ff57a132d6f95cec2",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgImu)
+}
+
+type Imu struct {
+	Header                       std_msgs.Header          `rosmsg:"header:Header"`
+	Orientation                  geometry_msgs.Quaternion `rosmsg:"orientation:Quaternion"`
+	OrientationCovariance        [9]float64               `rosmsg:"orientation_covariance:float64[9]"`
+	AngularVelocity              geometry_msgs.Vector3    `rosmsg:"angular_velocity:Vector3"`
+	AngularVelocityCovariance    [9]float64               `rosmsg:"angular_velocity_covariance:float64[9]"`
+	LinearAcceleration           geometry_msgs.Vector3    `rosmsg:"linear_acceleration:Vector3"`
+	LinearAccelerationCovariance [9]float64               `rosmsg:"linear_acceleration_covariance:float64[9]"`
+}
+
+func (m *Imu) Type() ros.MessageType {
+	return MsgImu
+}
+
+func (m *Imu) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Imu) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Imu) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Imu) Clone() *Imu {
+	c := *m
+	c.Header = *m.Header.Clone()
+	c.Orientation = *m.Orientation.Clone()
+	c.AngularVelocity = *m.AngularVelocity.Clone()
+	c.LinearAcceleration = *m.LinearAcceleration.Clone()
+	return &c
+}
+
+func (m *Imu) Equal(other *Imu) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if !m.Orientation.Equal(&other.Orientation) {
+		return false
+	}
+	if m.OrientationCovariance != other.OrientationCovariance {
+		return false
+	}
+	if !m.AngularVelocity.Equal(&other.AngularVelocity) {
+		return false
+	}
+	if m.AngularVelocityCovariance != other.AngularVelocityCovariance {
+		return false
+	}
+	if !m.LinearAcceleration.Equal(&other.LinearAcceleration) {
+		return false
+	}
+	if m.LinearAccelerationCovariance != other.LinearAccelerationCovariance {
+		return false
+	}
+	return true
+}
+
+func (m *Imu) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += m.Orientation.SerializedLength()
+	length += 8 * len(m.OrientationCovariance)
+	length += m.AngularVelocity.SerializedLength()
+	length += 8 * len(m.AngularVelocityCovariance)
+	length += m.LinearAcceleration.SerializedLength()
+	length += 8 * len(m.LinearAccelerationCovariance)
+	return length
+}
+
+func (m *Imu) Serialize(buf *bytes.Buffer) error {
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	if err := m.Orientation.Serialize(buf); err != nil {
+		return err
+	}
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 8 * len(m.OrientationCovariance)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.OrientationCovariance {
+			binary.LittleEndian.PutUint64(data[8*i:], math.Float64bits(e))
+		}
+		buf.Write(data)
+	}
+	if err := m.AngularVelocity.Serialize(buf); err != nil {
+		return err
+	}
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 8 * len(m.AngularVelocityCovariance)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.AngularVelocityCovariance {
+			binary.LittleEndian.PutUint64(data[8*i:], math.Float64bits(e))
+		}
+		buf.Write(data)
+	}
+	if err := m.LinearAcceleration.Serialize(buf); err != nil {
+		return err
+	}
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 8 * len(m.LinearAccelerationCovariance)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.LinearAccelerationCovariance {
+			binary.LittleEndian.PutUint64(data[8*i:], math.Float64bits(e))
+		}
+		buf.Write(data)
+	}
+	return nil
+}
+
+func (m *Imu) Deserialize(buf *bytes.Reader) error {
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	if err := m.Orientation.Deserialize(buf); err != nil {
+		return err
+	}
+	{
+		data := make([]byte, 8*len(m.OrientationCovariance))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.OrientationCovariance {
+			m.OrientationCovariance[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[8*i:]))
+		}
+	}
+	if err := m.AngularVelocity.Deserialize(buf); err != nil {
+		return err
+	}
+	{
+		data := make([]byte, 8*len(m.AngularVelocityCovariance))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.AngularVelocityCovariance {
+			m.AngularVelocityCovariance[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[8*i:]))
+		}
+	}
+	if err := m.LinearAcceleration.Deserialize(buf); err != nil {
+		return err
+	}
+	{
+		data := make([]byte, 8*len(m.LinearAccelerationCovariance))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.LinearAccelerationCovariance {
+			m.LinearAccelerationCovariance[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[8*i:]))
+		}
+	}
+	return nil
+}
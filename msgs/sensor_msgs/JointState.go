@@ -0,0 +1,331 @@
+// Automatically generated from the message definition "sensor_msgs/JointState.msg"
+package sensor_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgJointState struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgJointState) Text() string {
+	return t.text
+}
+
+func (t *_MsgJointState) Name() string {
+	return t.name
+}
+
+func (t *_MsgJointState) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgJointState) NewMessage() ros.Message {
+	m := new(JointState)
+	m.Header = std_msgs.Header{}
+	m.Name = []string{}
+	m.Position = []float64{}
+	m.Velocity = []float64{}
+	m.Effort = []float64{}
+	return m
+}
+
+var (
+	MsgJointState = &_MsgJointState{
+		`# This is a message that holds data to describe the state of a set of torque controlled joints. 
+#
+# The state of each joint (revolute or prismatic) is defined by:
+#  * the position of the joint (rad or m),
+#  * the velocity of the joint (rad/s or m/s) and 
+#  * the effort that is applied in the joint (Nm or N).
+#
+# Each joint is uniquely identified by its name
+# The header specifies the time at which the joint states were recorded. All the joint states
+# in one message have to be recorded at the same time.
+#
+# This message consists of a multiple arrays, one for each part of the joint state. 
+# The goal is to make each of the fields optional. When e.g. your joints have no
+# effort associated with them, you can leave the effort array empty. 
+#
+# All arrays in this message should have the same size, or be empty.
+# This is the only way to uniquely associate the joint name with the correct
+# states.
+
+Header header
+
+string[] name
+float64[] position
+float64[] velocity
+float64[] effort
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+`,
+		"sensor_msgs/JointState",
+		"3066dcd76a6cfaef579bd0f34173e9fd",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgJointState)
+}
+
+type JointState struct {
+	Header   std_msgs.Header `rosmsg:"header:Header"`
+	Name     []string        `rosmsg:"name:string[]"`
+	Position []float64       `rosmsg:"position:float64[]"`
+	Velocity []float64       `rosmsg:"velocity:float64[]"`
+	Effort   []float64       `rosmsg:"effort:float64[]"`
+}
+
+func (m *JointState) Type() ros.MessageType {
+	return MsgJointState
+}
+
+func (m *JointState) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *JointState) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *JointState) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *JointState) Clone() *JointState {
+	c := *m
+	c.Header = *m.Header.Clone()
+	if m.Name != nil {
+		c.Name = make([]string, len(m.Name))
+		copy(c.Name, m.Name)
+	}
+	if m.Position != nil {
+		c.Position = make([]float64, len(m.Position))
+		copy(c.Position, m.Position)
+	}
+	if m.Velocity != nil {
+		c.Velocity = make([]float64, len(m.Velocity))
+		copy(c.Velocity, m.Velocity)
+	}
+	if m.Effort != nil {
+		c.Effort = make([]float64, len(m.Effort))
+		copy(c.Effort, m.Effort)
+	}
+	return &c
+}
+
+func (m *JointState) Equal(other *JointState) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if len(m.Name) != len(other.Name) {
+		return false
+	}
+	for i := range m.Name {
+		if m.Name[i] != other.Name[i] {
+			return false
+		}
+	}
+	if len(m.Position) != len(other.Position) {
+		return false
+	}
+	for i := range m.Position {
+		if m.Position[i] != other.Position[i] {
+			return false
+		}
+	}
+	if len(m.Velocity) != len(other.Velocity) {
+		return false
+	}
+	for i := range m.Velocity {
+		if m.Velocity[i] != other.Velocity[i] {
+			return false
+		}
+	}
+	if len(m.Effort) != len(other.Effort) {
+		return false
+	}
+	for i := range m.Effort {
+		if m.Effort[i] != other.Effort[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *JointState) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += 4
+	for _, e := range m.Name {
+		length += 4 + len(e)
+	}
+	length += 4
+	length += 8 * len(m.Position)
+	length += 4
+	length += 8 * len(m.Velocity)
+	length += 4
+	length += 8 * len(m.Effort)
+	return length
+}
+
+func (m *JointState) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Name)))
+	buf.Write(b[:4])
+	for _, e := range m.Name {
+		binary.LittleEndian.PutUint32(b[:4], uint32(len(e)))
+		buf.Write(b[:4])
+		buf.WriteString(e)
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Position)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 8 * len(m.Position)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.Position {
+			binary.LittleEndian.PutUint64(data[8*i:], math.Float64bits(e))
+		}
+		buf.Write(data)
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Velocity)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 8 * len(m.Velocity)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.Velocity {
+			binary.LittleEndian.PutUint64(data[8*i:], math.Float64bits(e))
+		}
+		buf.Write(data)
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Effort)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 8 * len(m.Effort)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.Effort {
+			binary.LittleEndian.PutUint64(data[8*i:], math.Float64bits(e))
+		}
+		buf.Write(data)
+	}
+	return nil
+}
+
+func (m *JointState) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*4 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Name = make([]string, size)
+		for i := range m.Name {
+			if _, err := io.ReadFull(buf, b[:4]); err != nil {
+				return err
+			}
+			n := int(binary.LittleEndian.Uint32(b[:4]))
+			if int64(n) > int64(buf.Len()) {
+				return io.ErrUnexpectedEOF
+			}
+			data := make([]byte, n)
+			if _, err := io.ReadFull(buf, data); err != nil {
+				return err
+			}
+			m.Name[i] = string(data)
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*8 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Position = make([]float64, size)
+		data := make([]byte, 8*len(m.Position))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.Position {
+			m.Position[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[8*i:]))
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*8 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Velocity = make([]float64, size)
+		data := make([]byte, 8*len(m.Velocity))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.Velocity {
+			m.Velocity[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[8*i:]))
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*8 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Effort = make([]float64, size)
+		data := make([]byte, 8*len(m.Effort))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.Effort {
+			m.Effort[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[8*i:]))
+		}
+	}
+	return nil
+}
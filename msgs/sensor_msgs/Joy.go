@@ -0,0 +1,215 @@
+// Automatically generated from the message definition "sensor_msgs/Joy.msg"
+package sensor_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgJoy struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgJoy) Text() string {
+	return t.text
+}
+
+func (t *_MsgJoy) Name() string {
+	return t.name
+}
+
+func (t *_MsgJoy) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgJoy) NewMessage() ros.Message {
+	m := new(Joy)
+	m.Header = std_msgs.Header{}
+	m.Axes = []float32{}
+	m.Buttons = []int32{}
+	return m
+}
+
+var (
+	MsgJoy = &_MsgJoy{
+		`# Reports the state of a joysticks axes and buttons.
+Header header           # timestamp in the header is the time the data is received from the joystick
+float32[] axes          # the axes measurements from a joystick
+int32[] buttons         # the buttons measurements from a joystick 
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+`,
+		"sensor_msgs/Joy",
+		"5a9ea5f83505693b71e785041e67a8bb",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgJoy)
+}
+
+type Joy struct {
+	Header  std_msgs.Header `rosmsg:"header:Header"`
+	Axes    []float32       `rosmsg:"axes:float32[]"`
+	Buttons []int32         `rosmsg:"buttons:int32[]"`
+}
+
+func (m *Joy) Type() ros.MessageType {
+	return MsgJoy
+}
+
+func (m *Joy) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Joy) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Joy) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Joy) Clone() *Joy {
+	c := *m
+	c.Header = *m.Header.Clone()
+	if m.Axes != nil {
+		c.Axes = make([]float32, len(m.Axes))
+		copy(c.Axes, m.Axes)
+	}
+	if m.Buttons != nil {
+		c.Buttons = make([]int32, len(m.Buttons))
+		copy(c.Buttons, m.Buttons)
+	}
+	return &c
+}
+
+func (m *Joy) Equal(other *Joy) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if len(m.Axes) != len(other.Axes) {
+		return false
+	}
+	for i := range m.Axes {
+		if m.Axes[i] != other.Axes[i] {
+			return false
+		}
+	}
+	if len(m.Buttons) != len(other.Buttons) {
+		return false
+	}
+	for i := range m.Buttons {
+		if m.Buttons[i] != other.Buttons[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Joy) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += 4
+	length += 4 * len(m.Axes)
+	length += 4
+	length += 4 * len(m.Buttons)
+	return length
+}
+
+func (m *Joy) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Axes)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 4 * len(m.Axes)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.Axes {
+			binary.LittleEndian.PutUint32(data[4*i:], math.Float32bits(e))
+		}
+		buf.Write(data)
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Buttons)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 4 * len(m.Buttons)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.Buttons {
+			binary.LittleEndian.PutUint32(data[4*i:], uint32(e))
+		}
+		buf.Write(data)
+	}
+	return nil
+}
+
+func (m *Joy) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*4 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Axes = make([]float32, size)
+		data := make([]byte, 4*len(m.Axes))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.Axes {
+			m.Axes[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[4*i:]))
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*4 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Buttons = make([]int32, size)
+		data := make([]byte, 4*len(m.Buttons))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.Buttons {
+			m.Buttons[i] = int32(binary.LittleEndian.Uint32(data[4*i:]))
+		}
+	}
+	return nil
+}
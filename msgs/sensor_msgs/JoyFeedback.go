@@ -0,0 +1,153 @@
+// Automatically generated from the message definition "sensor_msgs/JoyFeedback.msg"
+package sensor_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+const (
+	JoyFeedback_TYPE_LED    uint8 = 0
+	JoyFeedback_TYPE_RUMBLE uint8 = 1
+	JoyFeedback_TYPE_BUZZER uint8 = 2
+)
+
+// Names of the constants before they were scoped by the message.
+const (
+	// Deprecated: Use JoyFeedback_TYPE_LED.
+	TYPELED = JoyFeedback_TYPE_LED
+	// Deprecated: Use JoyFeedback_TYPE_RUMBLE.
+	TYPERUMBLE = JoyFeedback_TYPE_RUMBLE
+	// Deprecated: Use JoyFeedback_TYPE_BUZZER.
+	TYPEBUZZER = JoyFeedback_TYPE_BUZZER
+)
+
+type _MsgJoyFeedback struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgJoyFeedback) Text() string {
+	return t.text
+}
+
+func (t *_MsgJoyFeedback) Name() string {
+	return t.name
+}
+
+func (t *_MsgJoyFeedback) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgJoyFeedback) NewMessage() ros.Message {
+	m := new(JoyFeedback)
+	m.Type_ = 0
+	m.Id = 0
+	m.Intensity = 0.0
+	return m
+}
+
+var (
+	MsgJoyFeedback = &_MsgJoyFeedback{
+		`# Declare of the type of feedback
+uint8 TYPE_LED    = 0
+uint8 TYPE_RUMBLE = 1
+uint8 TYPE_BUZZER = 2
+
+uint8 type
+
+# This will hold an id number for each type of each feedback.
+# Example, the first led would be id=0, the second would be id=1
+uint8 id
+
+# Intensity of the feedback, from 0.0 to 1.0, inclusive.  If device is
+# actually binary, driver should treat 0<=x<0.5 as off, 0.5<=x<=1 as on.
+float32 intensity
+`,
+		"sensor_msgs/JoyFeedback",
+		"f4dcd73460360d98f36e55ee7f2e46f1",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgJoyFeedback)
+}
+
+type JoyFeedback struct {
+	Type_     uint8   `rosmsg:"type:uint8"`
+	Id        uint8   `rosmsg:"id:uint8"`
+	Intensity float32 `rosmsg:"intensity:float32"`
+}
+
+func (m *JoyFeedback) Type() ros.MessageType {
+	return MsgJoyFeedback
+}
+
+func (m *JoyFeedback) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *JoyFeedback) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *JoyFeedback) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *JoyFeedback) Clone() *JoyFeedback {
+	c := *m
+	return &c
+}
+
+func (m *JoyFeedback) Equal(other *JoyFeedback) bool {
+	if m.Type_ != other.Type_ {
+		return false
+	}
+	if m.Id != other.Id {
+		return false
+	}
+	if m.Intensity != other.Intensity {
+		return false
+	}
+	return true
+}
+
+func (m *JoyFeedback) SerializedLength() int {
+	length := 0
+	length += 1
+	length += 1
+	length += 4
+	return length
+}
+
+func (m *JoyFeedback) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	buf.WriteByte(m.Type_)
+	buf.WriteByte(m.Id)
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.Intensity))
+	buf.Write(b[:4])
+	return nil
+}
+
+func (m *JoyFeedback) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.Type_ = b[:][0]
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.Id = b[:][0]
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Intensity = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	return nil
+}
@@ -0,0 +1,149 @@
+// Automatically generated from the message definition "sensor_msgs/JoyFeedbackArray.msg"
+package sensor_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgJoyFeedbackArray struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgJoyFeedbackArray) Text() string {
+	return t.text
+}
+
+func (t *_MsgJoyFeedbackArray) Name() string {
+	return t.name
+}
+
+func (t *_MsgJoyFeedbackArray) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgJoyFeedbackArray) NewMessage() ros.Message {
+	m := new(JoyFeedbackArray)
+	m.Array = []JoyFeedback{}
+	return m
+}
+
+var (
+	MsgJoyFeedbackArray = &_MsgJoyFeedbackArray{
+		`# This message publishes values for multiple feedback at once. 
+JoyFeedback[] array
+
+================================================================================
+MSG: sensor_msgs/JoyFeedback
+# Declare of the type of feedback
+uint8 TYPE_LED    = 0
+uint8 TYPE_RUMBLE = 1
+uint8 TYPE_BUZZER = 2
+
+uint8 type
+
+# This will hold an id number for each type of each feedback.
+# Example, the first led would be id=0, the second would be id=1
+uint8 id
+
+# Intensity of the feedback, from 0.0 to 1.0, inclusive.  If device is
+# actually binary, driver should treat 0<=x<0.5 as off, 0.5<=x<=1 as on.
+float32 intensity
+`,
+		"sensor_msgs/JoyFeedbackArray",
+		"cde5730a895b1fc4dee6f91b754b213d",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgJoyFeedbackArray)
+}
+
+type JoyFeedbackArray struct {
+	Array []JoyFeedback `rosmsg:"array:JoyFeedback[]"`
+}
+
+func (m *JoyFeedbackArray) Type() ros.MessageType {
+	return MsgJoyFeedbackArray
+}
+
+func (m *JoyFeedbackArray) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *JoyFeedbackArray) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *JoyFeedbackArray) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *JoyFeedbackArray) Clone() *JoyFeedbackArray {
+	c := *m
+	if m.Array != nil {
+		c.Array = make([]JoyFeedback, len(m.Array))
+		for i := range m.Array {
+			c.Array[i] = *m.Array[i].Clone()
+		}
+	}
+	return &c
+}
+
+func (m *JoyFeedbackArray) Equal(other *JoyFeedbackArray) bool {
+	if len(m.Array) != len(other.Array) {
+		return false
+	}
+	for i := range m.Array {
+		if !m.Array[i].Equal(&other.Array[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *JoyFeedbackArray) SerializedLength() int {
+	length := 0
+	length += 4
+	for i := range m.Array {
+		length += m.Array[i].SerializedLength()
+	}
+	return length
+}
+
+func (m *JoyFeedbackArray) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Array)))
+	buf.Write(b[:4])
+	for i := range m.Array {
+		if err := m.Array[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *JoyFeedbackArray) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*6 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Array = make([]JoyFeedback, size)
+		for i := range m.Array {
+			if err := m.Array[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
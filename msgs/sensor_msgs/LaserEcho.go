@@ -0,0 +1,140 @@
+// Automatically generated from the message definition "sensor_msgs/LaserEcho.msg"
+package sensor_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgLaserEcho struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgLaserEcho) Text() string {
+	return t.text
+}
+
+func (t *_MsgLaserEcho) Name() string {
+	return t.name
+}
+
+func (t *_MsgLaserEcho) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgLaserEcho) NewMessage() ros.Message {
+	m := new(LaserEcho)
+	m.Echoes = []float32{}
+	return m
+}
+
+var (
+	MsgLaserEcho = &_MsgLaserEcho{
+		`# This message is a submessage of MultiEchoLaserScan and is not intended
+# to be used separately.
+
+float32[] echoes  # Multiple values of ranges or intensities.
+                  # Each array represents data from the same angle increment.
+`,
+		"sensor_msgs/LaserEcho",
+		"8bc5ae449b200fba4d552b4225586696",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgLaserEcho)
+}
+
+type LaserEcho struct {
+	Echoes []float32 `rosmsg:"echoes:float32[]"`
+}
+
+func (m *LaserEcho) Type() ros.MessageType {
+	return MsgLaserEcho
+}
+
+func (m *LaserEcho) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *LaserEcho) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *LaserEcho) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *LaserEcho) Clone() *LaserEcho {
+	c := *m
+	if m.Echoes != nil {
+		c.Echoes = make([]float32, len(m.Echoes))
+		copy(c.Echoes, m.Echoes)
+	}
+	return &c
+}
+
+func (m *LaserEcho) Equal(other *LaserEcho) bool {
+	if len(m.Echoes) != len(other.Echoes) {
+		return false
+	}
+	for i := range m.Echoes {
+		if m.Echoes[i] != other.Echoes[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *LaserEcho) SerializedLength() int {
+	length := 0
+	length += 4
+	length += 4 * len(m.Echoes)
+	return length
+}
+
+func (m *LaserEcho) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Echoes)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 4 * len(m.Echoes)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.Echoes {
+			binary.LittleEndian.PutUint32(data[4*i:], math.Float32bits(e))
+		}
+		buf.Write(data)
+	}
+	return nil
+}
+
+func (m *LaserEcho) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*4 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Echoes = make([]float32, size)
+		data := make([]byte, 4*len(m.Echoes))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.Echoes {
+			m.Echoes[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[4*i:]))
+		}
+	}
+	return nil
+}
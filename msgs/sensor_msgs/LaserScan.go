@@ -0,0 +1,324 @@
+// Automatically generated from the message definition "sensor_msgs/LaserScan.msg"
+package sensor_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgLaserScan struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgLaserScan) Text() string {
+	return t.text
+}
+
+func (t *_MsgLaserScan) Name() string {
+	return t.name
+}
+
+func (t *_MsgLaserScan) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgLaserScan) NewMessage() ros.Message {
+	m := new(LaserScan)
+	m.Header = std_msgs.Header{}
+	m.AngleMin = 0.0
+	m.AngleMax = 0.0
+	m.AngleIncrement = 0.0
+	m.TimeIncrement = 0.0
+	m.ScanTime = 0.0
+	m.RangeMin = 0.0
+	m.RangeMax = 0.0
+	m.Ranges = []float32{}
+	m.Intensities = []float32{}
+	return m
+}
+
+var (
+	MsgLaserScan = &_MsgLaserScan{
+		`# Single scan from a planar laser range-finder
+#
+# If you have another ranging device with different behavior (e.g. a sonar
+# array), please find or create a different message, since applications
+# will make fairly laser-specific assumptions about this data
+
+Header header            # timestamp in the header is the acquisition time of 
+                         # the first ray in the scan.
+                         #
+                         # in frame frame_id, angles are measured around 
+                         # the positive Z axis (counterclockwise, if Z is up)
+                         # with zero angle being forward along the x axis
+                         
+float32 angle_min        # start angle of the scan [rad]
+float32 angle_max        # end angle of the scan [rad]
+float32 angle_increment  # angular distance between measurements [rad]
+
+float32 time_increment   # time between measurements [seconds] - if your scanner
+                         # is moving, this will be used in interpolating position
+                         # of 3d points
+float32 scan_time        # time between scans [seconds]
+
+float32 range_min        # minimum range value [m]
+float32 range_max        # maximum range value [m]
+
+float32[] ranges         # range data [m] (Note: values < range_min or > range_max should be discarded)
+float32[] intensities    # intensity data [device-specific units].  If your
+                         # device does not provide intensities, please leave
+                         # the array empty.
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+`,
+		"sensor_msgs/LaserScan",
+		"90c7ef2dc6895d81024acba2ac42f369",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgLaserScan)
+}
+
+type LaserScan struct {
+	Header         std_msgs.Header `rosmsg:"header:Header"`
+	AngleMin       float32         `rosmsg:"angle_min:float32"`
+	AngleMax       float32         `rosmsg:"angle_max:float32"`
+	AngleIncrement float32         `rosmsg:"angle_increment:float32"`
+	TimeIncrement  float32         `rosmsg:"time_increment:float32"`
+	ScanTime       float32         `rosmsg:"scan_time:float32"`
+	RangeMin       float32         `rosmsg:"range_min:float32"`
+	RangeMax       float32         `rosmsg:"range_max:float32"`
+	Ranges         []float32       `rosmsg:"ranges:float32[]"`
+	Intensities    []float32       `rosmsg:"intensities:float32[]"`
+}
+
+func (m *LaserScan) Type() ros.MessageType {
+	return MsgLaserScan
+}
+
+func (m *LaserScan) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *LaserScan) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *LaserScan) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *LaserScan) Clone() *LaserScan {
+	c := *m
+	c.Header = *m.Header.Clone()
+	if m.Ranges != nil {
+		c.Ranges = make([]float32, len(m.Ranges))
+		copy(c.Ranges, m.Ranges)
+	}
+	if m.Intensities != nil {
+		c.Intensities = make([]float32, len(m.Intensities))
+		copy(c.Intensities, m.Intensities)
+	}
+	return &c
+}
+
+func (m *LaserScan) Equal(other *LaserScan) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if m.AngleMin != other.AngleMin {
+		return false
+	}
+	if m.AngleMax != other.AngleMax {
+		return false
+	}
+	if m.AngleIncrement != other.AngleIncrement {
+		return false
+	}
+	if m.TimeIncrement != other.TimeIncrement {
+		return false
+	}
+	if m.ScanTime != other.ScanTime {
+		return false
+	}
+	if m.RangeMin != other.RangeMin {
+		return false
+	}
+	if m.RangeMax != other.RangeMax {
+		return false
+	}
+	if len(m.Ranges) != len(other.Ranges) {
+		return false
+	}
+	for i := range m.Ranges {
+		if m.Ranges[i] != other.Ranges[i] {
+			return false
+		}
+	}
+	if len(m.Intensities) != len(other.Intensities) {
+		return false
+	}
+	for i := range m.Intensities {
+		if m.Intensities[i] != other.Intensities[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *LaserScan) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += 4
+	length += 4
+	length += 4
+	length += 4
+	length += 4
+	length += 4
+	length += 4
+	length += 4
+	length += 4 * len(m.Ranges)
+	length += 4
+	length += 4 * len(m.Intensities)
+	return length
+}
+
+func (m *LaserScan) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.AngleMin))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.AngleMax))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.AngleIncrement))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.TimeIncrement))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.ScanTime))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.RangeMin))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.RangeMax))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Ranges)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 4 * len(m.Ranges)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.Ranges {
+			binary.LittleEndian.PutUint32(data[4*i:], math.Float32bits(e))
+		}
+		buf.Write(data)
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Intensities)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 4 * len(m.Intensities)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.Intensities {
+			binary.LittleEndian.PutUint32(data[4*i:], math.Float32bits(e))
+		}
+		buf.Write(data)
+	}
+	return nil
+}
+
+func (m *LaserScan) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.AngleMin = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.AngleMax = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.AngleIncrement = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.TimeIncrement = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.ScanTime = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.RangeMin = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.RangeMax = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*4 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Ranges = make([]float32, size)
+		data := make([]byte, 4*len(m.Ranges))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.Ranges {
+			m.Ranges[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[4*i:]))
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*4 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Intensities = make([]float32, size)
+		data := make([]byte, 4*len(m.Intensities))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.Intensities {
+			m.Intensities[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[4*i:]))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+// Automatically generated from the message definition "sensor_msgs/MagneticField.msg"
+package sensor_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/geometry_msgs"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgMagneticField struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgMagneticField) Text() string {
+	return t.text
+}
+
+func (t *_MsgMagneticField) Name() string {
+	return t.name
+}
+
+func (t *_MsgMagneticField) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgMagneticField) NewMessage() ros.Message {
+	m := new(MagneticField)
+	m.Header = std_msgs.Header{}
+	m.MagneticField = geometry_msgs.Vector3{}
+	for i := 0; i < 9; i++ {
+		m.MagneticFieldCovariance[i] = 0.0
+	}
+	return m
+}
+
+var (
+	MsgMagneticField = &_MsgMagneticField{
+		` # Measurement of the Magnetic Field vector at a specific location.
+
+ # If the covariance of the measurement is known, it should be filled in
+ # (if all you know is the variance of each measurement, e.g. from the
+ # datasheet, just put those along the diagonal)
+ # A covariance matrix of all zeros will be interpreted as "covariance
+ # unknown", and to use the data a covariance will have to be assumed or
+ # gotten from some other source
+
+
+ Header header                        # timestamp is the time the
+                                      # field was measured
+                                      # frame_id is the location and orientation
+                                      # of the field measurement
+
+ geometry_msgs/Vector3 magnetic_field # x, y, and z components of the
+                                      # field vector in Tesla
+                                      # If your sensor does not output 3 axes,
+                                      # put NaNs in the components not reported.
+
+ float64[9] magnetic_field_covariance # Row major about x, y, z axes
+                                      # 0 is interpreted as variance unknown
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: geometry_msgs/Vector3
+# This represents a vector in free space. 
+# It is only meant to represent a direction. Therefore, it does not
+# make sense to apply a translation to it (e.g., when applying a 
+# generic rigid transformation to a Vector3, tf2 will only apply the
+# rotation). If you want your data to be translatable too, use the
+# geometry_msgs/Point message instead.
+
+float64 x
+float64 y
+float64 z
+`,
+		"sensor_msgs/MagneticField",
+		"2f3b0b43eed0c9501de0fa3ff89a45aa",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgMagneticField)
+}
+
+type MagneticField struct {
+	Header                  std_msgs.Header       `rosmsg:"header:Header"`
+	MagneticField           geometry_msgs.Vector3 `rosmsg:"magnetic_field:Vector3"`
+	MagneticFieldCovariance [9]float64            `rosmsg:"magnetic_field_covariance:float64[9]"`
+}
+
+func (m *MagneticField) Type() ros.MessageType {
+	return MsgMagneticField
+}
+
+func (m *MagneticField) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *MagneticField) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *MagneticField) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *MagneticField) Clone() *MagneticField {
+	c := *m
+	c.Header = *m.Header.Clone()
+	c.MagneticField = *m.MagneticField.Clone()
+	return &c
+}
+
+func (m *MagneticField) Equal(other *MagneticField) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if !m.MagneticField.Equal(&other.MagneticField) {
+		return false
+	}
+	if m.MagneticFieldCovariance != other.MagneticFieldCovariance {
+		return false
+	}
+	return true
+}
+
+func (m *MagneticField) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += m.MagneticField.SerializedLength()
+	length += 8 * len(m.MagneticFieldCovariance)
+	return length
+}
+
+func (m *MagneticField) Serialize(buf *bytes.Buffer) error {
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	if err := m.MagneticField.Serialize(buf); err != nil {
+		return err
+	}
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 8 * len(m.MagneticFieldCovariance)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.MagneticFieldCovariance {
+			binary.LittleEndian.PutUint64(data[8*i:], math.Float64bits(e))
+		}
+		buf.Write(data)
+	}
+	return nil
+}
+
+func (m *MagneticField) Deserialize(buf *bytes.Reader) error {
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	if err := m.MagneticField.Deserialize(buf); err != nil {
+		return err
+	}
+	{
+		data := make([]byte, 8*len(m.MagneticFieldCovariance))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.MagneticFieldCovariance {
+			m.MagneticFieldCovariance[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[8*i:]))
+		}
+	}
+	return nil
+}
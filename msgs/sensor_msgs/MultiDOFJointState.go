@@ -0,0 +1,362 @@
+// Automatically generated from the message definition "sensor_msgs/MultiDOFJointState.msg"
+package sensor_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/geometry_msgs"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgMultiDOFJointState struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgMultiDOFJointState) Text() string {
+	return t.text
+}
+
+func (t *_MsgMultiDOFJointState) Name() string {
+	return t.name
+}
+
+func (t *_MsgMultiDOFJointState) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgMultiDOFJointState) NewMessage() ros.Message {
+	m := new(MultiDOFJointState)
+	m.Header = std_msgs.Header{}
+	m.JointNames = []string{}
+	m.Transforms = []geometry_msgs.Transform{}
+	m.Twist = []geometry_msgs.Twist{}
+	m.Wrench = []geometry_msgs.Wrench{}
+	return m
+}
+
+var (
+	MsgMultiDOFJointState = &_MsgMultiDOFJointState{
+		`# Representation of state for joints with multiple degrees of freedom, 
+# following the structure of JointState.
+#
+# It is assumed that a joint in a system corresponds to a transform that gets applied 
+# along the kinematic chain. For example, a planar joint (as in URDF) is 3DOF (x, y, yaw)
+# and those 3DOF can be expressed as a transformation matrix, and that transformation
+# matrix can be converted back to (x, y, yaw)
+#
+# Each joint is uniquely identified by its name
+# The header specifies the time at which the joint states were recorded. All the joint states
+# in one message have to be recorded at the same time.
+#
+# This message consists of a multiple arrays, one for each part of the joint state. 
+# The goal is to make each of the fields optional. When e.g. your joints have no
+# wrench associated with them, you can leave the wrench array empty. 
+#
+# All arrays in this message should have the same size, or be empty.
+# This is the only way to uniquely associate the joint name with the correct
+# states.
+
+Header header
+
+string[] joint_names
+geometry_msgs/Transform[] transforms
+geometry_msgs/Twist[] twist
+geometry_msgs/Wrench[] wrench
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: geometry_msgs/Transform
+# This represents the transform between two coordinate frames in free space.
+
+Vector3 translation
+Quaternion rotation
+
+================================================================================
+MSG: geometry_msgs/Vector3
+# This represents a vector in free space. 
+# It is only meant to represent a direction. Therefore, it does not
+# make sense to apply a translation to it (e.g., when applying a 
+# generic rigid transformation to a Vector3, tf2 will only apply the
+# rotation). If you want your data to be translatable too, use the
+# geometry_msgs/Point message instead.
+
+float64 x
+float64 y
+float64 z
+
+================================================================================
+MSG: geometry_msgs/Quaternion
+# This represents an orientation in free space in quaternion form.
+
+float64 x
+float64 y
+float64 z
+float64 w
+
+================================================================================
+MSG: geometry_msgs/Twist
+# This expresses velocity in free space broken into its linear and angular parts.
+Vector3  linear
+Vector3  angular
+
+================================================================================
+MSG: geometry_msgs/Wrench
+# This represents force in free space, separated into
+# its linear and angular parts.
+Vector3  force
+Vector3  torque
+`,
+		"sensor_msgs/MultiDOFJointState",
+		"690f272f0640d2631c305eeb8301e59d",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgMultiDOFJointState)
+}
+
+type MultiDOFJointState struct {
+	Header     std_msgs.Header           `rosmsg:"header:Header"`
+	JointNames []string                  `rosmsg:"joint_names:string[]"`
+	Transforms []geometry_msgs.Transform `rosmsg:"transforms:Transform[]"`
+	Twist      []geometry_msgs.Twist     `rosmsg:"twist:Twist[]"`
+	Wrench     []geometry_msgs.Wrench    `rosmsg:"wrench:Wrench[]"`
+}
+
+func (m *MultiDOFJointState) Type() ros.MessageType {
+	return MsgMultiDOFJointState
+}
+
+func (m *MultiDOFJointState) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *MultiDOFJointState) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *MultiDOFJointState) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *MultiDOFJointState) Clone() *MultiDOFJointState {
+	c := *m
+	c.Header = *m.Header.Clone()
+	if m.JointNames != nil {
+		c.JointNames = make([]string, len(m.JointNames))
+		copy(c.JointNames, m.JointNames)
+	}
+	if m.Transforms != nil {
+		c.Transforms = make([]geometry_msgs.Transform, len(m.Transforms))
+		for i := range m.Transforms {
+			c.Transforms[i] = *m.Transforms[i].Clone()
+		}
+	}
+	if m.Twist != nil {
+		c.Twist = make([]geometry_msgs.Twist, len(m.Twist))
+		for i := range m.Twist {
+			c.Twist[i] = *m.Twist[i].Clone()
+		}
+	}
+	if m.Wrench != nil {
+		c.Wrench = make([]geometry_msgs.Wrench, len(m.Wrench))
+		for i := range m.Wrench {
+			c.Wrench[i] = *m.Wrench[i].Clone()
+		}
+	}
+	return &c
+}
+
+func (m *MultiDOFJointState) Equal(other *MultiDOFJointState) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if len(m.JointNames) != len(other.JointNames) {
+		return false
+	}
+	for i := range m.JointNames {
+		if m.JointNames[i] != other.JointNames[i] {
+			return false
+		}
+	}
+	if len(m.Transforms) != len(other.Transforms) {
+		return false
+	}
+	for i := range m.Transforms {
+		if !m.Transforms[i].Equal(&other.Transforms[i]) {
+			return false
+		}
+	}
+	if len(m.Twist) != len(other.Twist) {
+		return false
+	}
+	for i := range m.Twist {
+		if !m.Twist[i].Equal(&other.Twist[i]) {
+			return false
+		}
+	}
+	if len(m.Wrench) != len(other.Wrench) {
+		return false
+	}
+	for i := range m.Wrench {
+		if !m.Wrench[i].Equal(&other.Wrench[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *MultiDOFJointState) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += 4
+	for _, e := range m.JointNames {
+		length += 4 + len(e)
+	}
+	length += 4
+	for i := range m.Transforms {
+		length += m.Transforms[i].SerializedLength()
+	}
+	length += 4
+	for i := range m.Twist {
+		length += m.Twist[i].SerializedLength()
+	}
+	length += 4
+	for i := range m.Wrench {
+		length += m.Wrench[i].SerializedLength()
+	}
+	return length
+}
+
+func (m *MultiDOFJointState) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.JointNames)))
+	buf.Write(b[:4])
+	for _, e := range m.JointNames {
+		binary.LittleEndian.PutUint32(b[:4], uint32(len(e)))
+		buf.Write(b[:4])
+		buf.WriteString(e)
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Transforms)))
+	buf.Write(b[:4])
+	for i := range m.Transforms {
+		if err := m.Transforms[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Twist)))
+	buf.Write(b[:4])
+	for i := range m.Twist {
+		if err := m.Twist[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Wrench)))
+	buf.Write(b[:4])
+	for i := range m.Wrench {
+		if err := m.Wrench[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *MultiDOFJointState) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*4 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.JointNames = make([]string, size)
+		for i := range m.JointNames {
+			if _, err := io.ReadFull(buf, b[:4]); err != nil {
+				return err
+			}
+			n := int(binary.LittleEndian.Uint32(b[:4]))
+			if int64(n) > int64(buf.Len()) {
+				return io.ErrUnexpectedEOF
+			}
+			data := make([]byte, n)
+			if _, err := io.ReadFull(buf, data); err != nil {
+				return err
+			}
+			m.JointNames[i] = string(data)
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*56 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Transforms = make([]geometry_msgs.Transform, size)
+		for i := range m.Transforms {
+			if err := m.Transforms[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*48 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Twist = make([]geometry_msgs.Twist, size)
+		for i := range m.Twist {
+			if err := m.Twist[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*48 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Wrench = make([]geometry_msgs.Wrench, size)
+		for i := range m.Wrench {
+			if err := m.Wrench[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
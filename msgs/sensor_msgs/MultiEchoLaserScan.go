@@ -0,0 +1,326 @@
+// Automatically generated from the message definition "sensor_msgs/MultiEchoLaserScan.msg"
+package sensor_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgMultiEchoLaserScan struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgMultiEchoLaserScan) Text() string {
+	return t.text
+}
+
+func (t *_MsgMultiEchoLaserScan) Name() string {
+	return t.name
+}
+
+func (t *_MsgMultiEchoLaserScan) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgMultiEchoLaserScan) NewMessage() ros.Message {
+	m := new(MultiEchoLaserScan)
+	m.Header = std_msgs.Header{}
+	m.AngleMin = 0.0
+	m.AngleMax = 0.0
+	m.AngleIncrement = 0.0
+	m.TimeIncrement = 0.0
+	m.ScanTime = 0.0
+	m.RangeMin = 0.0
+	m.RangeMax = 0.0
+	m.Ranges = []LaserEcho{}
+	m.Intensities = []LaserEcho{}
+	return m
+}
+
+var (
+	MsgMultiEchoLaserScan = &_MsgMultiEchoLaserScan{
+		`# Single scan from a multi-echo planar laser range-finder
+#
+# If you have another ranging device with different behavior (e.g. a sonar
+# array), please find or create a different message, since applications
+# will make fairly laser-specific assumptions about this data
+
+Header header            # timestamp in the header is the acquisition time of 
+                         # the first ray in the scan.
+                         #
+                         # in frame frame_id, angles are measured around 
+                         # the positive Z axis (counterclockwise, if Z is up)
+                         # with zero angle being forward along the x axis
+                         
+float32 angle_min        # start angle of the scan [rad]
+float32 angle_max        # end angle of the scan [rad]
+float32 angle_increment  # angular distance between measurements [rad]
+
+float32 time_increment   # time between measurements [seconds] - if your scanner
+                         # is moving, this will be used in interpolating position
+                         # of 3d points
+float32 scan_time        # time between scans [seconds]
+
+float32 range_min        # minimum range value [m]
+float32 range_max        # maximum range value [m]
+
+LaserEcho[] ranges       # range data [m] (Note: NaNs, values < range_min or > range_max should be discarded)
+                         # +Inf measurements are out of range
+                         # -Inf measurements are too close to determine exact distance.
+LaserEcho[] intensities  # intensity data [device-specific units].  If your
+                         # device does not provide intensities, please leave
+                         # the array empty.
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: sensor_msgs/LaserEcho
+# This message is a submessage of MultiEchoLaserScan and is not intended
+# to be used separately.
+
+float32[] echoes  # Multiple values of ranges or intensities.
+                  # Each array represents data from the same angle increment.
+`,
+		"sensor_msgs/MultiEchoLaserScan",
+		"6fefb0c6da89d7c8abe4b339f5c2f8fb",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgMultiEchoLaserScan)
+}
+
+type MultiEchoLaserScan struct {
+	Header         std_msgs.Header `rosmsg:"header:Header"`
+	AngleMin       float32         `rosmsg:"angle_min:float32"`
+	AngleMax       float32         `rosmsg:"angle_max:float32"`
+	AngleIncrement float32         `rosmsg:"angle_increment:float32"`
+	TimeIncrement  float32         `rosmsg:"time_increment:float32"`
+	ScanTime       float32         `rosmsg:"scan_time:float32"`
+	RangeMin       float32         `rosmsg:"range_min:float32"`
+	RangeMax       float32         `rosmsg:"range_max:float32"`
+	Ranges         []LaserEcho     `rosmsg:"ranges:LaserEcho[]"`
+	Intensities    []LaserEcho     `rosmsg:"intensities:LaserEcho[]"`
+}
+
+func (m *MultiEchoLaserScan) Type() ros.MessageType {
+	return MsgMultiEchoLaserScan
+}
+
+func (m *MultiEchoLaserScan) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *MultiEchoLaserScan) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *MultiEchoLaserScan) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *MultiEchoLaserScan) Clone() *MultiEchoLaserScan {
+	c := *m
+	c.Header = *m.Header.Clone()
+	if m.Ranges != nil {
+		c.Ranges = make([]LaserEcho, len(m.Ranges))
+		for i := range m.Ranges {
+			c.Ranges[i] = *m.Ranges[i].Clone()
+		}
+	}
+	if m.Intensities != nil {
+		c.Intensities = make([]LaserEcho, len(m.Intensities))
+		for i := range m.Intensities {
+			c.Intensities[i] = *m.Intensities[i].Clone()
+		}
+	}
+	return &c
+}
+
+func (m *MultiEchoLaserScan) Equal(other *MultiEchoLaserScan) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if m.AngleMin != other.AngleMin {
+		return false
+	}
+	if m.AngleMax != other.AngleMax {
+		return false
+	}
+	if m.AngleIncrement != other.AngleIncrement {
+		return false
+	}
+	if m.TimeIncrement != other.TimeIncrement {
+		return false
+	}
+	if m.ScanTime != other.ScanTime {
+		return false
+	}
+	if m.RangeMin != other.RangeMin {
+		return false
+	}
+	if m.RangeMax != other.RangeMax {
+		return false
+	}
+	if len(m.Ranges) != len(other.Ranges) {
+		return false
+	}
+	for i := range m.Ranges {
+		if !m.Ranges[i].Equal(&other.Ranges[i]) {
+			return false
+		}
+	}
+	if len(m.Intensities) != len(other.Intensities) {
+		return false
+	}
+	for i := range m.Intensities {
+		if !m.Intensities[i].Equal(&other.Intensities[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *MultiEchoLaserScan) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += 4
+	length += 4
+	length += 4
+	length += 4
+	length += 4
+	length += 4
+	length += 4
+	length += 4
+	for i := range m.Ranges {
+		length += m.Ranges[i].SerializedLength()
+	}
+	length += 4
+	for i := range m.Intensities {
+		length += m.Intensities[i].SerializedLength()
+	}
+	return length
+}
+
+func (m *MultiEchoLaserScan) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.AngleMin))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.AngleMax))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.AngleIncrement))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.TimeIncrement))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.ScanTime))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.RangeMin))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.RangeMax))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Ranges)))
+	buf.Write(b[:4])
+	for i := range m.Ranges {
+		if err := m.Ranges[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Intensities)))
+	buf.Write(b[:4])
+	for i := range m.Intensities {
+		if err := m.Intensities[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *MultiEchoLaserScan) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.AngleMin = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.AngleMax = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.AngleIncrement = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.TimeIncrement = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.ScanTime = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.RangeMin = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.RangeMax = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*4 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Ranges = make([]LaserEcho, size)
+		for i := range m.Ranges {
+			if err := m.Ranges[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*4 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Intensities = make([]LaserEcho, size)
+		for i := range m.Intensities {
+			if err := m.Intensities[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,297 @@
+// Automatically generated from the message definition "sensor_msgs/NavSatFix.msg"
+package sensor_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+const (
+	NavSatFix_COVARIANCE_TYPE_UNKNOWN        uint8 = 0
+	NavSatFix_COVARIANCE_TYPE_APPROXIMATED   uint8 = 1
+	NavSatFix_COVARIANCE_TYPE_DIAGONAL_KNOWN uint8 = 2
+	NavSatFix_COVARIANCE_TYPE_KNOWN          uint8 = 3
+)
+
+// Names of the constants before they were scoped by the message.
+const (
+	// Deprecated: Use NavSatFix_COVARIANCE_TYPE_UNKNOWN.
+	COVARIANCETYPEUNKNOWN = NavSatFix_COVARIANCE_TYPE_UNKNOWN
+	// Deprecated: Use NavSatFix_COVARIANCE_TYPE_APPROXIMATED.
+	COVARIANCETYPEAPPROXIMATED = NavSatFix_COVARIANCE_TYPE_APPROXIMATED
+	// Deprecated: Use NavSatFix_COVARIANCE_TYPE_DIAGONAL_KNOWN.
+	COVARIANCETYPEDIAGONALKNOWN = NavSatFix_COVARIANCE_TYPE_DIAGONAL_KNOWN
+	// Deprecated: Use NavSatFix_COVARIANCE_TYPE_KNOWN.
+	COVARIANCETYPEKNOWN = NavSatFix_COVARIANCE_TYPE_KNOWN
+)
+
+type _MsgNavSatFix struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgNavSatFix) Text() string {
+	return t.text
+}
+
+func (t *_MsgNavSatFix) Name() string {
+	return t.name
+}
+
+func (t *_MsgNavSatFix) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgNavSatFix) NewMessage() ros.Message {
+	m := new(NavSatFix)
+	m.Header = std_msgs.Header{}
+	m.Status = NavSatStatus{}
+	m.Latitude = 0.0
+	m.Longitude = 0.0
+	m.Altitude = 0.0
+	for i := 0; i < 9; i++ {
+		m.PositionCovariance[i] = 0.0
+	}
+	m.PositionCovarianceType = 0
+	return m
+}
+
+var (
+	MsgNavSatFix = &_MsgNavSatFix{
+		`# Navigation Satellite fix for any Global Navigation Satellite System
+#
+# Specified using the WGS 84 reference ellipsoid
+
+# header.stamp specifies the ROS time for this measurement (the
+#        corresponding satellite time may be reported using the
+#        sensor_msgs/TimeReference message).
+#
+# header.frame_id is the frame of reference reported by the satellite
+#        receiver, usually the location of the antenna.  This is a
+#        Euclidean frame relative to the vehicle, not a reference
+#        ellipsoid.
+Header header
+
+# satellite fix status information
+NavSatStatus status
+
+# Latitude [degrees]. Positive is north of equator; negative is south.
+float64 latitude
+
+# Longitude [degrees]. Positive is east of prime meridian; negative is west.
+float64 longitude
+
+# Altitude [m]. Positive is above the WGS 84 ellipsoid
+# (quiet NaN if no altitude is available).
+float64 altitude
+
+# Position covariance [m^2] defined relative to a tangential plane
+# through the reported position. The components are East, North, and
+# Up (ENU), in row-major order.
+#
+# Beware: this coordinate system exhibits singularities at the poles.
+
+float64[9] position_covariance
+
+# If the covariance of the fix is known, fill it in completely. If the
+# GPS receiver provides the variance of each measurement, put them
+# along the diagonal. If only Dilution of Precision is available,
+# estimate an approximate covariance from that.
+
+uint8 COVARIANCE_TYPE_UNKNOWN = 0
+uint8 COVARIANCE_TYPE_APPROXIMATED = 1
+uint8 COVARIANCE_TYPE_DIAGONAL_KNOWN = 2
+uint8 COVARIANCE_TYPE_KNOWN = 3
+
+uint8 position_covariance_type
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: sensor_msgs/NavSatStatus
+# Navigation Satellite fix status for any Global Navigation Satellite System
+
+# Whether to output an augmented fix is determined by both the fix
+# type and the last time differential corrections were received.  A
+# fix is valid when status >= STATUS_FIX.
+
+int8 STATUS_NO_FIX =  -1        # unable to fix position
+int8 STATUS_FIX =      0        # unaugmented fix
+int8 STATUS_SBAS_FIX = 1        # with satellite-based augmentation
+int8 STATUS_GBAS_FIX = 2        # with ground-based augmentation
+
+int8 status
+
+# Bits defining which Global Navigation Satellite System signals were
+# used by the receiver.
+
+uint16 SERVICE_GPS =     1
+uint16 SERVICE_GLONASS = 2
+uint16 SERVICE_COMPASS = 4      # includes BeiDou.
+uint16 SERVICE_GALILEO = 8
+
+uint16 service
+`,
+		"sensor_msgs/NavSatFix",
+		"2d3a8cd499b9b4a0249fb98fd05cfa48",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgNavSatFix)
+}
+
+type NavSatFix struct {
+	Header                 std_msgs.Header `rosmsg:"header:Header"`
+	Status                 NavSatStatus    `rosmsg:"status:NavSatStatus"`
+	Latitude               float64         `rosmsg:"latitude:float64"`
+	Longitude              float64         `rosmsg:"longitude:float64"`
+	Altitude               float64         `rosmsg:"altitude:float64"`
+	PositionCovariance     [9]float64      `rosmsg:"position_covariance:float64[9]"`
+	PositionCovarianceType uint8           `rosmsg:"position_covariance_type:uint8"`
+}
+
+func (m *NavSatFix) Type() ros.MessageType {
+	return MsgNavSatFix
+}
+
+func (m *NavSatFix) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *NavSatFix) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *NavSatFix) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *NavSatFix) Clone() *NavSatFix {
+	c := *m
+	c.Header = *m.Header.Clone()
+	c.Status = *m.Status.Clone()
+	return &c
+}
+
+func (m *NavSatFix) Equal(other *NavSatFix) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if !m.Status.Equal(&other.Status) {
+		return false
+	}
+	if m.Latitude != other.Latitude {
+		return false
+	}
+	if m.Longitude != other.Longitude {
+		return false
+	}
+	if m.Altitude != other.Altitude {
+		return false
+	}
+	if m.PositionCovariance != other.PositionCovariance {
+		return false
+	}
+	if m.PositionCovarianceType != other.PositionCovarianceType {
+		return false
+	}
+	return true
+}
+
+func (m *NavSatFix) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += m.Status.SerializedLength()
+	length += 8
+	length += 8
+	length += 8
+	length += 8 * len(m.PositionCovariance)
+	length += 1
+	return length
+}
+
+func (m *NavSatFix) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	if err := m.Status.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.Latitude))
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.Longitude))
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.Altitude))
+	buf.Write(b[:8])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 8 * len(m.PositionCovariance)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.PositionCovariance {
+			binary.LittleEndian.PutUint64(data[8*i:], math.Float64bits(e))
+		}
+		buf.Write(data)
+	}
+	buf.WriteByte(m.PositionCovarianceType)
+	return nil
+}
+
+func (m *NavSatFix) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	if err := m.Status.Deserialize(buf); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Latitude = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Longitude = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Altitude = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	{
+		data := make([]byte, 8*len(m.PositionCovariance))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.PositionCovariance {
+			m.PositionCovariance[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[8*i:]))
+		}
+	}
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.PositionCovarianceType = b[:][0]
+	return nil
+}
@@ -0,0 +1,164 @@
+// Automatically generated from the message definition "sensor_msgs/NavSatStatus.msg"
+package sensor_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+const (
+	NavSatStatus_STATUS_NO_FIX   int8   = -1
+	NavSatStatus_STATUS_FIX      int8   = 0
+	NavSatStatus_STATUS_SBAS_FIX int8   = 1
+	NavSatStatus_STATUS_GBAS_FIX int8   = 2
+	NavSatStatus_SERVICE_GPS     uint16 = 1
+	NavSatStatus_SERVICE_GLONASS uint16 = 2
+	NavSatStatus_SERVICE_COMPASS uint16 = 4
+	NavSatStatus_SERVICE_GALILEO uint16 = 8
+)
+
+// Names of the constants before they were scoped by the message.
+const (
+	// Deprecated: Use NavSatStatus_STATUS_NO_FIX.
+	STATUSNOFIX = NavSatStatus_STATUS_NO_FIX
+	// Deprecated: Use NavSatStatus_STATUS_FIX.
+	STATUSFIX = NavSatStatus_STATUS_FIX
+	// Deprecated: Use NavSatStatus_STATUS_SBAS_FIX.
+	STATUSSBASFIX = NavSatStatus_STATUS_SBAS_FIX
+	// Deprecated: Use NavSatStatus_STATUS_GBAS_FIX.
+	STATUSGBASFIX = NavSatStatus_STATUS_GBAS_FIX
+	// Deprecated: Use NavSatStatus_SERVICE_GPS.
+	SERVICEGPS = NavSatStatus_SERVICE_GPS
+	// Deprecated: Use NavSatStatus_SERVICE_GLONASS.
+	SERVICEGLONASS = NavSatStatus_SERVICE_GLONASS
+	// Deprecated: Use NavSatStatus_SERVICE_COMPASS.
+	SERVICECOMPASS = NavSatStatus_SERVICE_COMPASS
+	// Deprecated: Use NavSatStatus_SERVICE_GALILEO.
+	SERVICEGALILEO = NavSatStatus_SERVICE_GALILEO
+)
+
+type _MsgNavSatStatus struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgNavSatStatus) Text() string {
+	return t.text
+}
+
+func (t *_MsgNavSatStatus) Name() string {
+	return t.name
+}
+
+func (t *_MsgNavSatStatus) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgNavSatStatus) NewMessage() ros.Message {
+	m := new(NavSatStatus)
+	m.Status = 0
+	m.Service = 0
+	return m
+}
+
+var (
+	MsgNavSatStatus = &_MsgNavSatStatus{
+		`# Navigation Satellite fix status for any Global Navigation Satellite System
+
+# Whether to output an augmented fix is determined by both the fix
+# type and the last time differential corrections were received.  A
+# fix is valid when status >= STATUS_FIX.
+
+int8 STATUS_NO_FIX =  -1        # unable to fix position
+int8 STATUS_FIX =      0        # unaugmented fix
+int8 STATUS_SBAS_FIX = 1        # with satellite-based augmentation
+int8 STATUS_GBAS_FIX = 2        # with ground-based augmentation
+
+int8 status
+
+# Bits defining which Global Navigation Satellite System signals were
+# used by the receiver.
+
+uint16 SERVICE_GPS =     1
+uint16 SERVICE_GLONASS = 2
+uint16 SERVICE_COMPASS = 4      # includes BeiDou.
+uint16 SERVICE_GALILEO = 8
+
+uint16 service
+`,
+		"sensor_msgs/NavSatStatus",
+		"331cdbddfa4bc96ffc3b9ad98900a54c",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgNavSatStatus)
+}
+
+type NavSatStatus struct {
+	Status  int8   `rosmsg:"status:int8"`
+	Service uint16 `rosmsg:"service:uint16"`
+}
+
+func (m *NavSatStatus) Type() ros.MessageType {
+	return MsgNavSatStatus
+}
+
+func (m *NavSatStatus) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *NavSatStatus) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *NavSatStatus) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *NavSatStatus) Clone() *NavSatStatus {
+	c := *m
+	return &c
+}
+
+func (m *NavSatStatus) Equal(other *NavSatStatus) bool {
+	if m.Status != other.Status {
+		return false
+	}
+	if m.Service != other.Service {
+		return false
+	}
+	return true
+}
+
+func (m *NavSatStatus) SerializedLength() int {
+	length := 0
+	length += 1
+	length += 2
+	return length
+}
+
+func (m *NavSatStatus) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	buf.WriteByte(byte(m.Status))
+	binary.LittleEndian.PutUint16(b[:], m.Service)
+	buf.Write(b[:2])
+	return nil
+}
+
+func (m *NavSatStatus) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.Status = int8(b[:][0])
+	if _, err := io.ReadFull(buf, b[:2]); err != nil {
+		return err
+	}
+	m.Service = binary.LittleEndian.Uint16(b[:])
+	return nil
+}
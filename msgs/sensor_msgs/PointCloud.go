@@ -0,0 +1,242 @@
+// Automatically generated from the message definition "sensor_msgs/PointCloud.msg"
+package sensor_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/geometry_msgs"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgPointCloud struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgPointCloud) Text() string {
+	return t.text
+}
+
+func (t *_MsgPointCloud) Name() string {
+	return t.name
+}
+
+func (t *_MsgPointCloud) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgPointCloud) NewMessage() ros.Message {
+	m := new(PointCloud)
+	m.Header = std_msgs.Header{}
+	m.Points = []geometry_msgs.Point32{}
+	m.Channels = []ChannelFloat32{}
+	return m
+}
+
+var (
+	MsgPointCloud = &_MsgPointCloud{
+		`# This message holds a collection of 3d points, plus optional additional
+# information about each point.
+
+# Time of sensor data acquisition, coordinate frame ID.
+Header header
+
+# Array of 3d points. Each Point32 should be interpreted as a 3d point
+# in the frame given in the header.
+geometry_msgs/Point32[] points
+
+# Each channel should have the same number of elements as points array,
+# and the data in each channel should correspond 1:1 with each point.
+# Channel names in common practice are listed in ChannelFloat32.msg.
+ChannelFloat32[] channels
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: geometry_msgs/Point32
+# This contains the position of a point in free space(with 32 bits of precision).
+# It is recommeded to use Point wherever possible instead of Point32.
+#
+# This recommendation is to promote interoperability.
+#
+# This message is designed to take up less space when sending
+# lots of points at once, as in the case of a PointCloud.
+
+float32 x
+float32 y
+float32 z
+
+================================================================================
+MSG: sensor_msgs/ChannelFloat32
+# This message is used by the PointCloud message to hold optional data
+# associated with each point in the cloud. The length of the values
+# array should be the same as the length of the points array in the
+# PointCloud, and each value should be associated with the corresponding
+# point.
+
+string name
+float32[] values
+`,
+		"sensor_msgs/PointCloud",
+		"d8e9c3f5afbdd8a130fd1d2763945fca",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgPointCloud)
+}
+
+type PointCloud struct {
+	Header   std_msgs.Header         `rosmsg:"header:Header"`
+	Points   []geometry_msgs.Point32 `rosmsg:"points:Point32[]"`
+	Channels []ChannelFloat32        `rosmsg:"channels:ChannelFloat32[]"`
+}
+
+func (m *PointCloud) Type() ros.MessageType {
+	return MsgPointCloud
+}
+
+func (m *PointCloud) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *PointCloud) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *PointCloud) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *PointCloud) Clone() *PointCloud {
+	c := *m
+	c.Header = *m.Header.Clone()
+	if m.Points != nil {
+		c.Points = make([]geometry_msgs.Point32, len(m.Points))
+		for i := range m.Points {
+			c.Points[i] = *m.Points[i].Clone()
+		}
+	}
+	if m.Channels != nil {
+		c.Channels = make([]ChannelFloat32, len(m.Channels))
+		for i := range m.Channels {
+			c.Channels[i] = *m.Channels[i].Clone()
+		}
+	}
+	return &c
+}
+
+func (m *PointCloud) Equal(other *PointCloud) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if len(m.Points) != len(other.Points) {
+		return false
+	}
+	for i := range m.Points {
+		if !m.Points[i].Equal(&other.Points[i]) {
+			return false
+		}
+	}
+	if len(m.Channels) != len(other.Channels) {
+		return false
+	}
+	for i := range m.Channels {
+		if !m.Channels[i].Equal(&other.Channels[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *PointCloud) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += 4
+	for i := range m.Points {
+		length += m.Points[i].SerializedLength()
+	}
+	length += 4
+	for i := range m.Channels {
+		length += m.Channels[i].SerializedLength()
+	}
+	return length
+}
+
+func (m *PointCloud) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Points)))
+	buf.Write(b[:4])
+	for i := range m.Points {
+		if err := m.Points[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Channels)))
+	buf.Write(b[:4])
+	for i := range m.Channels {
+		if err := m.Channels[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *PointCloud) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*12 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Points = make([]geometry_msgs.Point32, size)
+		for i := range m.Points {
+			if err := m.Points[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*8 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Channels = make([]ChannelFloat32, size)
+		for i := range m.Channels {
+			if err := m.Channels[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
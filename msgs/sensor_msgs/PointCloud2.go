@@ -0,0 +1,310 @@
+// Automatically generated from the message definition "sensor_msgs/PointCloud2.msg"
+package sensor_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgPointCloud2 struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgPointCloud2) Text() string {
+	return t.text
+}
+
+func (t *_MsgPointCloud2) Name() string {
+	return t.name
+}
+
+func (t *_MsgPointCloud2) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgPointCloud2) NewMessage() ros.Message {
+	m := new(PointCloud2)
+	m.Header = std_msgs.Header{}
+	m.Height = 0
+	m.Width = 0
+	m.Fields = []PointField{}
+	m.IsBigendian = false
+	m.PointStep = 0
+	m.RowStep = 0
+	m.Data = []uint8{}
+	m.IsDense = false
+	return m
+}
+
+var (
+	MsgPointCloud2 = &_MsgPointCloud2{
+		`# This message holds a collection of N-dimensional points, which may
+# contain additional information such as normals, intensity, etc. The
+# point data is stored as a binary blob, its layout described by the
+# contents of the "fields" array.
+
+# The point cloud data may be organized 2d (image-like) or 1d
+# (unordered). Point clouds organized as 2d images may be produced by
+# camera depth sensors such as stereo or time-of-flight.
+
+# Time of sensor data acquisition, and the coordinate frame ID (for 3d
+# points).
+Header header
+
+# 2D structure of the point cloud. If the cloud is unordered, height is
+# 1 and width is the length of the point cloud.
+uint32 height
+uint32 width
+
+# Describes the channels and their layout in the binary data blob.
+PointField[] fields
+
+bool    is_bigendian # Is this data bigendian?
+uint32  point_step   # Length of a point in bytes
+uint32  row_step     # Length of a row in bytes
+uint8[] data         # Actual point data, size is (row_step*height)
+
+bool is_dense        # True if there are no invalid points
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: sensor_msgs/PointField
+# This message holds the description of one point entry in the
+# PointCloud2 message format.
+uint8 INT8    = 1
+uint8 UINT8   = 2
+uint8 INT16   = 3
+uint8 UINT16  = 4
+uint8 INT32   = 5
+uint8 UINT32  = 6
+uint8 FLOAT32 = 7
+uint8 FLOAT64 = 8
+
+string name      # Name of field
+uint32 offset    # Offset from start of point struct
+uint8  datatype  # Datatype enumeration, see above
+uint32 count     # How many elements in the field
+`,
+		"sensor_msgs/PointCloud2",
+		"1158d486dd51d683ce2f1be655c3c181",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgPointCloud2)
+}
+
+type PointCloud2 struct {
+	Header      std_msgs.Header `rosmsg:"header:Header"`
+	Height      uint32          `rosmsg:"height:uint32"`
+	Width       uint32          `rosmsg:"width:uint32"`
+	Fields      []PointField    `rosmsg:"fields:PointField[]"`
+	IsBigendian bool            `rosmsg:"is_bigendian:bool"`
+	PointStep   uint32          `rosmsg:"point_step:uint32"`
+	RowStep     uint32          `rosmsg:"row_step:uint32"`
+	Data        []uint8         `rosmsg:"data:uint8[]"`
+	IsDense     bool            `rosmsg:"is_dense:bool"`
+}
+
+func (m *PointCloud2) Type() ros.MessageType {
+	return MsgPointCloud2
+}
+
+func (m *PointCloud2) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *PointCloud2) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *PointCloud2) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *PointCloud2) Clone() *PointCloud2 {
+	c := *m
+	c.Header = *m.Header.Clone()
+	if m.Fields != nil {
+		c.Fields = make([]PointField, len(m.Fields))
+		for i := range m.Fields {
+			c.Fields[i] = *m.Fields[i].Clone()
+		}
+	}
+	if m.Data != nil {
+		c.Data = make([]uint8, len(m.Data))
+		copy(c.Data, m.Data)
+	}
+	return &c
+}
+
+func (m *PointCloud2) Equal(other *PointCloud2) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if m.Height != other.Height {
+		return false
+	}
+	if m.Width != other.Width {
+		return false
+	}
+	if len(m.Fields) != len(other.Fields) {
+		return false
+	}
+	for i := range m.Fields {
+		if !m.Fields[i].Equal(&other.Fields[i]) {
+			return false
+		}
+	}
+	if m.IsBigendian != other.IsBigendian {
+		return false
+	}
+	if m.PointStep != other.PointStep {
+		return false
+	}
+	if m.RowStep != other.RowStep {
+		return false
+	}
+	if !bytes.Equal(m.Data, other.Data) {
+		return false
+	}
+	if m.IsDense != other.IsDense {
+		return false
+	}
+	return true
+}
+
+func (m *PointCloud2) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += 4
+	length += 4
+	length += 4
+	for i := range m.Fields {
+		length += m.Fields[i].SerializedLength()
+	}
+	length += 1
+	length += 4
+	length += 4
+	length += 4
+	length += 1 * len(m.Data)
+	length += 1
+	return length
+}
+
+func (m *PointCloud2) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b[:], m.Height)
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], m.Width)
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Fields)))
+	buf.Write(b[:4])
+	for i := range m.Fields {
+		if err := m.Fields[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	if m.IsBigendian {
+		buf.WriteByte(1)
+	} else {
+		buf.WriteByte(0)
+	}
+	binary.LittleEndian.PutUint32(b[:], m.PointStep)
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], m.RowStep)
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Data)))
+	buf.Write(b[:4])
+	buf.Write(m.Data[:])
+	if m.IsDense {
+		buf.WriteByte(1)
+	} else {
+		buf.WriteByte(0)
+	}
+	return nil
+}
+
+func (m *PointCloud2) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Height = binary.LittleEndian.Uint32(b[:])
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Width = binary.LittleEndian.Uint32(b[:])
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*13 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Fields = make([]PointField, size)
+		for i := range m.Fields {
+			if err := m.Fields[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.IsBigendian = b[:][0] != 0
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.PointStep = binary.LittleEndian.Uint32(b[:])
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.RowStep = binary.LittleEndian.Uint32(b[:])
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*1 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Data = make([]uint8, size)
+		if _, err := io.ReadFull(buf, m.Data[:]); err != nil {
+			return err
+		}
+	}
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.IsDense = b[:][0] != 0
+	return nil
+}
@@ -0,0 +1,192 @@
+// Automatically generated from the message definition "sensor_msgs/PointField.msg"
+package sensor_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+const (
+	PointField_INT8    uint8 = 1
+	PointField_UINT8   uint8 = 2
+	PointField_INT16   uint8 = 3
+	PointField_UINT16  uint8 = 4
+	PointField_INT32   uint8 = 5
+	PointField_UINT32  uint8 = 6
+	PointField_FLOAT32 uint8 = 7
+	PointField_FLOAT64 uint8 = 8
+)
+
+// Names of the constants before they were scoped by the message.
+const (
+	// Deprecated: Use PointField_INT8.
+	INT8 = PointField_INT8
+	// Deprecated: Use PointField_UINT8.
+	UINT8 = PointField_UINT8
+	// Deprecated: Use PointField_INT16.
+	INT16 = PointField_INT16
+	// Deprecated: Use PointField_UINT16.
+	UINT16 = PointField_UINT16
+	// Deprecated: Use PointField_INT32.
+	INT32 = PointField_INT32
+	// Deprecated: Use PointField_UINT32.
+	UINT32 = PointField_UINT32
+	// Deprecated: Use PointField_FLOAT32.
+	FLOAT32 = PointField_FLOAT32
+	// Deprecated: Use PointField_FLOAT64.
+	FLOAT64 = PointField_FLOAT64
+)
+
+type _MsgPointField struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgPointField) Text() string {
+	return t.text
+}
+
+func (t *_MsgPointField) Name() string {
+	return t.name
+}
+
+func (t *_MsgPointField) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgPointField) NewMessage() ros.Message {
+	m := new(PointField)
+	m.Name = ""
+	m.Offset = 0
+	m.Datatype = 0
+	m.Count = 0
+	return m
+}
+
+var (
+	MsgPointField = &_MsgPointField{
+		`# This message holds the description of one point entry in the
+# PointCloud2 message format.
+uint8 INT8    = 1
+uint8 UINT8   = 2
+uint8 INT16   = 3
+uint8 UINT16  = 4
+uint8 INT32   = 5
+uint8 UINT32  = 6
+uint8 FLOAT32 = 7
+uint8 FLOAT64 = 8
+
+string name      # Name of field
+uint32 offset    # Offset from start of point struct
+uint8  datatype  # Datatype enumeration, see above
+uint32 count     # How many elements in the field
+`,
+		"sensor_msgs/PointField",
+		"268eacb2962780ceac86cbd17e328150",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgPointField)
+}
+
+type PointField struct {
+	Name     string `rosmsg:"name:string"`
+	Offset   uint32 `rosmsg:"offset:uint32"`
+	Datatype uint8  `rosmsg:"datatype:uint8"`
+	Count    uint32 `rosmsg:"count:uint32"`
+}
+
+func (m *PointField) Type() ros.MessageType {
+	return MsgPointField
+}
+
+func (m *PointField) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *PointField) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *PointField) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *PointField) Clone() *PointField {
+	c := *m
+	return &c
+}
+
+func (m *PointField) Equal(other *PointField) bool {
+	if m.Name != other.Name {
+		return false
+	}
+	if m.Offset != other.Offset {
+		return false
+	}
+	if m.Datatype != other.Datatype {
+		return false
+	}
+	if m.Count != other.Count {
+		return false
+	}
+	return true
+}
+
+func (m *PointField) SerializedLength() int {
+	length := 0
+	length += 4 + len(m.Name)
+	length += 4
+	length += 1
+	length += 4
+	return length
+}
+
+func (m *PointField) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Name)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Name)
+	binary.LittleEndian.PutUint32(b[:], m.Offset)
+	buf.Write(b[:4])
+	buf.WriteByte(m.Datatype)
+	binary.LittleEndian.PutUint32(b[:], m.Count)
+	buf.Write(b[:4])
+	return nil
+}
+
+func (m *PointField) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Name = string(data)
+	}
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Offset = binary.LittleEndian.Uint32(b[:])
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.Datatype = b[:][0]
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Count = binary.LittleEndian.Uint32(b[:])
+	return nil
+}
@@ -0,0 +1,217 @@
+// Automatically generated from the message definition "sensor_msgs/Range.msg"
+package sensor_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+const (
+	Range_ULTRASOUND uint8 = 0
+	Range_INFRARED   uint8 = 1
+)
+
+// Names of the constants before they were scoped by the message.
+const (
+	// Deprecated: Use Range_ULTRASOUND.
+	ULTRASOUND = Range_ULTRASOUND
+	// Deprecated: Use Range_INFRARED.
+	INFRARED = Range_INFRARED
+)
+
+type _MsgRange struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgRange) Text() string {
+	return t.text
+}
+
+func (t *_MsgRange) Name() string {
+	return t.name
+}
+
+func (t *_MsgRange) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgRange) NewMessage() ros.Message {
+	m := new(Range)
+	m.Header = std_msgs.Header{}
+	m.RadiationType = 0
+	m.FieldOfView = 0.0
+	m.MinRange = 0.0
+	m.MaxRange = 0.0
+	m.Range = 0.0
+	return m
+}
+
+var (
+	MsgRange = &_MsgRange{
+		`# Single range reading from an active ranger that emits energy and reports
+# one range reading that is valid along an arc at the distance measured. 
+# This message is  not appropriate for laser scanners. See the LaserScan
+# message if you are working with a laser scanner.
+
+Header header           # timestamp in the header is the time the ranger
+                        # returned the distance reading
+
+# Radiation type enums
+# If you want a value added to this list, send an email to the ros-users list
+uint8 ULTRASOUND=0
+uint8 INFRARED=1
+
+uint8 radiation_type    # the type of radiation used by the sensor
+                        # (sound, IR, etc) [enum]
+
+float32 field_of_view   # the size of the arc that the distance reading is
+                        # valid for [rad]
+
+float32 min_range       # minimum range value [m]
+float32 max_range       # maximum range value [m]
+                        # Fixed distance rangers require min_range==max_range
+
+float32 range           # range data [m]
+                        # (Note: values < range_min or > range_max
+                        # should be discarded)
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+`,
+		"sensor_msgs/Range",
+		"c005c34273dc426c67a020a87bc24148",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgRange)
+}
+
+type Range struct {
+	Header        std_msgs.Header `rosmsg:"header:Header"`
+	RadiationType uint8           `rosmsg:"radiation_type:uint8"`
+	FieldOfView   float32         `rosmsg:"field_of_view:float32"`
+	MinRange      float32         `rosmsg:"min_range:float32"`
+	MaxRange      float32         `rosmsg:"max_range:float32"`
+	Range         float32         `rosmsg:"range:float32"`
+}
+
+func (m *Range) Type() ros.MessageType {
+	return MsgRange
+}
+
+func (m *Range) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Range) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Range) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Range) Clone() *Range {
+	c := *m
+	c.Header = *m.Header.Clone()
+	return &c
+}
+
+func (m *Range) Equal(other *Range) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if m.RadiationType != other.RadiationType {
+		return false
+	}
+	if m.FieldOfView != other.FieldOfView {
+		return false
+	}
+	if m.MinRange != other.MinRange {
+		return false
+	}
+	if m.MaxRange != other.MaxRange {
+		return false
+	}
+	if m.Range != other.Range {
+		return false
+	}
+	return true
+}
+
+func (m *Range) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += 1
+	length += 4
+	length += 4
+	length += 4
+	length += 4
+	return length
+}
+
+func (m *Range) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	buf.WriteByte(m.RadiationType)
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.FieldOfView))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.MinRange))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.MaxRange))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.Range))
+	buf.Write(b[:4])
+	return nil
+}
+
+func (m *Range) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.RadiationType = b[:][0]
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.FieldOfView = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.MinRange = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.MaxRange = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Range = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	return nil
+}
@@ -0,0 +1,170 @@
+// Automatically generated from the message definition "sensor_msgs/RegionOfInterest.msg"
+package sensor_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgRegionOfInterest struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgRegionOfInterest) Text() string {
+	return t.text
+}
+
+func (t *_MsgRegionOfInterest) Name() string {
+	return t.name
+}
+
+func (t *_MsgRegionOfInterest) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgRegionOfInterest) NewMessage() ros.Message {
+	m := new(RegionOfInterest)
+	m.XOffset = 0
+	m.YOffset = 0
+	m.Height = 0
+	m.Width = 0
+	m.DoRectify = false
+	return m
+}
+
+var (
+	MsgRegionOfInterest = &_MsgRegionOfInterest{
+		`# This message is used to specify a region of interest within an image.
+#
+# When used to specify the ROI setting of the camera when the image was
+# taken, the height and width fields should either match the height and
+# width fields for the associated image; or height = width = 0
+# indicates that the full resolution image was captured.
+
+uint32 x_offset  # Leftmost pixel of the ROI
+                 # (0 if the ROI includes the left edge of the image)
+uint32 y_offset  # Topmost pixel of the ROI
+                 # (0 if the ROI includes the top edge of the image)
+uint32 height    # Height of ROI
+uint32 width     # Width of ROI
+
+# True if a distinct rectified ROI should be calculated from the "raw"
+# ROI in this message. Typically this should be False if the full image
+# is captured (ROI not used), and True if a subwindow is captured (ROI
+# used).
+bool do_rectify
+`,
+		"sensor_msgs/RegionOfInterest",
+		"bdb633039d588fcccb441a4d43ccfe09",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgRegionOfInterest)
+}
+
+type RegionOfInterest struct {
+	XOffset   uint32 `rosmsg:"x_offset:uint32"`
+	YOffset   uint32 `rosmsg:"y_offset:uint32"`
+	Height    uint32 `rosmsg:"height:uint32"`
+	Width     uint32 `rosmsg:"width:uint32"`
+	DoRectify bool   `rosmsg:"do_rectify:bool"`
+}
+
+func (m *RegionOfInterest) Type() ros.MessageType {
+	return MsgRegionOfInterest
+}
+
+func (m *RegionOfInterest) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *RegionOfInterest) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *RegionOfInterest) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *RegionOfInterest) Clone() *RegionOfInterest {
+	c := *m
+	return &c
+}
+
+func (m *RegionOfInterest) Equal(other *RegionOfInterest) bool {
+	if m.XOffset != other.XOffset {
+		return false
+	}
+	if m.YOffset != other.YOffset {
+		return false
+	}
+	if m.Height != other.Height {
+		return false
+	}
+	if m.Width != other.Width {
+		return false
+	}
+	if m.DoRectify != other.DoRectify {
+		return false
+	}
+	return true
+}
+
+func (m *RegionOfInterest) SerializedLength() int {
+	length := 0
+	length += 4
+	length += 4
+	length += 4
+	length += 4
+	length += 1
+	return length
+}
+
+func (m *RegionOfInterest) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:], m.XOffset)
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], m.YOffset)
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], m.Height)
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], m.Width)
+	buf.Write(b[:4])
+	if m.DoRectify {
+		buf.WriteByte(1)
+	} else {
+		buf.WriteByte(0)
+	}
+	return nil
+}
+
+func (m *RegionOfInterest) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.XOffset = binary.LittleEndian.Uint32(b[:])
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.YOffset = binary.LittleEndian.Uint32(b[:])
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Height = binary.LittleEndian.Uint32(b[:])
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Width = binary.LittleEndian.Uint32(b[:])
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.DoRectify = b[:][0] != 0
+	return nil
+}
@@ -0,0 +1,155 @@
+// Automatically generated from the message definition "sensor_msgs/RelativeHumidity.msg"
+package sensor_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgRelativeHumidity struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgRelativeHumidity) Text() string {
+	return t.text
+}
+
+func (t *_MsgRelativeHumidity) Name() string {
+	return t.name
+}
+
+func (t *_MsgRelativeHumidity) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgRelativeHumidity) NewMessage() ros.Message {
+	m := new(RelativeHumidity)
+	m.Header = std_msgs.Header{}
+	m.RelativeHumidity = 0.0
+	m.Variance = 0.0
+	return m
+}
+
+var (
+	MsgRelativeHumidity = &_MsgRelativeHumidity{
+		` # Single reading from a relative humidity sensor.  Defines the ratio of partial
+ # pressure of water vapor to the saturated vapor pressure at a temperature.
+
+ Header header             # timestamp of the measurement
+                           # frame_id is the location of the humidity sensor
+
+ float64 relative_humidity # Expression of the relative humidity
+                           # from 0.0 to 1.0.
+                           # 0.0 is no partial pressure of water vapor
+                           # 1.0 represents partial pressure of saturation
+
+ float64 variance          # 0 is interpreted as variance unknown
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+`,
+		"sensor_msgs/RelativeHumidity",
+		"8730015b05955b7e992ce29a2678d90f",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgRelativeHumidity)
+}
+
+type RelativeHumidity struct {
+	Header           std_msgs.Header `rosmsg:"header:Header"`
+	RelativeHumidity float64         `rosmsg:"relative_humidity:float64"`
+	Variance         float64         `rosmsg:"variance:float64"`
+}
+
+func (m *RelativeHumidity) Type() ros.MessageType {
+	return MsgRelativeHumidity
+}
+
+func (m *RelativeHumidity) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *RelativeHumidity) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *RelativeHumidity) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *RelativeHumidity) Clone() *RelativeHumidity {
+	c := *m
+	c.Header = *m.Header.Clone()
+	return &c
+}
+
+func (m *RelativeHumidity) Equal(other *RelativeHumidity) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if m.RelativeHumidity != other.RelativeHumidity {
+		return false
+	}
+	if m.Variance != other.Variance {
+		return false
+	}
+	return true
+}
+
+func (m *RelativeHumidity) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += 8
+	length += 8
+	return length
+}
+
+func (m *RelativeHumidity) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.RelativeHumidity))
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.Variance))
+	buf.Write(b[:8])
+	return nil
+}
+
+func (m *RelativeHumidity) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.RelativeHumidity = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Variance = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	return nil
+}
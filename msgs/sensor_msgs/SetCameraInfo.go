@@ -0,0 +1,59 @@
+// Automatically generated from the message definition "sensor_msgs/SetCameraInfo.srv"
+package sensor_msgs
+
+import (
+	"github.com/akio/rosgo/ros"
+)
+
+// Service type metadata
+type _SrvSetCameraInfo struct {
+	name    string
+	md5sum  string
+	text    string
+	reqType ros.MessageType
+	resType ros.MessageType
+}
+
+func (t *_SrvSetCameraInfo) Name() string                  { return t.name }
+func (t *_SrvSetCameraInfo) MD5Sum() string                { return t.md5sum }
+func (t *_SrvSetCameraInfo) Text() string                  { return t.text }
+func (t *_SrvSetCameraInfo) RequestType() ros.MessageType  { return t.reqType }
+func (t *_SrvSetCameraInfo) ResponseType() ros.MessageType { return t.resType }
+func (t *_SrvSetCameraInfo) NewService() ros.Service {
+	return new(SetCameraInfo)
+}
+
+var (
+	SrvSetCameraInfo = &_SrvSetCameraInfo{
+		"sensor_msgs/SetCameraInfo",
+		"bef1df590ed75ed1f393692395e15482",
+		`# This service requests that a camera stores the given CameraInfo 
+# as that camera's calibration information.
+#
+# The width and height in the camera_info field should match what the
+# camera is currently outputting on its camera_info topic, and the camera
+# will assume that the region of the imager that is being referred to is
+# the region that the camera is currently capturing.
+
+sensor_msgs/CameraInfo camera_info # The camera_info to store
+---
+bool success          # True if the call succeeded
+string status_message # Used to give details about success
+`,
+		MsgSetCameraInfoRequest,
+		MsgSetCameraInfoResponse,
+	}
+)
+
+func init() {
+	ros.RegisterServiceType(SrvSetCameraInfo)
+}
+
+type SetCameraInfo struct {
+	Request  SetCameraInfoRequest
+	Response SetCameraInfoResponse
+}
+
+func (s *SetCameraInfo) Type() ros.ServiceType   { return SrvSetCameraInfo }
+func (s *SetCameraInfo) ReqMessage() ros.Message { return &s.Request }
+func (s *SetCameraInfo) ResMessage() ros.Message { return &s.Response }
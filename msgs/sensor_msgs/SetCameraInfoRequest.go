@@ -0,0 +1,181 @@
+// Automatically generated from the message definition "sensor_msgs/SetCameraInfoRequest.msg"
+package sensor_msgs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/ros"
+)
+
+type _MsgSetCameraInfoRequest struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgSetCameraInfoRequest) Text() string {
+	return t.text
+}
+
+func (t *_MsgSetCameraInfoRequest) Name() string {
+	return t.name
+}
+
+func (t *_MsgSetCameraInfoRequest) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgSetCameraInfoRequest) NewMessage() ros.Message {
+	m := new(SetCameraInfoRequest)
+	m.CameraInfo = CameraInfo{}
+	return m
+}
+
+var (
+	MsgSetCameraInfoRequest = &_MsgSetCameraInfoRequest{
+		`# This service requests that a camera stores the given CameraInfo 
+# as that camera's calibration information.
+#
+# The width and height in the camera_info field should match what the
+# camera is currently outputting on its camera_info topic, and the camera
+# will assume that the region of the imager that is being referred to is
+# the region that the camera is currently capturing.
+
+sensor_msgs/CameraInfo camera_info # The camera_info to store
+
+================================================================================
+MSG: sensor_msgs/CameraInfo
+# This message defines meta information for a camera. It should be in a
+# camera namespace on topic "camera_info" and accompanied by up to five
+# image topics named:
+#
+#   image_raw - raw data from the camera driver, possibly Bayer encoded
+#   image            - monochrome, distorted
+#   image_color      - color, distorted
+#   image_rect       - monochrome, rectified
+#   image_rect_color - color, rectified
+#
+# See the image_proc and camera_calibration packages for the full
+# description of the fields.
+
+Header header    # Header timestamp should be acquisition time of image
+                 # Header frame_id should be optical frame of camera
+
+uint32 height    # The image dimensions with which the camera was calibrated.
+uint32 width
+
+string distortion_model # The distortion model used. Supported models are
+                        # listed in sensor_msgs/distortion_models.h.
+
+float64[] D      # The distortion parameters, size depending on the distortion model.
+
+float64[9]  K    # 3x3 row-major matrix: intrinsic camera matrix for the raw images.
+
+float64[9]  R    # 3x3 row-major matrix: rectification matrix (stereo cameras only)
+
+float64[12] P    # 3x4 row-major matrix: projection/camera matrix
+
+uint32 binning_x # Binning refers to any camera setting which combines
+uint32 binning_y # rectangular neighborhoods of pixels into larger "super-pixels."
+
+RegionOfInterest roi # Region of interest (subwindow of full camera resolution)
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: sensor_msgs/RegionOfInterest
+# This message is used to specify a region of interest within an image.
+#
+# When used to specify the ROI setting of the camera when the image was
+# taken, the height and width fields should either match the height and
+# width fields for the associated image; or height = width = 0
+# indicates that the full resolution image was captured.
+
+uint32 x_offset  # Leftmost pixel of the ROI
+                 # (0 if the ROI includes the left edge of the image)
+uint32 y_offset  # Topmost pixel of the ROI
+                 # (0 if the ROI includes the top edge of the image)
+uint32 height    # Height of ROI
+uint32 width     # Width of ROI
+
+# True if a distinct rectified ROI should be calculated from the "raw"
+# ROI in this message. Typically this should be False if the full image
+# is captured (ROI not used), and True if a subwindow is captured (ROI
+# used).
+bool do_rectify
+`,
+		"sensor_msgs/SetCameraInfoRequest",
+		"ee34be01fdeee563d0d99cd594d5581d",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgSetCameraInfoRequest)
+}
+
+type SetCameraInfoRequest struct {
+	CameraInfo CameraInfo `rosmsg:"camera_info:CameraInfo"`
+}
+
+func (m *SetCameraInfoRequest) Type() ros.MessageType {
+	return MsgSetCameraInfoRequest
+}
+
+func (m *SetCameraInfoRequest) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *SetCameraInfoRequest) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *SetCameraInfoRequest) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *SetCameraInfoRequest) Clone() *SetCameraInfoRequest {
+	c := *m
+	c.CameraInfo = *m.CameraInfo.Clone()
+	return &c
+}
+
+func (m *SetCameraInfoRequest) Equal(other *SetCameraInfoRequest) bool {
+	if !m.CameraInfo.Equal(&other.CameraInfo) {
+		return false
+	}
+	return true
+}
+
+func (m *SetCameraInfoRequest) SerializedLength() int {
+	length := 0
+	length += m.CameraInfo.SerializedLength()
+	return length
+}
+
+func (m *SetCameraInfoRequest) Serialize(buf *bytes.Buffer) error {
+	if err := m.CameraInfo.Serialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *SetCameraInfoRequest) Deserialize(buf *bytes.Reader) error {
+	if err := m.CameraInfo.Deserialize(buf); err != nil {
+		return err
+	}
+	return nil
+}
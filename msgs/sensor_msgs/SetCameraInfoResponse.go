@@ -0,0 +1,129 @@
+// Automatically generated from the message definition "sensor_msgs/SetCameraInfoResponse.msg"
+package sensor_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgSetCameraInfoResponse struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgSetCameraInfoResponse) Text() string {
+	return t.text
+}
+
+func (t *_MsgSetCameraInfoResponse) Name() string {
+	return t.name
+}
+
+func (t *_MsgSetCameraInfoResponse) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgSetCameraInfoResponse) NewMessage() ros.Message {
+	m := new(SetCameraInfoResponse)
+	m.Success = false
+	m.StatusMessage = ""
+	return m
+}
+
+var (
+	MsgSetCameraInfoResponse = &_MsgSetCameraInfoResponse{
+		`
+bool success          # True if the call succeeded
+string status_message # Used to give details about success
+`,
+		"sensor_msgs/SetCameraInfoResponse",
+		"2ec6f3eff0161f4257b808b12bc830c2",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgSetCameraInfoResponse)
+}
+
+type SetCameraInfoResponse struct {
+	Success       bool   `rosmsg:"success:bool"`
+	StatusMessage string `rosmsg:"status_message:string"`
+}
+
+func (m *SetCameraInfoResponse) Type() ros.MessageType {
+	return MsgSetCameraInfoResponse
+}
+
+func (m *SetCameraInfoResponse) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *SetCameraInfoResponse) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *SetCameraInfoResponse) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *SetCameraInfoResponse) Clone() *SetCameraInfoResponse {
+	c := *m
+	return &c
+}
+
+func (m *SetCameraInfoResponse) Equal(other *SetCameraInfoResponse) bool {
+	if m.Success != other.Success {
+		return false
+	}
+	if m.StatusMessage != other.StatusMessage {
+		return false
+	}
+	return true
+}
+
+func (m *SetCameraInfoResponse) SerializedLength() int {
+	length := 0
+	length += 1
+	length += 4 + len(m.StatusMessage)
+	return length
+}
+
+func (m *SetCameraInfoResponse) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if m.Success {
+		buf.WriteByte(1)
+	} else {
+		buf.WriteByte(0)
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.StatusMessage)))
+	buf.Write(b[:4])
+	buf.WriteString(m.StatusMessage)
+	return nil
+}
+
+func (m *SetCameraInfoResponse) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.Success = b[:][0] != 0
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.StatusMessage = string(data)
+	}
+	return nil
+}
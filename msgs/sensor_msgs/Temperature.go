@@ -0,0 +1,151 @@
+// Automatically generated from the message definition "sensor_msgs/Temperature.msg"
+package sensor_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgTemperature struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgTemperature) Text() string {
+	return t.text
+}
+
+func (t *_MsgTemperature) Name() string {
+	return t.name
+}
+
+func (t *_MsgTemperature) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgTemperature) NewMessage() ros.Message {
+	m := new(Temperature)
+	m.Header = std_msgs.Header{}
+	m.Temperature = 0.0
+	m.Variance = 0.0
+	return m
+}
+
+var (
+	MsgTemperature = &_MsgTemperature{
+		` # Single temperature reading.
+
+ Header header           # timestamp is the time the temperature was measured
+                         # frame_id is the location of the temperature reading
+
+ float64 temperature     # Measurement of the Temperature in Degrees Celsius
+
+ float64 variance        # 0 is interpreted as variance unknown
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+`,
+		"sensor_msgs/Temperature",
+		"ff71b307acdbe7c871a5a6d7ed359100",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgTemperature)
+}
+
+type Temperature struct {
+	Header      std_msgs.Header `rosmsg:"header:Header"`
+	Temperature float64         `rosmsg:"temperature:float64"`
+	Variance    float64         `rosmsg:"variance:float64"`
+}
+
+func (m *Temperature) Type() ros.MessageType {
+	return MsgTemperature
+}
+
+func (m *Temperature) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Temperature) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Temperature) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Temperature) Clone() *Temperature {
+	c := *m
+	c.Header = *m.Header.Clone()
+	return &c
+}
+
+func (m *Temperature) Equal(other *Temperature) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if m.Temperature != other.Temperature {
+		return false
+	}
+	if m.Variance != other.Variance {
+		return false
+	}
+	return true
+}
+
+func (m *Temperature) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += 8
+	length += 8
+	return length
+}
+
+func (m *Temperature) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.Temperature))
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.Variance))
+	buf.Write(b[:8])
+	return nil
+}
+
+func (m *Temperature) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Temperature = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Variance = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	return nil
+}
@@ -0,0 +1,162 @@
+// Automatically generated from the message definition "sensor_msgs/TimeReference.msg"
+package sensor_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/std_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgTimeReference struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgTimeReference) Text() string {
+	return t.text
+}
+
+func (t *_MsgTimeReference) Name() string {
+	return t.name
+}
+
+func (t *_MsgTimeReference) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgTimeReference) NewMessage() ros.Message {
+	m := new(TimeReference)
+	m.Header = std_msgs.Header{}
+	m.TimeRef = ros.Time{}
+	m.Source = ""
+	return m
+}
+
+var (
+	MsgTimeReference = &_MsgTimeReference{
+		`# Measurement from an external time source not actively synchronized with the system clock.
+
+Header header    # stamp is system time for which measurement was valid
+                 # frame_id is not used 
+
+time   time_ref  # corresponding time from this external source
+string source    # (optional) name of time source
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+`,
+		"sensor_msgs/TimeReference",
+		"fded64a0265108ba86c3d38fb11c0c16",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgTimeReference)
+}
+
+type TimeReference struct {
+	Header  std_msgs.Header `rosmsg:"header:Header"`
+	TimeRef ros.Time        `rosmsg:"time_ref:time"`
+	Source  string          `rosmsg:"source:string"`
+}
+
+func (m *TimeReference) Type() ros.MessageType {
+	return MsgTimeReference
+}
+
+func (m *TimeReference) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *TimeReference) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *TimeReference) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *TimeReference) Clone() *TimeReference {
+	c := *m
+	c.Header = *m.Header.Clone()
+	return &c
+}
+
+func (m *TimeReference) Equal(other *TimeReference) bool {
+	if !m.Header.Equal(&other.Header) {
+		return false
+	}
+	if m.TimeRef != other.TimeRef {
+		return false
+	}
+	if m.Source != other.Source {
+		return false
+	}
+	return true
+}
+
+func (m *TimeReference) SerializedLength() int {
+	length := 0
+	length += m.Header.SerializedLength()
+	length += 8
+	length += 4 + len(m.Source)
+	return length
+}
+
+func (m *TimeReference) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Header.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b[:], m.TimeRef.Sec)
+	binary.LittleEndian.PutUint32(b[4:], m.TimeRef.NSec)
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Source)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Source)
+	return nil
+}
+
+func (m *TimeReference) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Header.Deserialize(buf); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.TimeRef.Sec = binary.LittleEndian.Uint32(b[:])
+	m.TimeRef.NSec = binary.LittleEndian.Uint32(b[4:])
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Source = string(data)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+// Automatically generated from the message definition "std_msgs/Bool.msg"
+package std_msgs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgBool struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgBool) Text() string {
+	return t.text
+}
+
+func (t *_MsgBool) Name() string {
+	return t.name
+}
+
+func (t *_MsgBool) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgBool) NewMessage() ros.Message {
+	m := new(Bool)
+	m.Data = false
+	return m
+}
+
+var (
+	MsgBool = &_MsgBool{
+		`bool data
+`,
+		"std_msgs/Bool",
+		"8b94c1b53db61fb6aed406028ad6332a",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgBool)
+}
+
+type Bool struct {
+	Data bool `rosmsg:"data:bool"`
+}
+
+func (m *Bool) Type() ros.MessageType {
+	return MsgBool
+}
+
+func (m *Bool) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Bool) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Bool) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Bool) Clone() *Bool {
+	c := *m
+	return &c
+}
+
+func (m *Bool) Equal(other *Bool) bool {
+	if m.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+func (m *Bool) SerializedLength() int {
+	length := 0
+	length += 1
+	return length
+}
+
+func (m *Bool) Serialize(buf *bytes.Buffer) error {
+	if m.Data {
+		buf.WriteByte(1)
+	} else {
+		buf.WriteByte(0)
+	}
+	return nil
+}
+
+func (m *Bool) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.Data = b[:][0] != 0
+	return nil
+}
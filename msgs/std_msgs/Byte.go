@@ -0,0 +1,98 @@
+// Automatically generated from the message definition "std_msgs/Byte.msg"
+package std_msgs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgByte struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgByte) Text() string {
+	return t.text
+}
+
+func (t *_MsgByte) Name() string {
+	return t.name
+}
+
+func (t *_MsgByte) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgByte) NewMessage() ros.Message {
+	m := new(Byte)
+	m.Data = 0
+	return m
+}
+
+var (
+	MsgByte = &_MsgByte{
+		`byte data
+`,
+		"std_msgs/Byte",
+		"ad736a2e8818154c487bb80fe42ce43b",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgByte)
+}
+
+type Byte struct {
+	Data uint8 `rosmsg:"data:byte"`
+}
+
+func (m *Byte) Type() ros.MessageType {
+	return MsgByte
+}
+
+func (m *Byte) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Byte) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Byte) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Byte) Clone() *Byte {
+	c := *m
+	return &c
+}
+
+func (m *Byte) Equal(other *Byte) bool {
+	if m.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+func (m *Byte) SerializedLength() int {
+	length := 0
+	length += 1
+	return length
+}
+
+func (m *Byte) Serialize(buf *bytes.Buffer) error {
+	buf.WriteByte(m.Data)
+	return nil
+}
+
+func (m *Byte) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.Data = b[:][0]
+	return nil
+}
@@ -0,0 +1,148 @@
+// Automatically generated from the message definition "std_msgs/ByteMultiArray.msg"
+package std_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgByteMultiArray struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgByteMultiArray) Text() string {
+	return t.text
+}
+
+func (t *_MsgByteMultiArray) Name() string {
+	return t.name
+}
+
+func (t *_MsgByteMultiArray) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgByteMultiArray) NewMessage() ros.Message {
+	m := new(ByteMultiArray)
+	m.Layout = MultiArrayLayout{}
+	m.Data = []uint8{}
+	return m
+}
+
+var (
+	MsgByteMultiArray = &_MsgByteMultiArray{
+		`# Please look at the MultiArrayLayout message definition for
+# documentation on all multiarrays.
+
+MultiArrayLayout  layout        # specification of data layout
+byte[]            data          # array of data
+
+================================================================================
+MSG: std_msgs/MultiArrayLayout
+# The multiarray declares a generic multi-dimensional array of a
+# particular data type.  Dimensions are ordered from outer most
+# to inner most.
+
+MultiArrayDimension[] dim # Array of dimension properties
+uint32 data_offset        # padding elements at front of data
+
+================================================================================
+MSG: std_msgs/MultiArrayDimension
+string label   # label of given dimension
+uint32 size    # size of given dimension (in type units)
+uint32 stride  # stride of given dimension
+`,
+		"std_msgs/ByteMultiArray",
+		"70ea476cbcfd65ac2f68f3cda1e891fe",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgByteMultiArray)
+}
+
+type ByteMultiArray struct {
+	Layout MultiArrayLayout `rosmsg:"layout:MultiArrayLayout"`
+	Data   []uint8          `rosmsg:"data:byte[]"`
+}
+
+func (m *ByteMultiArray) Type() ros.MessageType {
+	return MsgByteMultiArray
+}
+
+func (m *ByteMultiArray) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *ByteMultiArray) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *ByteMultiArray) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *ByteMultiArray) Clone() *ByteMultiArray {
+	c := *m
+	c.Layout = *m.Layout.Clone()
+	if m.Data != nil {
+		c.Data = make([]uint8, len(m.Data))
+		copy(c.Data, m.Data)
+	}
+	return &c
+}
+
+func (m *ByteMultiArray) Equal(other *ByteMultiArray) bool {
+	if !m.Layout.Equal(&other.Layout) {
+		return false
+	}
+	if !bytes.Equal(m.Data, other.Data) {
+		return false
+	}
+	return true
+}
+
+func (m *ByteMultiArray) SerializedLength() int {
+	length := 0
+	length += m.Layout.SerializedLength()
+	length += 4
+	length += 1 * len(m.Data)
+	return length
+}
+
+func (m *ByteMultiArray) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Layout.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Data)))
+	buf.Write(b[:4])
+	buf.Write(m.Data[:])
+	return nil
+}
+
+func (m *ByteMultiArray) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Layout.Deserialize(buf); err != nil {
+		return err
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*1 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Data = make([]uint8, size)
+		if _, err := io.ReadFull(buf, m.Data[:]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
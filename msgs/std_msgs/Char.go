@@ -0,0 +1,98 @@
+// Automatically generated from the message definition "std_msgs/Char.msg"
+package std_msgs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgChar struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgChar) Text() string {
+	return t.text
+}
+
+func (t *_MsgChar) Name() string {
+	return t.name
+}
+
+func (t *_MsgChar) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgChar) NewMessage() ros.Message {
+	m := new(Char)
+	m.Data = 0
+	return m
+}
+
+var (
+	MsgChar = &_MsgChar{
+		`char data
+`,
+		"std_msgs/Char",
+		"1bf77f25acecdedba0e224b162199717",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgChar)
+}
+
+type Char struct {
+	Data uint8 `rosmsg:"data:char"`
+}
+
+func (m *Char) Type() ros.MessageType {
+	return MsgChar
+}
+
+func (m *Char) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Char) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Char) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Char) Clone() *Char {
+	c := *m
+	return &c
+}
+
+func (m *Char) Equal(other *Char) bool {
+	if m.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+func (m *Char) SerializedLength() int {
+	length := 0
+	length += 1
+	return length
+}
+
+func (m *Char) Serialize(buf *bytes.Buffer) error {
+	buf.WriteByte(m.Data)
+	return nil
+}
+
+func (m *Char) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.Data = b[:][0]
+	return nil
+}
@@ -0,0 +1,141 @@
+// Automatically generated from the message definition "std_msgs/ColorRGBA.msg"
+package std_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgColorRGBA struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgColorRGBA) Text() string {
+	return t.text
+}
+
+func (t *_MsgColorRGBA) Name() string {
+	return t.name
+}
+
+func (t *_MsgColorRGBA) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgColorRGBA) NewMessage() ros.Message {
+	m := new(ColorRGBA)
+	m.R = 0.0
+	m.G = 0.0
+	m.B = 0.0
+	m.A = 0.0
+	return m
+}
+
+var (
+	MsgColorRGBA = &_MsgColorRGBA{
+		`float32 r
+float32 g
+float32 b
+float32 a
+`,
+		"std_msgs/ColorRGBA",
+		"a29a96539573343b1310c73607334b00",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgColorRGBA)
+}
+
+type ColorRGBA struct {
+	R float32 `rosmsg:"r:float32"`
+	G float32 `rosmsg:"g:float32"`
+	B float32 `rosmsg:"b:float32"`
+	A float32 `rosmsg:"a:float32"`
+}
+
+func (m *ColorRGBA) Type() ros.MessageType {
+	return MsgColorRGBA
+}
+
+func (m *ColorRGBA) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *ColorRGBA) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *ColorRGBA) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *ColorRGBA) Clone() *ColorRGBA {
+	c := *m
+	return &c
+}
+
+func (m *ColorRGBA) Equal(other *ColorRGBA) bool {
+	if m.R != other.R {
+		return false
+	}
+	if m.G != other.G {
+		return false
+	}
+	if m.B != other.B {
+		return false
+	}
+	if m.A != other.A {
+		return false
+	}
+	return true
+}
+
+func (m *ColorRGBA) SerializedLength() int {
+	length := 0
+	length += 4
+	length += 4
+	length += 4
+	length += 4
+	return length
+}
+
+func (m *ColorRGBA) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.R))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.G))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.B))
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.A))
+	buf.Write(b[:4])
+	return nil
+}
+
+func (m *ColorRGBA) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.R = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.G = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.B = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.A = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	return nil
+}
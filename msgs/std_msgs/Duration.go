@@ -0,0 +1,103 @@
+// Automatically generated from the message definition "std_msgs/Duration.msg"
+package std_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgDuration struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgDuration) Text() string {
+	return t.text
+}
+
+func (t *_MsgDuration) Name() string {
+	return t.name
+}
+
+func (t *_MsgDuration) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgDuration) NewMessage() ros.Message {
+	m := new(Duration)
+	m.Data = ros.Duration{}
+	return m
+}
+
+var (
+	MsgDuration = &_MsgDuration{
+		`duration data
+`,
+		"std_msgs/Duration",
+		"3e286caf4241d664e55f3ad380e2ae46",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgDuration)
+}
+
+type Duration struct {
+	Data ros.Duration `rosmsg:"data:duration"`
+}
+
+func (m *Duration) Type() ros.MessageType {
+	return MsgDuration
+}
+
+func (m *Duration) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Duration) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Duration) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Duration) Clone() *Duration {
+	c := *m
+	return &c
+}
+
+func (m *Duration) Equal(other *Duration) bool {
+	if m.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+func (m *Duration) SerializedLength() int {
+	length := 0
+	length += 8
+	return length
+}
+
+func (m *Duration) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:], m.Data.Sec)
+	binary.LittleEndian.PutUint32(b[4:], m.Data.NSec)
+	buf.Write(b[:8])
+	return nil
+}
+
+func (m *Duration) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Data.Sec = binary.LittleEndian.Uint32(b[:])
+	m.Data.NSec = binary.LittleEndian.Uint32(b[4:])
+	return nil
+}
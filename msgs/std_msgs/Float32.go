@@ -0,0 +1,102 @@
+// Automatically generated from the message definition "std_msgs/Float32.msg"
+package std_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgFloat32 struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgFloat32) Text() string {
+	return t.text
+}
+
+func (t *_MsgFloat32) Name() string {
+	return t.name
+}
+
+func (t *_MsgFloat32) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgFloat32) NewMessage() ros.Message {
+	m := new(Float32)
+	m.Data = 0.0
+	return m
+}
+
+var (
+	MsgFloat32 = &_MsgFloat32{
+		`float32 data
+`,
+		"std_msgs/Float32",
+		"73fcbf46b49191e672908e50842a83d4",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgFloat32)
+}
+
+type Float32 struct {
+	Data float32 `rosmsg:"data:float32"`
+}
+
+func (m *Float32) Type() ros.MessageType {
+	return MsgFloat32
+}
+
+func (m *Float32) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Float32) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Float32) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Float32) Clone() *Float32 {
+	c := *m
+	return &c
+}
+
+func (m *Float32) Equal(other *Float32) bool {
+	if m.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+func (m *Float32) SerializedLength() int {
+	length := 0
+	length += 4
+	return length
+}
+
+func (m *Float32) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:], math.Float32bits(m.Data))
+	buf.Write(b[:4])
+	return nil
+}
+
+func (m *Float32) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Data = math.Float32frombits(binary.LittleEndian.Uint32(b[:]))
+	return nil
+}
@@ -0,0 +1,168 @@
+// Automatically generated from the message definition "std_msgs/Float32MultiArray.msg"
+package std_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgFloat32MultiArray struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgFloat32MultiArray) Text() string {
+	return t.text
+}
+
+func (t *_MsgFloat32MultiArray) Name() string {
+	return t.name
+}
+
+func (t *_MsgFloat32MultiArray) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgFloat32MultiArray) NewMessage() ros.Message {
+	m := new(Float32MultiArray)
+	m.Layout = MultiArrayLayout{}
+	m.Data = []float32{}
+	return m
+}
+
+var (
+	MsgFloat32MultiArray = &_MsgFloat32MultiArray{
+		`# Please look at the MultiArrayLayout message definition for
+# documentation on all multiarrays.
+
+MultiArrayLayout  layout        # specification of data layout
+float32[]            data          # array of data
+
+================================================================================
+MSG: std_msgs/MultiArrayLayout
+# The multiarray declares a generic multi-dimensional array of a
+# particular data type.  Dimensions are ordered from outer most
+# to inner most.
+
+MultiArrayDimension[] dim # Array of dimension properties
+uint32 data_offset        # padding elements at front of data
+
+================================================================================
+MSG: std_msgs/MultiArrayDimension
+string label   # label of given dimension
+uint32 size    # size of given dimension (in type units)
+uint32 stride  # stride of given dimension
+`,
+		"std_msgs/Float32MultiArray",
+		"6a40e0ffa6a17a503ac3f8616991b1f6",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgFloat32MultiArray)
+}
+
+type Float32MultiArray struct {
+	Layout MultiArrayLayout `rosmsg:"layout:MultiArrayLayout"`
+	Data   []float32        `rosmsg:"data:float32[]"`
+}
+
+func (m *Float32MultiArray) Type() ros.MessageType {
+	return MsgFloat32MultiArray
+}
+
+func (m *Float32MultiArray) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Float32MultiArray) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Float32MultiArray) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Float32MultiArray) Clone() *Float32MultiArray {
+	c := *m
+	c.Layout = *m.Layout.Clone()
+	if m.Data != nil {
+		c.Data = make([]float32, len(m.Data))
+		copy(c.Data, m.Data)
+	}
+	return &c
+}
+
+func (m *Float32MultiArray) Equal(other *Float32MultiArray) bool {
+	if !m.Layout.Equal(&other.Layout) {
+		return false
+	}
+	if len(m.Data) != len(other.Data) {
+		return false
+	}
+	for i := range m.Data {
+		if m.Data[i] != other.Data[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Float32MultiArray) SerializedLength() int {
+	length := 0
+	length += m.Layout.SerializedLength()
+	length += 4
+	length += 4 * len(m.Data)
+	return length
+}
+
+func (m *Float32MultiArray) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Layout.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Data)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 4 * len(m.Data)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.Data {
+			binary.LittleEndian.PutUint32(data[4*i:], math.Float32bits(e))
+		}
+		buf.Write(data)
+	}
+	return nil
+}
+
+func (m *Float32MultiArray) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Layout.Deserialize(buf); err != nil {
+		return err
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*4 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Data = make([]float32, size)
+		data := make([]byte, 4*len(m.Data))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.Data {
+			m.Data[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[4*i:]))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+// Automatically generated from the message definition "std_msgs/Float64.msg"
+package std_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgFloat64 struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgFloat64) Text() string {
+	return t.text
+}
+
+func (t *_MsgFloat64) Name() string {
+	return t.name
+}
+
+func (t *_MsgFloat64) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgFloat64) NewMessage() ros.Message {
+	m := new(Float64)
+	m.Data = 0.0
+	return m
+}
+
+var (
+	MsgFloat64 = &_MsgFloat64{
+		`float64 data
+`,
+		"std_msgs/Float64",
+		"fdb28210bfa9d7c91146260178d9a584",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgFloat64)
+}
+
+type Float64 struct {
+	Data float64 `rosmsg:"data:float64"`
+}
+
+func (m *Float64) Type() ros.MessageType {
+	return MsgFloat64
+}
+
+func (m *Float64) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Float64) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Float64) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Float64) Clone() *Float64 {
+	c := *m
+	return &c
+}
+
+func (m *Float64) Equal(other *Float64) bool {
+	if m.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+func (m *Float64) SerializedLength() int {
+	length := 0
+	length += 8
+	return length
+}
+
+func (m *Float64) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.Data))
+	buf.Write(b[:8])
+	return nil
+}
+
+func (m *Float64) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Data = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	return nil
+}
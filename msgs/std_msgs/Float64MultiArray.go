@@ -0,0 +1,168 @@
+// Automatically generated from the message definition "std_msgs/Float64MultiArray.msg"
+package std_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+	"math"
+)
+
+type _MsgFloat64MultiArray struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgFloat64MultiArray) Text() string {
+	return t.text
+}
+
+func (t *_MsgFloat64MultiArray) Name() string {
+	return t.name
+}
+
+func (t *_MsgFloat64MultiArray) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgFloat64MultiArray) NewMessage() ros.Message {
+	m := new(Float64MultiArray)
+	m.Layout = MultiArrayLayout{}
+	m.Data = []float64{}
+	return m
+}
+
+var (
+	MsgFloat64MultiArray = &_MsgFloat64MultiArray{
+		`# Please look at the MultiArrayLayout message definition for
+# documentation on all multiarrays.
+
+MultiArrayLayout  layout        # specification of data layout
+float64[]            data          # array of data
+
+================================================================================
+MSG: std_msgs/MultiArrayLayout
+# The multiarray declares a generic multi-dimensional array of a
+# particular data type.  Dimensions are ordered from outer most
+# to inner most.
+
+MultiArrayDimension[] dim # Array of dimension properties
+uint32 data_offset        # padding elements at front of data
+
+================================================================================
+MSG: std_msgs/MultiArrayDimension
+string label   # label of given dimension
+uint32 size    # size of given dimension (in type units)
+uint32 stride  # stride of given dimension
+`,
+		"std_msgs/Float64MultiArray",
+		"4b7d974086d4060e7db4613a7e6c3ba4",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgFloat64MultiArray)
+}
+
+type Float64MultiArray struct {
+	Layout MultiArrayLayout `rosmsg:"layout:MultiArrayLayout"`
+	Data   []float64        `rosmsg:"data:float64[]"`
+}
+
+func (m *Float64MultiArray) Type() ros.MessageType {
+	return MsgFloat64MultiArray
+}
+
+func (m *Float64MultiArray) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Float64MultiArray) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Float64MultiArray) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Float64MultiArray) Clone() *Float64MultiArray {
+	c := *m
+	c.Layout = *m.Layout.Clone()
+	if m.Data != nil {
+		c.Data = make([]float64, len(m.Data))
+		copy(c.Data, m.Data)
+	}
+	return &c
+}
+
+func (m *Float64MultiArray) Equal(other *Float64MultiArray) bool {
+	if !m.Layout.Equal(&other.Layout) {
+		return false
+	}
+	if len(m.Data) != len(other.Data) {
+		return false
+	}
+	for i := range m.Data {
+		if m.Data[i] != other.Data[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Float64MultiArray) SerializedLength() int {
+	length := 0
+	length += m.Layout.SerializedLength()
+	length += 4
+	length += 8 * len(m.Data)
+	return length
+}
+
+func (m *Float64MultiArray) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Layout.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Data)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 8 * len(m.Data)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.Data {
+			binary.LittleEndian.PutUint64(data[8*i:], math.Float64bits(e))
+		}
+		buf.Write(data)
+	}
+	return nil
+}
+
+func (m *Float64MultiArray) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Layout.Deserialize(buf); err != nil {
+		return err
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*8 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Data = make([]float64, size)
+		data := make([]byte, 8*len(m.Data))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.Data {
+			m.Data[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[8*i:]))
+		}
+	}
+	return nil
+}
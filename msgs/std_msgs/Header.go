@@ -0,0 +1,150 @@
+// Automatically generated from the message definition "std_msgs/Header.msg"
+package std_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgHeader struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgHeader) Text() string {
+	return t.text
+}
+
+func (t *_MsgHeader) Name() string {
+	return t.name
+}
+
+func (t *_MsgHeader) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgHeader) NewMessage() ros.Message {
+	m := new(Header)
+	m.Seq = 0
+	m.Stamp = ros.Time{}
+	m.FrameId = ""
+	return m
+}
+
+var (
+	MsgHeader = &_MsgHeader{
+		`# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+`,
+		"std_msgs/Header",
+		"2176decaecbce78abc3b96ef049fabed",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgHeader)
+}
+
+type Header struct {
+	Seq     uint32   `rosmsg:"seq:uint32"`
+	Stamp   ros.Time `rosmsg:"stamp:time"`
+	FrameId string   `rosmsg:"frame_id:string"`
+}
+
+func (m *Header) Type() ros.MessageType {
+	return MsgHeader
+}
+
+func (m *Header) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Header) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Header) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Header) Clone() *Header {
+	c := *m
+	return &c
+}
+
+func (m *Header) Equal(other *Header) bool {
+	if m.Seq != other.Seq {
+		return false
+	}
+	if m.Stamp != other.Stamp {
+		return false
+	}
+	if m.FrameId != other.FrameId {
+		return false
+	}
+	return true
+}
+
+func (m *Header) SerializedLength() int {
+	length := 0
+	length += 4
+	length += 8
+	length += 4 + len(m.FrameId)
+	return length
+}
+
+func (m *Header) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:], m.Seq)
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], m.Stamp.Sec)
+	binary.LittleEndian.PutUint32(b[4:], m.Stamp.NSec)
+	buf.Write(b[:8])
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.FrameId)))
+	buf.Write(b[:4])
+	buf.WriteString(m.FrameId)
+	return nil
+}
+
+func (m *Header) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Seq = binary.LittleEndian.Uint32(b[:])
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Stamp.Sec = binary.LittleEndian.Uint32(b[:])
+	m.Stamp.NSec = binary.LittleEndian.Uint32(b[4:])
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.FrameId = string(data)
+	}
+	return nil
+}
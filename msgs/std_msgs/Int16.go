@@ -0,0 +1,101 @@
+// Automatically generated from the message definition "std_msgs/Int16.msg"
+package std_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgInt16 struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgInt16) Text() string {
+	return t.text
+}
+
+func (t *_MsgInt16) Name() string {
+	return t.name
+}
+
+func (t *_MsgInt16) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgInt16) NewMessage() ros.Message {
+	m := new(Int16)
+	m.Data = 0
+	return m
+}
+
+var (
+	MsgInt16 = &_MsgInt16{
+		`int16 data
+`,
+		"std_msgs/Int16",
+		"8524586e34fbd7cb1c08c5f5f1ca0e57",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgInt16)
+}
+
+type Int16 struct {
+	Data int16 `rosmsg:"data:int16"`
+}
+
+func (m *Int16) Type() ros.MessageType {
+	return MsgInt16
+}
+
+func (m *Int16) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Int16) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Int16) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Int16) Clone() *Int16 {
+	c := *m
+	return &c
+}
+
+func (m *Int16) Equal(other *Int16) bool {
+	if m.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+func (m *Int16) SerializedLength() int {
+	length := 0
+	length += 2
+	return length
+}
+
+func (m *Int16) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint16(b[:], uint16(m.Data))
+	buf.Write(b[:2])
+	return nil
+}
+
+func (m *Int16) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:2]); err != nil {
+		return err
+	}
+	m.Data = int16(binary.LittleEndian.Uint16(b[:]))
+	return nil
+}
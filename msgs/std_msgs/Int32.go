@@ -0,0 +1,101 @@
+// Automatically generated from the message definition "std_msgs/Int32.msg"
+package std_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgInt32 struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgInt32) Text() string {
+	return t.text
+}
+
+func (t *_MsgInt32) Name() string {
+	return t.name
+}
+
+func (t *_MsgInt32) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgInt32) NewMessage() ros.Message {
+	m := new(Int32)
+	m.Data = 0
+	return m
+}
+
+var (
+	MsgInt32 = &_MsgInt32{
+		`int32 data
+`,
+		"std_msgs/Int32",
+		"da5909fbe378aeaf85e547e830cc1bb7",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgInt32)
+}
+
+type Int32 struct {
+	Data int32 `rosmsg:"data:int32"`
+}
+
+func (m *Int32) Type() ros.MessageType {
+	return MsgInt32
+}
+
+func (m *Int32) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Int32) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Int32) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Int32) Clone() *Int32 {
+	c := *m
+	return &c
+}
+
+func (m *Int32) Equal(other *Int32) bool {
+	if m.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+func (m *Int32) SerializedLength() int {
+	length := 0
+	length += 4
+	return length
+}
+
+func (m *Int32) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:], uint32(m.Data))
+	buf.Write(b[:4])
+	return nil
+}
+
+func (m *Int32) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Data = int32(binary.LittleEndian.Uint32(b[:]))
+	return nil
+}
@@ -0,0 +1,101 @@
+// Automatically generated from the message definition "std_msgs/Int64.msg"
+package std_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgInt64 struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgInt64) Text() string {
+	return t.text
+}
+
+func (t *_MsgInt64) Name() string {
+	return t.name
+}
+
+func (t *_MsgInt64) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgInt64) NewMessage() ros.Message {
+	m := new(Int64)
+	m.Data = 0
+	return m
+}
+
+var (
+	MsgInt64 = &_MsgInt64{
+		`int64 data
+`,
+		"std_msgs/Int64",
+		"34add168574510e6e17f5d23ecc077ef",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgInt64)
+}
+
+type Int64 struct {
+	Data int64 `rosmsg:"data:int64"`
+}
+
+func (m *Int64) Type() ros.MessageType {
+	return MsgInt64
+}
+
+func (m *Int64) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Int64) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Int64) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Int64) Clone() *Int64 {
+	c := *m
+	return &c
+}
+
+func (m *Int64) Equal(other *Int64) bool {
+	if m.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+func (m *Int64) SerializedLength() int {
+	length := 0
+	length += 8
+	return length
+}
+
+func (m *Int64) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], uint64(m.Data))
+	buf.Write(b[:8])
+	return nil
+}
+
+func (m *Int64) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Data = int64(binary.LittleEndian.Uint64(b[:]))
+	return nil
+}
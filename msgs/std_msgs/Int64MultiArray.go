@@ -0,0 +1,167 @@
+// Automatically generated from the message definition "std_msgs/Int64MultiArray.msg"
+package std_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgInt64MultiArray struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgInt64MultiArray) Text() string {
+	return t.text
+}
+
+func (t *_MsgInt64MultiArray) Name() string {
+	return t.name
+}
+
+func (t *_MsgInt64MultiArray) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgInt64MultiArray) NewMessage() ros.Message {
+	m := new(Int64MultiArray)
+	m.Layout = MultiArrayLayout{}
+	m.Data = []int64{}
+	return m
+}
+
+var (
+	MsgInt64MultiArray = &_MsgInt64MultiArray{
+		`# Please look at the MultiArrayLayout message definition for
+# documentation on all multiarrays.
+
+MultiArrayLayout  layout        # specification of data layout
+int64[]            data          # array of data
+
+================================================================================
+MSG: std_msgs/MultiArrayLayout
+# The multiarray declares a generic multi-dimensional array of a
+# particular data type.  Dimensions are ordered from outer most
+# to inner most.
+
+MultiArrayDimension[] dim # Array of dimension properties
+uint32 data_offset        # padding elements at front of data
+
+================================================================================
+MSG: std_msgs/MultiArrayDimension
+string label   # label of given dimension
+uint32 size    # size of given dimension (in type units)
+uint32 stride  # stride of given dimension
+`,
+		"std_msgs/Int64MultiArray",
+		"54865aa6c65be0448113a2afc6a49270",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgInt64MultiArray)
+}
+
+type Int64MultiArray struct {
+	Layout MultiArrayLayout `rosmsg:"layout:MultiArrayLayout"`
+	Data   []int64          `rosmsg:"data:int64[]"`
+}
+
+func (m *Int64MultiArray) Type() ros.MessageType {
+	return MsgInt64MultiArray
+}
+
+func (m *Int64MultiArray) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Int64MultiArray) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Int64MultiArray) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Int64MultiArray) Clone() *Int64MultiArray {
+	c := *m
+	c.Layout = *m.Layout.Clone()
+	if m.Data != nil {
+		c.Data = make([]int64, len(m.Data))
+		copy(c.Data, m.Data)
+	}
+	return &c
+}
+
+func (m *Int64MultiArray) Equal(other *Int64MultiArray) bool {
+	if !m.Layout.Equal(&other.Layout) {
+		return false
+	}
+	if len(m.Data) != len(other.Data) {
+		return false
+	}
+	for i := range m.Data {
+		if m.Data[i] != other.Data[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Int64MultiArray) SerializedLength() int {
+	length := 0
+	length += m.Layout.SerializedLength()
+	length += 4
+	length += 8 * len(m.Data)
+	return length
+}
+
+func (m *Int64MultiArray) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Layout.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Data)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 8 * len(m.Data)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.Data {
+			binary.LittleEndian.PutUint64(data[8*i:], uint64(e))
+		}
+		buf.Write(data)
+	}
+	return nil
+}
+
+func (m *Int64MultiArray) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Layout.Deserialize(buf); err != nil {
+		return err
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*8 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Data = make([]int64, size)
+		data := make([]byte, 8*len(m.Data))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.Data {
+			m.Data[i] = int64(binary.LittleEndian.Uint64(data[8*i:]))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+// Automatically generated from the message definition "std_msgs/Int8.msg"
+package std_msgs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgInt8 struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgInt8) Text() string {
+	return t.text
+}
+
+func (t *_MsgInt8) Name() string {
+	return t.name
+}
+
+func (t *_MsgInt8) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgInt8) NewMessage() ros.Message {
+	m := new(Int8)
+	m.Data = 0
+	return m
+}
+
+var (
+	MsgInt8 = &_MsgInt8{
+		`int8 data
+`,
+		"std_msgs/Int8",
+		"27ffa0c9c4b8fb8492252bcad9e5c57b",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgInt8)
+}
+
+type Int8 struct {
+	Data int8 `rosmsg:"data:int8"`
+}
+
+func (m *Int8) Type() ros.MessageType {
+	return MsgInt8
+}
+
+func (m *Int8) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Int8) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Int8) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Int8) Clone() *Int8 {
+	c := *m
+	return &c
+}
+
+func (m *Int8) Equal(other *Int8) bool {
+	if m.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+func (m *Int8) SerializedLength() int {
+	length := 0
+	length += 1
+	return length
+}
+
+func (m *Int8) Serialize(buf *bytes.Buffer) error {
+	buf.WriteByte(byte(m.Data))
+	return nil
+}
+
+func (m *Int8) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.Data = int8(b[:][0])
+	return nil
+}
@@ -0,0 +1,167 @@
+// Automatically generated from the message definition "std_msgs/Int8MultiArray.msg"
+package std_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgInt8MultiArray struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgInt8MultiArray) Text() string {
+	return t.text
+}
+
+func (t *_MsgInt8MultiArray) Name() string {
+	return t.name
+}
+
+func (t *_MsgInt8MultiArray) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgInt8MultiArray) NewMessage() ros.Message {
+	m := new(Int8MultiArray)
+	m.Layout = MultiArrayLayout{}
+	m.Data = []int8{}
+	return m
+}
+
+var (
+	MsgInt8MultiArray = &_MsgInt8MultiArray{
+		`# Please look at the MultiArrayLayout message definition for
+# documentation on all multiarrays.
+
+MultiArrayLayout  layout        # specification of data layout
+int8[]            data          # array of data
+
+================================================================================
+MSG: std_msgs/MultiArrayLayout
+# The multiarray declares a generic multi-dimensional array of a
+# particular data type.  Dimensions are ordered from outer most
+# to inner most.
+
+MultiArrayDimension[] dim # Array of dimension properties
+uint32 data_offset        # padding elements at front of data
+
+================================================================================
+MSG: std_msgs/MultiArrayDimension
+string label   # label of given dimension
+uint32 size    # size of given dimension (in type units)
+uint32 stride  # stride of given dimension
+`,
+		"std_msgs/Int8MultiArray",
+		"d7c1af35a1b4781bbe79e03dd94b7c13",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgInt8MultiArray)
+}
+
+type Int8MultiArray struct {
+	Layout MultiArrayLayout `rosmsg:"layout:MultiArrayLayout"`
+	Data   []int8           `rosmsg:"data:int8[]"`
+}
+
+func (m *Int8MultiArray) Type() ros.MessageType {
+	return MsgInt8MultiArray
+}
+
+func (m *Int8MultiArray) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Int8MultiArray) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Int8MultiArray) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Int8MultiArray) Clone() *Int8MultiArray {
+	c := *m
+	c.Layout = *m.Layout.Clone()
+	if m.Data != nil {
+		c.Data = make([]int8, len(m.Data))
+		copy(c.Data, m.Data)
+	}
+	return &c
+}
+
+func (m *Int8MultiArray) Equal(other *Int8MultiArray) bool {
+	if !m.Layout.Equal(&other.Layout) {
+		return false
+	}
+	if len(m.Data) != len(other.Data) {
+		return false
+	}
+	for i := range m.Data {
+		if m.Data[i] != other.Data[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Int8MultiArray) SerializedLength() int {
+	length := 0
+	length += m.Layout.SerializedLength()
+	length += 4
+	length += 1 * len(m.Data)
+	return length
+}
+
+func (m *Int8MultiArray) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Layout.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Data)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 1 * len(m.Data)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.Data {
+			data[1*i:][0] = uint8(e)
+		}
+		buf.Write(data)
+	}
+	return nil
+}
+
+func (m *Int8MultiArray) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Layout.Deserialize(buf); err != nil {
+		return err
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*1 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Data = make([]int8, size)
+		data := make([]byte, 1*len(m.Data))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.Data {
+			m.Data[i] = int8(data[1*i:][0])
+		}
+	}
+	return nil
+}
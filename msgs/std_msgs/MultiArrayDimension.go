@@ -0,0 +1,138 @@
+// Automatically generated from the message definition "std_msgs/MultiArrayDimension.msg"
+package std_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgMultiArrayDimension struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgMultiArrayDimension) Text() string {
+	return t.text
+}
+
+func (t *_MsgMultiArrayDimension) Name() string {
+	return t.name
+}
+
+func (t *_MsgMultiArrayDimension) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgMultiArrayDimension) NewMessage() ros.Message {
+	m := new(MultiArrayDimension)
+	m.Label = ""
+	m.Size = 0
+	m.Stride = 0
+	return m
+}
+
+var (
+	MsgMultiArrayDimension = &_MsgMultiArrayDimension{
+		`string label   # label of given dimension
+uint32 size    # size of given dimension (in type units)
+uint32 stride  # stride of given dimension
+`,
+		"std_msgs/MultiArrayDimension",
+		"4cd0c83a8683deae40ecdac60e53bfa8",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgMultiArrayDimension)
+}
+
+type MultiArrayDimension struct {
+	Label  string `rosmsg:"label:string"`
+	Size   uint32 `rosmsg:"size:uint32"`
+	Stride uint32 `rosmsg:"stride:uint32"`
+}
+
+func (m *MultiArrayDimension) Type() ros.MessageType {
+	return MsgMultiArrayDimension
+}
+
+func (m *MultiArrayDimension) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *MultiArrayDimension) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *MultiArrayDimension) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *MultiArrayDimension) Clone() *MultiArrayDimension {
+	c := *m
+	return &c
+}
+
+func (m *MultiArrayDimension) Equal(other *MultiArrayDimension) bool {
+	if m.Label != other.Label {
+		return false
+	}
+	if m.Size != other.Size {
+		return false
+	}
+	if m.Stride != other.Stride {
+		return false
+	}
+	return true
+}
+
+func (m *MultiArrayDimension) SerializedLength() int {
+	length := 0
+	length += 4 + len(m.Label)
+	length += 4
+	length += 4
+	return length
+}
+
+func (m *MultiArrayDimension) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Label)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Label)
+	binary.LittleEndian.PutUint32(b[:], m.Size)
+	buf.Write(b[:4])
+	binary.LittleEndian.PutUint32(b[:], m.Stride)
+	buf.Write(b[:4])
+	return nil
+}
+
+func (m *MultiArrayDimension) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Label = string(data)
+	}
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Size = binary.LittleEndian.Uint32(b[:])
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Stride = binary.LittleEndian.Uint32(b[:])
+	return nil
+}
@@ -0,0 +1,154 @@
+// Automatically generated from the message definition "std_msgs/MultiArrayLayout.msg"
+package std_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgMultiArrayLayout struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgMultiArrayLayout) Text() string {
+	return t.text
+}
+
+func (t *_MsgMultiArrayLayout) Name() string {
+	return t.name
+}
+
+func (t *_MsgMultiArrayLayout) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgMultiArrayLayout) NewMessage() ros.Message {
+	m := new(MultiArrayLayout)
+	m.Dim = []MultiArrayDimension{}
+	m.DataOffset = 0
+	return m
+}
+
+var (
+	MsgMultiArrayLayout = &_MsgMultiArrayLayout{
+		`# The multiarray declares a generic multi-dimensional array of a
+# particular data type.  Dimensions are ordered from outer most
+# to inner most.
+
+MultiArrayDimension[] dim # Array of dimension properties
+uint32 data_offset        # padding elements at front of data
+
+================================================================================
+MSG: std_msgs/MultiArrayDimension
+string label   # label of given dimension
+uint32 size    # size of given dimension (in type units)
+uint32 stride  # stride of given dimension
+`,
+		"std_msgs/MultiArrayLayout",
+		"0fed2a11c13e11c5571b4e2a995a91a3",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgMultiArrayLayout)
+}
+
+type MultiArrayLayout struct {
+	Dim        []MultiArrayDimension `rosmsg:"dim:MultiArrayDimension[]"`
+	DataOffset uint32                `rosmsg:"data_offset:uint32"`
+}
+
+func (m *MultiArrayLayout) Type() ros.MessageType {
+	return MsgMultiArrayLayout
+}
+
+func (m *MultiArrayLayout) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *MultiArrayLayout) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *MultiArrayLayout) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *MultiArrayLayout) Clone() *MultiArrayLayout {
+	c := *m
+	if m.Dim != nil {
+		c.Dim = make([]MultiArrayDimension, len(m.Dim))
+		for i := range m.Dim {
+			c.Dim[i] = *m.Dim[i].Clone()
+		}
+	}
+	return &c
+}
+
+func (m *MultiArrayLayout) Equal(other *MultiArrayLayout) bool {
+	if len(m.Dim) != len(other.Dim) {
+		return false
+	}
+	for i := range m.Dim {
+		if !m.Dim[i].Equal(&other.Dim[i]) {
+			return false
+		}
+	}
+	if m.DataOffset != other.DataOffset {
+		return false
+	}
+	return true
+}
+
+func (m *MultiArrayLayout) SerializedLength() int {
+	length := 0
+	length += 4
+	for i := range m.Dim {
+		length += m.Dim[i].SerializedLength()
+	}
+	length += 4
+	return length
+}
+
+func (m *MultiArrayLayout) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Dim)))
+	buf.Write(b[:4])
+	for i := range m.Dim {
+		if err := m.Dim[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	binary.LittleEndian.PutUint32(b[:], m.DataOffset)
+	buf.Write(b[:4])
+	return nil
+}
+
+func (m *MultiArrayLayout) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*12 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Dim = make([]MultiArrayDimension, size)
+		for i := range m.Dim {
+			if err := m.Dim[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.DataOffset = binary.LittleEndian.Uint32(b[:])
+	return nil
+}
@@ -0,0 +1,112 @@
+// Automatically generated from the message definition "std_msgs/String.msg"
+package std_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgString struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgString) Text() string {
+	return t.text
+}
+
+func (t *_MsgString) Name() string {
+	return t.name
+}
+
+func (t *_MsgString) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgString) NewMessage() ros.Message {
+	m := new(String)
+	m.Data = ""
+	return m
+}
+
+var (
+	MsgString = &_MsgString{
+		`string data
+`,
+		"std_msgs/String",
+		"992ce8a1687cec8c8bd883ec73ca41d1",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgString)
+}
+
+type String struct {
+	Data string `rosmsg:"data:string"`
+}
+
+func (m *String) Type() ros.MessageType {
+	return MsgString
+}
+
+func (m *String) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *String) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *String) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *String) Clone() *String {
+	c := *m
+	return &c
+}
+
+func (m *String) Equal(other *String) bool {
+	if m.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+func (m *String) SerializedLength() int {
+	length := 0
+	length += 4 + len(m.Data)
+	return length
+}
+
+func (m *String) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Data)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Data)
+	return nil
+}
+
+func (m *String) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Data = string(data)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+// Automatically generated from the message definition "std_msgs/Time.msg"
+package std_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgTime struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgTime) Text() string {
+	return t.text
+}
+
+func (t *_MsgTime) Name() string {
+	return t.name
+}
+
+func (t *_MsgTime) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgTime) NewMessage() ros.Message {
+	m := new(Time)
+	m.Data = ros.Time{}
+	return m
+}
+
+var (
+	MsgTime = &_MsgTime{
+		`time data
+`,
+		"std_msgs/Time",
+		"cd7166c74c552c311fbcc2fe5a7bc289",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgTime)
+}
+
+type Time struct {
+	Data ros.Time `rosmsg:"data:time"`
+}
+
+func (m *Time) Type() ros.MessageType {
+	return MsgTime
+}
+
+func (m *Time) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *Time) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *Time) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *Time) Clone() *Time {
+	c := *m
+	return &c
+}
+
+func (m *Time) Equal(other *Time) bool {
+	if m.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+func (m *Time) SerializedLength() int {
+	length := 0
+	length += 8
+	return length
+}
+
+func (m *Time) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:], m.Data.Sec)
+	binary.LittleEndian.PutUint32(b[4:], m.Data.NSec)
+	buf.Write(b[:8])
+	return nil
+}
+
+func (m *Time) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Data.Sec = binary.LittleEndian.Uint32(b[:])
+	m.Data.NSec = binary.LittleEndian.Uint32(b[4:])
+	return nil
+}
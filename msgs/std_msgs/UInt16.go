@@ -0,0 +1,101 @@
+// Automatically generated from the message definition "std_msgs/UInt16.msg"
+package std_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgUInt16 struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgUInt16) Text() string {
+	return t.text
+}
+
+func (t *_MsgUInt16) Name() string {
+	return t.name
+}
+
+func (t *_MsgUInt16) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgUInt16) NewMessage() ros.Message {
+	m := new(UInt16)
+	m.Data = 0
+	return m
+}
+
+var (
+	MsgUInt16 = &_MsgUInt16{
+		`uint16 data
+`,
+		"std_msgs/UInt16",
+		"1df79edf208b629fe6b81923a544552d",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgUInt16)
+}
+
+type UInt16 struct {
+	Data uint16 `rosmsg:"data:uint16"`
+}
+
+func (m *UInt16) Type() ros.MessageType {
+	return MsgUInt16
+}
+
+func (m *UInt16) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *UInt16) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *UInt16) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *UInt16) Clone() *UInt16 {
+	c := *m
+	return &c
+}
+
+func (m *UInt16) Equal(other *UInt16) bool {
+	if m.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+func (m *UInt16) SerializedLength() int {
+	length := 0
+	length += 2
+	return length
+}
+
+func (m *UInt16) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint16(b[:], m.Data)
+	buf.Write(b[:2])
+	return nil
+}
+
+func (m *UInt16) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:2]); err != nil {
+		return err
+	}
+	m.Data = binary.LittleEndian.Uint16(b[:])
+	return nil
+}
@@ -0,0 +1,167 @@
+// Automatically generated from the message definition "std_msgs/UInt16MultiArray.msg"
+package std_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgUInt16MultiArray struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgUInt16MultiArray) Text() string {
+	return t.text
+}
+
+func (t *_MsgUInt16MultiArray) Name() string {
+	return t.name
+}
+
+func (t *_MsgUInt16MultiArray) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgUInt16MultiArray) NewMessage() ros.Message {
+	m := new(UInt16MultiArray)
+	m.Layout = MultiArrayLayout{}
+	m.Data = []uint16{}
+	return m
+}
+
+var (
+	MsgUInt16MultiArray = &_MsgUInt16MultiArray{
+		`# Please look at the MultiArrayLayout message definition for
+# documentation on all multiarrays.
+
+MultiArrayLayout  layout        # specification of data layout
+uint16[]            data          # array of data
+
+================================================================================
+MSG: std_msgs/MultiArrayLayout
+# The multiarray declares a generic multi-dimensional array of a
+# particular data type.  Dimensions are ordered from outer most
+# to inner most.
+
+MultiArrayDimension[] dim # Array of dimension properties
+uint32 data_offset        # padding elements at front of data
+
+================================================================================
+MSG: std_msgs/MultiArrayDimension
+string label   # label of given dimension
+uint32 size    # size of given dimension (in type units)
+uint32 stride  # stride of given dimension
+`,
+		"std_msgs/UInt16MultiArray",
+		"52f264f1c973c4b73790d384c6cb4484",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgUInt16MultiArray)
+}
+
+type UInt16MultiArray struct {
+	Layout MultiArrayLayout `rosmsg:"layout:MultiArrayLayout"`
+	Data   []uint16         `rosmsg:"data:uint16[]"`
+}
+
+func (m *UInt16MultiArray) Type() ros.MessageType {
+	return MsgUInt16MultiArray
+}
+
+func (m *UInt16MultiArray) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *UInt16MultiArray) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *UInt16MultiArray) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *UInt16MultiArray) Clone() *UInt16MultiArray {
+	c := *m
+	c.Layout = *m.Layout.Clone()
+	if m.Data != nil {
+		c.Data = make([]uint16, len(m.Data))
+		copy(c.Data, m.Data)
+	}
+	return &c
+}
+
+func (m *UInt16MultiArray) Equal(other *UInt16MultiArray) bool {
+	if !m.Layout.Equal(&other.Layout) {
+		return false
+	}
+	if len(m.Data) != len(other.Data) {
+		return false
+	}
+	for i := range m.Data {
+		if m.Data[i] != other.Data[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *UInt16MultiArray) SerializedLength() int {
+	length := 0
+	length += m.Layout.SerializedLength()
+	length += 4
+	length += 2 * len(m.Data)
+	return length
+}
+
+func (m *UInt16MultiArray) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Layout.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Data)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 2 * len(m.Data)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.Data {
+			binary.LittleEndian.PutUint16(data[2*i:], e)
+		}
+		buf.Write(data)
+	}
+	return nil
+}
+
+func (m *UInt16MultiArray) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Layout.Deserialize(buf); err != nil {
+		return err
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*2 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Data = make([]uint16, size)
+		data := make([]byte, 2*len(m.Data))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.Data {
+			m.Data[i] = binary.LittleEndian.Uint16(data[2*i:])
+		}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+// Automatically generated from the message definition "std_msgs/UInt32.msg"
+package std_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgUInt32 struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgUInt32) Text() string {
+	return t.text
+}
+
+func (t *_MsgUInt32) Name() string {
+	return t.name
+}
+
+func (t *_MsgUInt32) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgUInt32) NewMessage() ros.Message {
+	m := new(UInt32)
+	m.Data = 0
+	return m
+}
+
+var (
+	MsgUInt32 = &_MsgUInt32{
+		`uint32 data
+`,
+		"std_msgs/UInt32",
+		"304a39449588c7f8ce2df6e8001c5fce",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgUInt32)
+}
+
+type UInt32 struct {
+	Data uint32 `rosmsg:"data:uint32"`
+}
+
+func (m *UInt32) Type() ros.MessageType {
+	return MsgUInt32
+}
+
+func (m *UInt32) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *UInt32) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *UInt32) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *UInt32) Clone() *UInt32 {
+	c := *m
+	return &c
+}
+
+func (m *UInt32) Equal(other *UInt32) bool {
+	if m.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+func (m *UInt32) SerializedLength() int {
+	length := 0
+	length += 4
+	return length
+}
+
+func (m *UInt32) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:], m.Data)
+	buf.Write(b[:4])
+	return nil
+}
+
+func (m *UInt32) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:4]); err != nil {
+		return err
+	}
+	m.Data = binary.LittleEndian.Uint32(b[:])
+	return nil
+}
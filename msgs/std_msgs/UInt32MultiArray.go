@@ -0,0 +1,167 @@
+// Automatically generated from the message definition "std_msgs/UInt32MultiArray.msg"
+package std_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgUInt32MultiArray struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgUInt32MultiArray) Text() string {
+	return t.text
+}
+
+func (t *_MsgUInt32MultiArray) Name() string {
+	return t.name
+}
+
+func (t *_MsgUInt32MultiArray) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgUInt32MultiArray) NewMessage() ros.Message {
+	m := new(UInt32MultiArray)
+	m.Layout = MultiArrayLayout{}
+	m.Data = []uint32{}
+	return m
+}
+
+var (
+	MsgUInt32MultiArray = &_MsgUInt32MultiArray{
+		`# Please look at the MultiArrayLayout message definition for
+# documentation on all multiarrays.
+
+MultiArrayLayout  layout        # specification of data layout
+uint32[]            data          # array of data
+
+================================================================================
+MSG: std_msgs/MultiArrayLayout
+# The multiarray declares a generic multi-dimensional array of a
+# particular data type.  Dimensions are ordered from outer most
+# to inner most.
+
+MultiArrayDimension[] dim # Array of dimension properties
+uint32 data_offset        # padding elements at front of data
+
+================================================================================
+MSG: std_msgs/MultiArrayDimension
+string label   # label of given dimension
+uint32 size    # size of given dimension (in type units)
+uint32 stride  # stride of given dimension
+`,
+		"std_msgs/UInt32MultiArray",
+		"4d6a180abc9be191b96a7eda6c8a233d",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgUInt32MultiArray)
+}
+
+type UInt32MultiArray struct {
+	Layout MultiArrayLayout `rosmsg:"layout:MultiArrayLayout"`
+	Data   []uint32         `rosmsg:"data:uint32[]"`
+}
+
+func (m *UInt32MultiArray) Type() ros.MessageType {
+	return MsgUInt32MultiArray
+}
+
+func (m *UInt32MultiArray) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *UInt32MultiArray) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *UInt32MultiArray) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *UInt32MultiArray) Clone() *UInt32MultiArray {
+	c := *m
+	c.Layout = *m.Layout.Clone()
+	if m.Data != nil {
+		c.Data = make([]uint32, len(m.Data))
+		copy(c.Data, m.Data)
+	}
+	return &c
+}
+
+func (m *UInt32MultiArray) Equal(other *UInt32MultiArray) bool {
+	if !m.Layout.Equal(&other.Layout) {
+		return false
+	}
+	if len(m.Data) != len(other.Data) {
+		return false
+	}
+	for i := range m.Data {
+		if m.Data[i] != other.Data[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *UInt32MultiArray) SerializedLength() int {
+	length := 0
+	length += m.Layout.SerializedLength()
+	length += 4
+	length += 4 * len(m.Data)
+	return length
+}
+
+func (m *UInt32MultiArray) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Layout.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Data)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 4 * len(m.Data)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.Data {
+			binary.LittleEndian.PutUint32(data[4*i:], e)
+		}
+		buf.Write(data)
+	}
+	return nil
+}
+
+func (m *UInt32MultiArray) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Layout.Deserialize(buf); err != nil {
+		return err
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*4 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Data = make([]uint32, size)
+		data := make([]byte, 4*len(m.Data))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.Data {
+			m.Data[i] = binary.LittleEndian.Uint32(data[4*i:])
+		}
+	}
+	return nil
+}
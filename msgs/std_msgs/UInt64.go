@@ -0,0 +1,101 @@
+// Automatically generated from the message definition "std_msgs/UInt64.msg"
+package std_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgUInt64 struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgUInt64) Text() string {
+	return t.text
+}
+
+func (t *_MsgUInt64) Name() string {
+	return t.name
+}
+
+func (t *_MsgUInt64) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgUInt64) NewMessage() ros.Message {
+	m := new(UInt64)
+	m.Data = 0
+	return m
+}
+
+var (
+	MsgUInt64 = &_MsgUInt64{
+		`uint64 data
+`,
+		"std_msgs/UInt64",
+		"1b2a79973e8bf53d7b53acb71299cb57",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgUInt64)
+}
+
+type UInt64 struct {
+	Data uint64 `rosmsg:"data:uint64"`
+}
+
+func (m *UInt64) Type() ros.MessageType {
+	return MsgUInt64
+}
+
+func (m *UInt64) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *UInt64) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *UInt64) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *UInt64) Clone() *UInt64 {
+	c := *m
+	return &c
+}
+
+func (m *UInt64) Equal(other *UInt64) bool {
+	if m.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+func (m *UInt64) SerializedLength() int {
+	length := 0
+	length += 8
+	return length
+}
+
+func (m *UInt64) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], m.Data)
+	buf.Write(b[:8])
+	return nil
+}
+
+func (m *UInt64) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:8]); err != nil {
+		return err
+	}
+	m.Data = binary.LittleEndian.Uint64(b[:])
+	return nil
+}
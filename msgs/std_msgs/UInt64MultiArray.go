@@ -0,0 +1,167 @@
+// Automatically generated from the message definition "std_msgs/UInt64MultiArray.msg"
+package std_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgUInt64MultiArray struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgUInt64MultiArray) Text() string {
+	return t.text
+}
+
+func (t *_MsgUInt64MultiArray) Name() string {
+	return t.name
+}
+
+func (t *_MsgUInt64MultiArray) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgUInt64MultiArray) NewMessage() ros.Message {
+	m := new(UInt64MultiArray)
+	m.Layout = MultiArrayLayout{}
+	m.Data = []uint64{}
+	return m
+}
+
+var (
+	MsgUInt64MultiArray = &_MsgUInt64MultiArray{
+		`# Please look at the MultiArrayLayout message definition for
+# documentation on all multiarrays.
+
+MultiArrayLayout  layout        # specification of data layout
+uint64[]            data          # array of data
+
+================================================================================
+MSG: std_msgs/MultiArrayLayout
+# The multiarray declares a generic multi-dimensional array of a
+# particular data type.  Dimensions are ordered from outer most
+# to inner most.
+
+MultiArrayDimension[] dim # Array of dimension properties
+uint32 data_offset        # padding elements at front of data
+
+================================================================================
+MSG: std_msgs/MultiArrayDimension
+string label   # label of given dimension
+uint32 size    # size of given dimension (in type units)
+uint32 stride  # stride of given dimension
+`,
+		"std_msgs/UInt64MultiArray",
+		"6088f127afb1d6c72927aa1247e945af",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgUInt64MultiArray)
+}
+
+type UInt64MultiArray struct {
+	Layout MultiArrayLayout `rosmsg:"layout:MultiArrayLayout"`
+	Data   []uint64         `rosmsg:"data:uint64[]"`
+}
+
+func (m *UInt64MultiArray) Type() ros.MessageType {
+	return MsgUInt64MultiArray
+}
+
+func (m *UInt64MultiArray) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *UInt64MultiArray) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *UInt64MultiArray) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *UInt64MultiArray) Clone() *UInt64MultiArray {
+	c := *m
+	c.Layout = *m.Layout.Clone()
+	if m.Data != nil {
+		c.Data = make([]uint64, len(m.Data))
+		copy(c.Data, m.Data)
+	}
+	return &c
+}
+
+func (m *UInt64MultiArray) Equal(other *UInt64MultiArray) bool {
+	if !m.Layout.Equal(&other.Layout) {
+		return false
+	}
+	if len(m.Data) != len(other.Data) {
+		return false
+	}
+	for i := range m.Data {
+		if m.Data[i] != other.Data[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *UInt64MultiArray) SerializedLength() int {
+	length := 0
+	length += m.Layout.SerializedLength()
+	length += 4
+	length += 8 * len(m.Data)
+	return length
+}
+
+func (m *UInt64MultiArray) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Layout.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Data)))
+	buf.Write(b[:4])
+	{
+		// Encode elements in place into the spare capacity of buf.
+		n := 8 * len(m.Data)
+		buf.Grow(n)
+		data := buf.Bytes()
+		data = data[len(data) : len(data)+n]
+		for i, e := range m.Data {
+			binary.LittleEndian.PutUint64(data[8*i:], e)
+		}
+		buf.Write(data)
+	}
+	return nil
+}
+
+func (m *UInt64MultiArray) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Layout.Deserialize(buf); err != nil {
+		return err
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*8 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Data = make([]uint64, size)
+		data := make([]byte, 8*len(m.Data))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		for i := range m.Data {
+			m.Data[i] = binary.LittleEndian.Uint64(data[8*i:])
+		}
+	}
+	return nil
+}
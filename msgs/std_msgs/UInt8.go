@@ -0,0 +1,98 @@
+// Automatically generated from the message definition "std_msgs/UInt8.msg"
+package std_msgs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgUInt8 struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgUInt8) Text() string {
+	return t.text
+}
+
+func (t *_MsgUInt8) Name() string {
+	return t.name
+}
+
+func (t *_MsgUInt8) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgUInt8) NewMessage() ros.Message {
+	m := new(UInt8)
+	m.Data = 0
+	return m
+}
+
+var (
+	MsgUInt8 = &_MsgUInt8{
+		`uint8 data
+`,
+		"std_msgs/UInt8",
+		"7c8164229e7d2c17eb95e9231617fdee",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgUInt8)
+}
+
+type UInt8 struct {
+	Data uint8 `rosmsg:"data:uint8"`
+}
+
+func (m *UInt8) Type() ros.MessageType {
+	return MsgUInt8
+}
+
+func (m *UInt8) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *UInt8) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *UInt8) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *UInt8) Clone() *UInt8 {
+	c := *m
+	return &c
+}
+
+func (m *UInt8) Equal(other *UInt8) bool {
+	if m.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+func (m *UInt8) SerializedLength() int {
+	length := 0
+	length += 1
+	return length
+}
+
+func (m *UInt8) Serialize(buf *bytes.Buffer) error {
+	buf.WriteByte(m.Data)
+	return nil
+}
+
+func (m *UInt8) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.Data = b[:][0]
+	return nil
+}
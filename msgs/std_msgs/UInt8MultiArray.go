@@ -0,0 +1,148 @@
+// Automatically generated from the message definition "std_msgs/UInt8MultiArray.msg"
+package std_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgUInt8MultiArray struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgUInt8MultiArray) Text() string {
+	return t.text
+}
+
+func (t *_MsgUInt8MultiArray) Name() string {
+	return t.name
+}
+
+func (t *_MsgUInt8MultiArray) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgUInt8MultiArray) NewMessage() ros.Message {
+	m := new(UInt8MultiArray)
+	m.Layout = MultiArrayLayout{}
+	m.Data = []uint8{}
+	return m
+}
+
+var (
+	MsgUInt8MultiArray = &_MsgUInt8MultiArray{
+		`# Please look at the MultiArrayLayout message definition for
+# documentation on all multiarrays.
+
+MultiArrayLayout  layout        # specification of data layout
+uint8[]            data          # array of data
+
+================================================================================
+MSG: std_msgs/MultiArrayLayout
+# The multiarray declares a generic multi-dimensional array of a
+# particular data type.  Dimensions are ordered from outer most
+# to inner most.
+
+MultiArrayDimension[] dim # Array of dimension properties
+uint32 data_offset        # padding elements at front of data
+
+================================================================================
+MSG: std_msgs/MultiArrayDimension
+string label   # label of given dimension
+uint32 size    # size of given dimension (in type units)
+uint32 stride  # stride of given dimension
+`,
+		"std_msgs/UInt8MultiArray",
+		"82373f1612381bb6ee473b5cd6f5d89c",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgUInt8MultiArray)
+}
+
+type UInt8MultiArray struct {
+	Layout MultiArrayLayout `rosmsg:"layout:MultiArrayLayout"`
+	Data   []uint8          `rosmsg:"data:uint8[]"`
+}
+
+func (m *UInt8MultiArray) Type() ros.MessageType {
+	return MsgUInt8MultiArray
+}
+
+func (m *UInt8MultiArray) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *UInt8MultiArray) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *UInt8MultiArray) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *UInt8MultiArray) Clone() *UInt8MultiArray {
+	c := *m
+	c.Layout = *m.Layout.Clone()
+	if m.Data != nil {
+		c.Data = make([]uint8, len(m.Data))
+		copy(c.Data, m.Data)
+	}
+	return &c
+}
+
+func (m *UInt8MultiArray) Equal(other *UInt8MultiArray) bool {
+	if !m.Layout.Equal(&other.Layout) {
+		return false
+	}
+	if !bytes.Equal(m.Data, other.Data) {
+		return false
+	}
+	return true
+}
+
+func (m *UInt8MultiArray) SerializedLength() int {
+	length := 0
+	length += m.Layout.SerializedLength()
+	length += 4
+	length += 1 * len(m.Data)
+	return length
+}
+
+func (m *UInt8MultiArray) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if err := m.Layout.Serialize(buf); err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Data)))
+	buf.Write(b[:4])
+	buf.Write(m.Data[:])
+	return nil
+}
+
+func (m *UInt8MultiArray) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if err := m.Layout.Deserialize(buf); err != nil {
+		return err
+	}
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*1 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Data = make([]uint8, size)
+		if _, err := io.ReadFull(buf, m.Data[:]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+// Automatically generated from the message definition "std_srvs/EmptyRequest.msg"
+package std_srvs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/ros"
+)
+
+type _MsgEmptyRequest struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgEmptyRequest) Text() string {
+	return t.text
+}
+
+func (t *_MsgEmptyRequest) Name() string {
+	return t.name
+}
+
+func (t *_MsgEmptyRequest) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgEmptyRequest) NewMessage() ros.Message {
+	m := new(EmptyRequest)
+	return m
+}
+
+var (
+	MsgEmptyRequest = &_MsgEmptyRequest{
+		``,
+		"std_srvs/EmptyRequest",
+		"d41d8cd98f00b204e9800998ecf8427e",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgEmptyRequest)
+}
+
+type EmptyRequest struct {
+}
+
+func (m *EmptyRequest) Type() ros.MessageType {
+	return MsgEmptyRequest
+}
+
+func (m *EmptyRequest) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *EmptyRequest) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *EmptyRequest) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *EmptyRequest) Clone() *EmptyRequest {
+	c := *m
+	return &c
+}
+
+func (m *EmptyRequest) Equal(other *EmptyRequest) bool {
+	return true
+}
+
+func (m *EmptyRequest) SerializedLength() int {
+	length := 0
+	return length
+}
+
+func (m *EmptyRequest) Serialize(buf *bytes.Buffer) error {
+	return nil
+}
+
+func (m *EmptyRequest) Deserialize(buf *bytes.Reader) error {
+	return nil
+}
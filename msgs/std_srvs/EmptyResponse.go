@@ -0,0 +1,85 @@
+// Automatically generated from the message definition "std_srvs/EmptyResponse.msg"
+package std_srvs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/ros"
+)
+
+type _MsgEmptyResponse struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgEmptyResponse) Text() string {
+	return t.text
+}
+
+func (t *_MsgEmptyResponse) Name() string {
+	return t.name
+}
+
+func (t *_MsgEmptyResponse) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgEmptyResponse) NewMessage() ros.Message {
+	m := new(EmptyResponse)
+	return m
+}
+
+var (
+	MsgEmptyResponse = &_MsgEmptyResponse{
+		`
+`,
+		"std_srvs/EmptyResponse",
+		"d41d8cd98f00b204e9800998ecf8427e",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgEmptyResponse)
+}
+
+type EmptyResponse struct {
+}
+
+func (m *EmptyResponse) Type() ros.MessageType {
+	return MsgEmptyResponse
+}
+
+func (m *EmptyResponse) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *EmptyResponse) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *EmptyResponse) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *EmptyResponse) Clone() *EmptyResponse {
+	c := *m
+	return &c
+}
+
+func (m *EmptyResponse) Equal(other *EmptyResponse) bool {
+	return true
+}
+
+func (m *EmptyResponse) SerializedLength() int {
+	length := 0
+	return length
+}
+
+func (m *EmptyResponse) Serialize(buf *bytes.Buffer) error {
+	return nil
+}
+
+func (m *EmptyResponse) Deserialize(buf *bytes.Reader) error {
+	return nil
+}
@@ -0,0 +1,102 @@
+// Automatically generated from the message definition "std_srvs/SetBoolRequest.msg"
+package std_srvs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgSetBoolRequest struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgSetBoolRequest) Text() string {
+	return t.text
+}
+
+func (t *_MsgSetBoolRequest) Name() string {
+	return t.name
+}
+
+func (t *_MsgSetBoolRequest) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgSetBoolRequest) NewMessage() ros.Message {
+	m := new(SetBoolRequest)
+	m.Data = false
+	return m
+}
+
+var (
+	MsgSetBoolRequest = &_MsgSetBoolRequest{
+		`bool data # e.g. for hardware enabling / disabling
+`,
+		"std_srvs/SetBoolRequest",
+		"8b94c1b53db61fb6aed406028ad6332a",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgSetBoolRequest)
+}
+
+type SetBoolRequest struct {
+	Data bool `rosmsg:"data:bool"`
+}
+
+func (m *SetBoolRequest) Type() ros.MessageType {
+	return MsgSetBoolRequest
+}
+
+func (m *SetBoolRequest) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *SetBoolRequest) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *SetBoolRequest) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *SetBoolRequest) Clone() *SetBoolRequest {
+	c := *m
+	return &c
+}
+
+func (m *SetBoolRequest) Equal(other *SetBoolRequest) bool {
+	if m.Data != other.Data {
+		return false
+	}
+	return true
+}
+
+func (m *SetBoolRequest) SerializedLength() int {
+	length := 0
+	length += 1
+	return length
+}
+
+func (m *SetBoolRequest) Serialize(buf *bytes.Buffer) error {
+	if m.Data {
+		buf.WriteByte(1)
+	} else {
+		buf.WriteByte(0)
+	}
+	return nil
+}
+
+func (m *SetBoolRequest) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.Data = b[:][0] != 0
+	return nil
+}
@@ -0,0 +1,129 @@
+// Automatically generated from the message definition "std_srvs/SetBoolResponse.msg"
+package std_srvs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgSetBoolResponse struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgSetBoolResponse) Text() string {
+	return t.text
+}
+
+func (t *_MsgSetBoolResponse) Name() string {
+	return t.name
+}
+
+func (t *_MsgSetBoolResponse) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgSetBoolResponse) NewMessage() ros.Message {
+	m := new(SetBoolResponse)
+	m.Success = false
+	m.Message = ""
+	return m
+}
+
+var (
+	MsgSetBoolResponse = &_MsgSetBoolResponse{
+		`
+bool success   # indicate successful run of triggered service
+string message # informational, e.g. for error messages
+`,
+		"std_srvs/SetBoolResponse",
+		"937c9679a518e3a18d831e57125ea522",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgSetBoolResponse)
+}
+
+type SetBoolResponse struct {
+	Success bool   `rosmsg:"success:bool"`
+	Message string `rosmsg:"message:string"`
+}
+
+func (m *SetBoolResponse) Type() ros.MessageType {
+	return MsgSetBoolResponse
+}
+
+func (m *SetBoolResponse) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *SetBoolResponse) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *SetBoolResponse) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *SetBoolResponse) Clone() *SetBoolResponse {
+	c := *m
+	return &c
+}
+
+func (m *SetBoolResponse) Equal(other *SetBoolResponse) bool {
+	if m.Success != other.Success {
+		return false
+	}
+	if m.Message != other.Message {
+		return false
+	}
+	return true
+}
+
+func (m *SetBoolResponse) SerializedLength() int {
+	length := 0
+	length += 1
+	length += 4 + len(m.Message)
+	return length
+}
+
+func (m *SetBoolResponse) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if m.Success {
+		buf.WriteByte(1)
+	} else {
+		buf.WriteByte(0)
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Message)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Message)
+	return nil
+}
+
+func (m *SetBoolResponse) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.Success = b[:][0] != 0
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Message = string(data)
+	}
+	return nil
+}
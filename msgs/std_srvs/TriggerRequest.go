@@ -0,0 +1,84 @@
+// Automatically generated from the message definition "std_srvs/TriggerRequest.msg"
+package std_srvs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/ros"
+)
+
+type _MsgTriggerRequest struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgTriggerRequest) Text() string {
+	return t.text
+}
+
+func (t *_MsgTriggerRequest) Name() string {
+	return t.name
+}
+
+func (t *_MsgTriggerRequest) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgTriggerRequest) NewMessage() ros.Message {
+	m := new(TriggerRequest)
+	return m
+}
+
+var (
+	MsgTriggerRequest = &_MsgTriggerRequest{
+		``,
+		"std_srvs/TriggerRequest",
+		"d41d8cd98f00b204e9800998ecf8427e",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgTriggerRequest)
+}
+
+type TriggerRequest struct {
+}
+
+func (m *TriggerRequest) Type() ros.MessageType {
+	return MsgTriggerRequest
+}
+
+func (m *TriggerRequest) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *TriggerRequest) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *TriggerRequest) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *TriggerRequest) Clone() *TriggerRequest {
+	c := *m
+	return &c
+}
+
+func (m *TriggerRequest) Equal(other *TriggerRequest) bool {
+	return true
+}
+
+func (m *TriggerRequest) SerializedLength() int {
+	length := 0
+	return length
+}
+
+func (m *TriggerRequest) Serialize(buf *bytes.Buffer) error {
+	return nil
+}
+
+func (m *TriggerRequest) Deserialize(buf *bytes.Reader) error {
+	return nil
+}
@@ -0,0 +1,129 @@
+// Automatically generated from the message definition "std_srvs/TriggerResponse.msg"
+package std_srvs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgTriggerResponse struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgTriggerResponse) Text() string {
+	return t.text
+}
+
+func (t *_MsgTriggerResponse) Name() string {
+	return t.name
+}
+
+func (t *_MsgTriggerResponse) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgTriggerResponse) NewMessage() ros.Message {
+	m := new(TriggerResponse)
+	m.Success = false
+	m.Message = ""
+	return m
+}
+
+var (
+	MsgTriggerResponse = &_MsgTriggerResponse{
+		`
+bool success   # indicate successful run of triggered service
+string message # informational, e.g. for error messages
+`,
+		"std_srvs/TriggerResponse",
+		"937c9679a518e3a18d831e57125ea522",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgTriggerResponse)
+}
+
+type TriggerResponse struct {
+	Success bool   `rosmsg:"success:bool"`
+	Message string `rosmsg:"message:string"`
+}
+
+func (m *TriggerResponse) Type() ros.MessageType {
+	return MsgTriggerResponse
+}
+
+func (m *TriggerResponse) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *TriggerResponse) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *TriggerResponse) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *TriggerResponse) Clone() *TriggerResponse {
+	c := *m
+	return &c
+}
+
+func (m *TriggerResponse) Equal(other *TriggerResponse) bool {
+	if m.Success != other.Success {
+		return false
+	}
+	if m.Message != other.Message {
+		return false
+	}
+	return true
+}
+
+func (m *TriggerResponse) SerializedLength() int {
+	length := 0
+	length += 1
+	length += 4 + len(m.Message)
+	return length
+}
+
+func (m *TriggerResponse) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	if m.Success {
+		buf.WriteByte(1)
+	} else {
+		buf.WriteByte(0)
+	}
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Message)))
+	buf.Write(b[:4])
+	buf.WriteString(m.Message)
+	return nil
+}
+
+func (m *TriggerResponse) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.Success = b[:][0] != 0
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.Message = string(data)
+	}
+	return nil
+}
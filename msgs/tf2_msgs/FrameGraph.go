@@ -0,0 +1,49 @@
+// Automatically generated from the message definition "tf2_msgs/FrameGraph.srv"
+package tf2_msgs
+
+import (
+	"github.com/akio/rosgo/ros"
+)
+
+// Service type metadata
+type _SrvFrameGraph struct {
+	name    string
+	md5sum  string
+	text    string
+	reqType ros.MessageType
+	resType ros.MessageType
+}
+
+func (t *_SrvFrameGraph) Name() string                  { return t.name }
+func (t *_SrvFrameGraph) MD5Sum() string                { return t.md5sum }
+func (t *_SrvFrameGraph) Text() string                  { return t.text }
+func (t *_SrvFrameGraph) RequestType() ros.MessageType  { return t.reqType }
+func (t *_SrvFrameGraph) ResponseType() ros.MessageType { return t.resType }
+func (t *_SrvFrameGraph) NewService() ros.Service {
+	return new(FrameGraph)
+}
+
+var (
+	SrvFrameGraph = &_SrvFrameGraph{
+		"tf2_msgs/FrameGraph",
+		"437ea58e9463815a0d511c7326b686b0",
+		`---
+string frame_yaml
+`,
+		MsgFrameGraphRequest,
+		MsgFrameGraphResponse,
+	}
+)
+
+func init() {
+	ros.RegisterServiceType(SrvFrameGraph)
+}
+
+type FrameGraph struct {
+	Request  FrameGraphRequest
+	Response FrameGraphResponse
+}
+
+func (s *FrameGraph) Type() ros.ServiceType   { return SrvFrameGraph }
+func (s *FrameGraph) ReqMessage() ros.Message { return &s.Request }
+func (s *FrameGraph) ResMessage() ros.Message { return &s.Response }
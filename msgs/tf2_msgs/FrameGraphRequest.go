@@ -0,0 +1,84 @@
+// Automatically generated from the message definition "tf2_msgs/FrameGraphRequest.msg"
+package tf2_msgs
+
+import (
+	"bytes"
+	"github.com/akio/rosgo/ros"
+)
+
+type _MsgFrameGraphRequest struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgFrameGraphRequest) Text() string {
+	return t.text
+}
+
+func (t *_MsgFrameGraphRequest) Name() string {
+	return t.name
+}
+
+func (t *_MsgFrameGraphRequest) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgFrameGraphRequest) NewMessage() ros.Message {
+	m := new(FrameGraphRequest)
+	return m
+}
+
+var (
+	MsgFrameGraphRequest = &_MsgFrameGraphRequest{
+		``,
+		"tf2_msgs/FrameGraphRequest",
+		"d41d8cd98f00b204e9800998ecf8427e",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgFrameGraphRequest)
+}
+
+type FrameGraphRequest struct {
+}
+
+func (m *FrameGraphRequest) Type() ros.MessageType {
+	return MsgFrameGraphRequest
+}
+
+func (m *FrameGraphRequest) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *FrameGraphRequest) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *FrameGraphRequest) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *FrameGraphRequest) Clone() *FrameGraphRequest {
+	c := *m
+	return &c
+}
+
+func (m *FrameGraphRequest) Equal(other *FrameGraphRequest) bool {
+	return true
+}
+
+func (m *FrameGraphRequest) SerializedLength() int {
+	length := 0
+	return length
+}
+
+func (m *FrameGraphRequest) Serialize(buf *bytes.Buffer) error {
+	return nil
+}
+
+func (m *FrameGraphRequest) Deserialize(buf *bytes.Reader) error {
+	return nil
+}
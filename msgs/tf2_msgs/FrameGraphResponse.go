@@ -0,0 +1,113 @@
+// Automatically generated from the message definition "tf2_msgs/FrameGraphResponse.msg"
+package tf2_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgFrameGraphResponse struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgFrameGraphResponse) Text() string {
+	return t.text
+}
+
+func (t *_MsgFrameGraphResponse) Name() string {
+	return t.name
+}
+
+func (t *_MsgFrameGraphResponse) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgFrameGraphResponse) NewMessage() ros.Message {
+	m := new(FrameGraphResponse)
+	m.FrameYaml = ""
+	return m
+}
+
+var (
+	MsgFrameGraphResponse = &_MsgFrameGraphResponse{
+		`
+string frame_yaml
+`,
+		"tf2_msgs/FrameGraphResponse",
+		"437ea58e9463815a0d511c7326b686b0",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgFrameGraphResponse)
+}
+
+type FrameGraphResponse struct {
+	FrameYaml string `rosmsg:"frame_yaml:string"`
+}
+
+func (m *FrameGraphResponse) Type() ros.MessageType {
+	return MsgFrameGraphResponse
+}
+
+func (m *FrameGraphResponse) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *FrameGraphResponse) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *FrameGraphResponse) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *FrameGraphResponse) Clone() *FrameGraphResponse {
+	c := *m
+	return &c
+}
+
+func (m *FrameGraphResponse) Equal(other *FrameGraphResponse) bool {
+	if m.FrameYaml != other.FrameYaml {
+		return false
+	}
+	return true
+}
+
+func (m *FrameGraphResponse) SerializedLength() int {
+	length := 0
+	length += 4 + len(m.FrameYaml)
+	return length
+}
+
+func (m *FrameGraphResponse) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.FrameYaml)))
+	buf.Write(b[:4])
+	buf.WriteString(m.FrameYaml)
+	return nil
+}
+
+func (m *FrameGraphResponse) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.FrameYaml = string(data)
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+// Automatically generated from the message definition "tf2_msgs/TF2Error.msg"
+package tf2_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+const (
+	TF2Error_NO_ERROR               uint8 = 0
+	TF2Error_LOOKUP_ERROR           uint8 = 1
+	TF2Error_CONNECTIVITY_ERROR     uint8 = 2
+	TF2Error_EXTRAPOLATION_ERROR    uint8 = 3
+	TF2Error_INVALID_ARGUMENT_ERROR uint8 = 4
+	TF2Error_TIMEOUT_ERROR          uint8 = 5
+	TF2Error_TRANSFORM_ERROR        uint8 = 6
+)
+
+// Names of the constants before they were scoped by the message.
+const (
+	// Deprecated: Use TF2Error_NO_ERROR.
+	NOERROR = TF2Error_NO_ERROR
+	// Deprecated: Use TF2Error_LOOKUP_ERROR.
+	LOOKUPERROR = TF2Error_LOOKUP_ERROR
+	// Deprecated: Use TF2Error_CONNECTIVITY_ERROR.
+	CONNECTIVITYERROR = TF2Error_CONNECTIVITY_ERROR
+	// Deprecated: Use TF2Error_EXTRAPOLATION_ERROR.
+	EXTRAPOLATIONERROR = TF2Error_EXTRAPOLATION_ERROR
+	// Deprecated: Use TF2Error_INVALID_ARGUMENT_ERROR.
+	INVALIDARGUMENTERROR = TF2Error_INVALID_ARGUMENT_ERROR
+	// Deprecated: Use TF2Error_TIMEOUT_ERROR.
+	TIMEOUTERROR = TF2Error_TIMEOUT_ERROR
+	// Deprecated: Use TF2Error_TRANSFORM_ERROR.
+	TRANSFORMERROR = TF2Error_TRANSFORM_ERROR
+)
+
+type _MsgTF2Error struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgTF2Error) Text() string {
+	return t.text
+}
+
+func (t *_MsgTF2Error) Name() string {
+	return t.name
+}
+
+func (t *_MsgTF2Error) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgTF2Error) NewMessage() ros.Message {
+	m := new(TF2Error)
+	m.Error = 0
+	m.ErrorString = ""
+	return m
+}
+
+var (
+	MsgTF2Error = &_MsgTF2Error{
+		`uint8 NO_ERROR = 0
+uint8 LOOKUP_ERROR = 1
+uint8 CONNECTIVITY_ERROR = 2
+uint8 EXTRAPOLATION_ERROR = 3
+uint8 INVALID_ARGUMENT_ERROR = 4
+uint8 TIMEOUT_ERROR = 5
+uint8 TRANSFORM_ERROR = 6
+
+uint8 error
+string error_string
+`,
+		"tf2_msgs/TF2Error",
+		"bc6848fd6fd750c92e38575618a4917d",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgTF2Error)
+}
+
+type TF2Error struct {
+	Error       uint8  `rosmsg:"error:uint8"`
+	ErrorString string `rosmsg:"error_string:string"`
+}
+
+func (m *TF2Error) Type() ros.MessageType {
+	return MsgTF2Error
+}
+
+func (m *TF2Error) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *TF2Error) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *TF2Error) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *TF2Error) Clone() *TF2Error {
+	c := *m
+	return &c
+}
+
+func (m *TF2Error) Equal(other *TF2Error) bool {
+	if m.Error != other.Error {
+		return false
+	}
+	if m.ErrorString != other.ErrorString {
+		return false
+	}
+	return true
+}
+
+func (m *TF2Error) SerializedLength() int {
+	length := 0
+	length += 1
+	length += 4 + len(m.ErrorString)
+	return length
+}
+
+func (m *TF2Error) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	buf.WriteByte(m.Error)
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.ErrorString)))
+	buf.Write(b[:4])
+	buf.WriteString(m.ErrorString)
+	return nil
+}
+
+func (m *TF2Error) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	if _, err := io.ReadFull(buf, b[:1]); err != nil {
+		return err
+	}
+	m.Error = b[:][0]
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		n := int(binary.LittleEndian.Uint32(b[:4]))
+		if int64(n) > int64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return err
+		}
+		m.ErrorString = string(data)
+	}
+	return nil
+}
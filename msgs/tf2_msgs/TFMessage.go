@@ -0,0 +1,190 @@
+// Automatically generated from the message definition "tf2_msgs/TFMessage.msg"
+package tf2_msgs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/akio/rosgo/msgs/geometry_msgs"
+	"github.com/akio/rosgo/ros"
+	"io"
+)
+
+type _MsgTFMessage struct {
+	text   string
+	name   string
+	md5sum string
+}
+
+func (t *_MsgTFMessage) Text() string {
+	return t.text
+}
+
+func (t *_MsgTFMessage) Name() string {
+	return t.name
+}
+
+func (t *_MsgTFMessage) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *_MsgTFMessage) NewMessage() ros.Message {
+	m := new(TFMessage)
+	m.Transforms = []geometry_msgs.TransformStamped{}
+	return m
+}
+
+var (
+	MsgTFMessage = &_MsgTFMessage{
+		`geometry_msgs/TransformStamped[] transforms
+
+================================================================================
+MSG: geometry_msgs/TransformStamped
+# This expresses a transform from coordinate frame header.frame_id
+# to the coordinate frame child_frame_id
+#
+# This message is mostly used by the 
+# <a href="http://wiki.ros.org/tf">tf</a> package. 
+# See its documentation for more information.
+
+Header header
+string child_frame_id # the frame id of the child frame
+Transform transform
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+# This is generally used to communicate timestamped data
+# in a particular coordinate frame.
+#
+# sequence ID: consecutively increasing ID
+uint32 seq
+#Two-integer timestamp that is expressed as:
+# * stamp.sec: seconds (stamp_secs) since epoch (in Python the variable is called 'secs')
+# * stamp.nsec: nanoseconds since stamp_secs (in Python the variable is called 'nsecs')
+# time-handling sugar is provided by the client library
+time stamp
+#Frame this data is associated with
+string frame_id
+
+================================================================================
+MSG: geometry_msgs/Transform
+# This represents the transform between two coordinate frames in free space.
+
+Vector3 translation
+Quaternion rotation
+
+================================================================================
+MSG: geometry_msgs/Vector3
+# This represents a vector in free space. 
+# It is only meant to represent a direction. Therefore, it does not
+# make sense to apply a translation to it (e.g., when applying a 
+# generic rigid transformation to a Vector3, tf2 will only apply the
+# rotation). If you want your data to be translatable too, use the
+# geometry_msgs/Point message instead.
+
+float64 x
+float64 y
+float64 z
+
+================================================================================
+MSG: geometry_msgs/Quaternion
+# This represents an orientation in free space in quaternion form.
+
+float64 x
+float64 y
+float64 z
+float64 w
+`,
+		"tf2_msgs/TFMessage",
+		"94810edda583a504dfda3829e70d7eec",
+	}
+)
+
+func init() {
+	ros.RegisterMessageType(MsgTFMessage)
+}
+
+type TFMessage struct {
+	Transforms []geometry_msgs.TransformStamped `rosmsg:"transforms:TransformStamped[]"`
+}
+
+func (m *TFMessage) Type() ros.MessageType {
+	return MsgTFMessage
+}
+
+func (m *TFMessage) MarshalJSON() ([]byte, error) {
+	return ros.MarshalJSON(m)
+}
+
+func (m *TFMessage) UnmarshalJSON(data []byte) error {
+	return ros.UnmarshalJSON(data, m)
+}
+
+func (m *TFMessage) String() string {
+	text, _ := ros.MarshalYAML(m)
+	return string(text)
+}
+
+func (m *TFMessage) Clone() *TFMessage {
+	c := *m
+	if m.Transforms != nil {
+		c.Transforms = make([]geometry_msgs.TransformStamped, len(m.Transforms))
+		for i := range m.Transforms {
+			c.Transforms[i] = *m.Transforms[i].Clone()
+		}
+	}
+	return &c
+}
+
+func (m *TFMessage) Equal(other *TFMessage) bool {
+	if len(m.Transforms) != len(other.Transforms) {
+		return false
+	}
+	for i := range m.Transforms {
+		if !m.Transforms[i].Equal(&other.Transforms[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *TFMessage) SerializedLength() int {
+	length := 0
+	length += 4
+	for i := range m.Transforms {
+		length += m.Transforms[i].SerializedLength()
+	}
+	return length
+}
+
+func (m *TFMessage) Serialize(buf *bytes.Buffer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint32(b[:4], uint32(len(m.Transforms)))
+	buf.Write(b[:4])
+	for i := range m.Transforms {
+		if err := m.Transforms[i].Serialize(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *TFMessage) Deserialize(buf *bytes.Reader) error {
+	var b [8]byte
+	{
+		if _, err := io.ReadFull(buf, b[:4]); err != nil {
+			return err
+		}
+		size := int(binary.LittleEndian.Uint32(b[:4]))
+		if uint64(size)*76 > uint64(buf.Len()) {
+			return io.ErrUnexpectedEOF
+		}
+		m.Transforms = make([]geometry_msgs.TransformStamped, size)
+		for i := range m.Transforms {
+			if err := m.Transforms[i].Deserialize(buf); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,546 @@
+package ros
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const dynamicMsgSeparator = "================================================================================"
+
+type dynamicField struct {
+	name      string
+	typeName  string // Base type. Message types are fully qualified.
+	isBuiltin bool
+	isArray   bool
+	arrayLen  int // -1 for variable length arrays
+}
+
+type dynamicConstant struct {
+	typeName  string
+	name      string
+	valueText string
+}
+
+type dynamicSpec struct {
+	fullName  string
+	fields    []dynamicField
+	constants []dynamicConstant
+}
+
+// DynamicMessageType is a MessageType built at runtime from a message
+// definition such as the `message_definition` field of a TCPROS
+// connection header. Messages of this type are decoded into a generic
+// tree instead of a generated struct.
+type DynamicMessageType struct {
+	name   string
+	text   string
+	md5sum string
+	specs  map[string]*dynamicSpec
+	// Least sizes on the wire of the message and its dependencies.
+	minSizes map[string]int
+}
+
+// NewDynamicMessageType parses the full message definition of `name`.
+// The definition is the message's own text followed by the text of its
+// dependencies, each preceded by a separator line and `MSG: <name>`.
+func NewDynamicMessageType(name string, definition string) (*DynamicMessageType, error) {
+	t := new(DynamicMessageType)
+	t.name = name
+	t.text = definition
+	t.specs = make(map[string]*dynamicSpec)
+
+	fullName := name
+	var lines []string
+	flush := func() error {
+		spec, err := parseDynamicSpec(fullName, lines)
+		if err != nil {
+			return err
+		}
+		t.specs[fullName] = spec
+		return nil
+	}
+	for _, line := range strings.Split(definition, "\n") {
+		if strings.TrimSpace(line) == dynamicMsgSeparator {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			fullName = ""
+			lines = nil
+		} else if fullName == "" && strings.HasPrefix(line, "MSG:") {
+			fullName = strings.TrimSpace(line[len("MSG:"):])
+		} else {
+			lines = append(lines, line)
+		}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	md5sum, err := t.computeMD5(name, map[string]bool{})
+	if err != nil {
+		return nil, err
+	}
+	t.md5sum = md5sum
+	t.minSizes = make(map[string]int)
+	if _, err := t.computeMinSize(name); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (t *DynamicMessageType) Text() string {
+	return t.text
+}
+
+func (t *DynamicMessageType) Name() string {
+	return t.name
+}
+
+func (t *DynamicMessageType) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *DynamicMessageType) NewMessage() Message {
+	m := new(DynamicMessage)
+	m.dynamicType = t
+	m.Data = t.zeroValue(t.name)
+	return m
+}
+
+// DynamicMessage holds a message decoded by a DynamicMessageType.
+// Data maps field names to values. Primitive fields are stored with their
+// Go types (int32, float64, string, Time, Duration...), nested messages
+// as map[string]interface{} and arrays as slices of those types.
+type DynamicMessage struct {
+	dynamicType *DynamicMessageType
+	Data        map[string]interface{}
+}
+
+func (m *DynamicMessage) Type() MessageType {
+	return m.dynamicType
+}
+
+func (m *DynamicMessage) Serialize(buf *bytes.Buffer) error {
+	return m.dynamicType.serializeMsg(buf, m.dynamicType.name, m.Data)
+}
+
+func (m *DynamicMessage) Deserialize(buf *bytes.Reader) error {
+	data, err := m.dynamicType.deserializeMsg(buf, m.dynamicType.name)
+	if err != nil {
+		return err
+	}
+	m.Data = data
+	return nil
+}
+
+func isDynamicBuiltinType(t string) bool {
+	switch t {
+	case "bool", "int8", "uint8", "byte", "char",
+		"int16", "uint16", "int32", "uint32", "int64", "uint64",
+		"float32", "float64", "string", "time", "duration":
+		return true
+	}
+	return false
+}
+
+func parseDynamicSpec(fullName string, lines []string) (*dynamicSpec, error) {
+	if fullName == "" {
+		return nil, fmt.Errorf("Message definition has a section without name")
+	}
+	spec := new(dynamicSpec)
+	spec.fullName = fullName
+	pkg := ""
+	if i := strings.Index(fullName, "/"); i >= 0 {
+		pkg = fullName[:i]
+	}
+	for lineno, line := range lines {
+		clean := strings.TrimSpace(strings.SplitN(line, "#", 2)[0])
+		if len(clean) == 0 {
+			continue
+		}
+		sep := strings.IndexFunc(clean, unicode.IsSpace)
+		if sep < 0 {
+			return nil, fmt.Errorf("[%s@%d] Invalid declaration: %s", fullName, lineno, line)
+		}
+		fieldType := clean[:sep]
+		if strings.Contains(clean, "=") && !strings.Contains(fieldType, "[") {
+			// Constant. String constants take everything right of '='.
+			var name, value string
+			if fieldType == "string" {
+				kv := strings.SplitN(strings.TrimSpace(line)[sep:], "=", 2)
+				name, value = strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1])
+			} else {
+				kv := strings.SplitN(clean[sep:], "=", 2)
+				name, value = strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1])
+			}
+			spec.constants = append(spec.constants, dynamicConstant{fieldType, name, value})
+			continue
+		}
+		field := dynamicField{name: strings.TrimSpace(clean[sep:])}
+		baseType := fieldType
+		if i := strings.Index(fieldType, "["); i >= 0 {
+			if !strings.HasSuffix(fieldType, "]") {
+				return nil, fmt.Errorf("[%s@%d] missing ']'", fullName, lineno)
+			}
+			baseType = fieldType[:i]
+			field.isArray = true
+			field.arrayLen = -1
+			if size := fieldType[i+1 : len(fieldType)-1]; size != "" {
+				n, err := strconv.ParseUint(size, 10, 31)
+				if err != nil {
+					return nil, fmt.Errorf("[%s@%d] %v", fullName, lineno, err)
+				}
+				field.arrayLen = int(n)
+			}
+		}
+		if isDynamicBuiltinType(baseType) {
+			field.isBuiltin = true
+		} else if baseType == "Header" {
+			baseType = "std_msgs/Header"
+		} else if !strings.Contains(baseType, "/") {
+			baseType = pkg + "/" + baseType
+		}
+		field.typeName = baseType
+		spec.fields = append(spec.fields, field)
+	}
+	return spec, nil
+}
+
+func (t *DynamicMessageType) lookup(fullName string) (*dynamicSpec, error) {
+	spec, ok := t.specs[fullName]
+	if !ok {
+		return nil, fmt.Errorf("Message definition of `%s` is not found", fullName)
+	}
+	return spec, nil
+}
+
+// Compute MD5 sum in the same way as genmsg does.
+func (t *DynamicMessageType) computeMD5(fullName string, visiting map[string]bool) (string, error) {
+	if visiting[fullName] {
+		return "", fmt.Errorf("Circular dependency on `%s`", fullName)
+	}
+	visiting[fullName] = true
+	defer delete(visiting, fullName)
+
+	spec, err := t.lookup(fullName)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	for _, c := range spec.constants {
+		buf.WriteString(fmt.Sprintf("%s %s=%s\n", c.typeName, c.name, c.valueText))
+	}
+	for _, f := range spec.fields {
+		if f.isBuiltin {
+			typeText := f.typeName
+			if f.isArray && f.arrayLen < 0 {
+				typeText += "[]"
+			} else if f.isArray {
+				typeText += fmt.Sprintf("[%d]", f.arrayLen)
+			}
+			buf.WriteString(fmt.Sprintf("%s %s\n", typeText, f.name))
+		} else {
+			submd5, err := t.computeMD5(f.typeName, visiting)
+			if err != nil {
+				return "", err
+			}
+			buf.WriteString(fmt.Sprintf("%s %s\n", submd5, f.name))
+		}
+	}
+	sum := md5.Sum([]byte(strings.Trim(buf.String(), "\n")))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func dynamicBuiltinZero(typeName string) interface{} {
+	switch typeName {
+	case "bool":
+		return false
+	case "int8":
+		return int8(0)
+	case "uint8", "byte", "char":
+		return uint8(0)
+	case "int16":
+		return int16(0)
+	case "uint16":
+		return uint16(0)
+	case "int32":
+		return int32(0)
+	case "uint32":
+		return uint32(0)
+	case "int64":
+		return int64(0)
+	case "uint64":
+		return uint64(0)
+	case "float32":
+		return float32(0)
+	case "float64":
+		return float64(0)
+	case "string":
+		return ""
+	case "time":
+		return Time{}
+	case "duration":
+		return Duration{}
+	}
+	return nil
+}
+
+func (t *DynamicMessageType) fieldZero(f dynamicField) interface{} {
+	if !f.isBuiltin {
+		return t.zeroValue(f.typeName)
+	}
+	return dynamicBuiltinZero(f.typeName)
+}
+
+func (t *DynamicMessageType) zeroValue(fullName string) map[string]interface{} {
+	data := make(map[string]interface{})
+	spec, err := t.lookup(fullName)
+	if err != nil {
+		return data
+	}
+	for _, f := range spec.fields {
+		data[f.name] = t.fieldDefault(f)
+	}
+	return data
+}
+
+// Zero value of a field including arrays.
+func (t *DynamicMessageType) fieldDefault(f dynamicField) interface{} {
+	if !f.isArray {
+		return t.fieldZero(f)
+	}
+	size := 0
+	if f.arrayLen > 0 {
+		size = f.arrayLen
+	}
+	elemType := reflect.TypeOf(t.fieldZero(f))
+	slice := reflect.MakeSlice(reflect.SliceOf(elemType), size, size)
+	for i := 0; i < size; i++ {
+		slice.Index(i).Set(reflect.ValueOf(t.fieldZero(f)))
+	}
+	return slice.Interface()
+}
+
+func (t *DynamicMessageType) serializeMsg(buf *bytes.Buffer, fullName string, data map[string]interface{}) error {
+	spec, err := t.lookup(fullName)
+	if err != nil {
+		return err
+	}
+	for _, f := range spec.fields {
+		value, ok := data[f.name]
+		if !ok {
+			value = t.fieldDefault(f)
+		}
+		if f.isArray {
+			array := reflect.ValueOf(value)
+			if array.Kind() != reflect.Slice && array.Kind() != reflect.Array {
+				return fmt.Errorf("Field `%s` of `%s` must be an array but %T", f.name, fullName, value)
+			}
+			if f.arrayLen < 0 {
+				binary.Write(buf, binary.LittleEndian, uint32(array.Len()))
+			} else if array.Len() != f.arrayLen {
+				return fmt.Errorf("Field `%s` of `%s` must have %d elements but %d", f.name, fullName, f.arrayLen, array.Len())
+			}
+			for i := 0; i < array.Len(); i++ {
+				if err := t.serializeField(buf, f, array.Index(i).Interface()); err != nil {
+					return err
+				}
+			}
+		} else if err := t.serializeField(buf, f, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *DynamicMessageType) serializeField(buf *bytes.Buffer, f dynamicField, value interface{}) error {
+	if !f.isBuiltin {
+		data, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("Field `%s` must be map[string]interface{} but %T", f.name, value)
+		}
+		return t.serializeMsg(buf, f.typeName, data)
+	}
+	zero := dynamicBuiltinZero(f.typeName)
+	if reflect.TypeOf(value) != reflect.TypeOf(zero) {
+		return fmt.Errorf("Field `%s` must be %T but %T", f.name, zero, value)
+	}
+	switch v := value.(type) {
+	case string:
+		binary.Write(buf, binary.LittleEndian, uint32(len(v)))
+		buf.WriteString(v)
+	case Time:
+		binary.Write(buf, binary.LittleEndian, v.Sec)
+		binary.Write(buf, binary.LittleEndian, v.NSec)
+	case Duration:
+		binary.Write(buf, binary.LittleEndian, v.Sec)
+		binary.Write(buf, binary.LittleEndian, v.NSec)
+	default:
+		return binary.Write(buf, binary.LittleEndian, v)
+	}
+	return nil
+}
+
+// Least size of a message on the wire, with empty strings and
+// variable-length arrays. Called after computeMD5 rejected cycles.
+func (t *DynamicMessageType) computeMinSize(fullName string) (int, error) {
+	if size, ok := t.minSizes[fullName]; ok {
+		return size, nil
+	}
+	spec, err := t.lookup(fullName)
+	if err != nil {
+		return 0, err
+	}
+	size := 0
+	for _, f := range spec.fields {
+		n := dynamicBuiltinMinSize(f.typeName)
+		if !f.isBuiltin {
+			if n, err = t.computeMinSize(f.typeName); err != nil {
+				return 0, err
+			}
+		}
+		if f.isArray && f.arrayLen < 0 {
+			// Only the length prefix, but the elements are sized for the
+			// decoder.
+			n = 4
+		} else if f.isArray {
+			n *= f.arrayLen
+		}
+		size += n
+	}
+	t.minSizes[fullName] = size
+	return size, nil
+}
+
+// Least size of an element of an array field on the wire, which bounds the
+// length prefix of the array by the remaining bytes. Elements of empty
+// messages take no bytes, so it is 0 for them.
+func (t *DynamicMessageType) elemMinSize(f dynamicField) int {
+	if !f.isBuiltin {
+		return t.minSizes[f.typeName]
+	}
+	return dynamicBuiltinMinSize(f.typeName)
+}
+
+// Least size of a builtin type on the wire.
+func dynamicBuiltinMinSize(typeName string) int {
+	switch typeName {
+	case "bool", "int8", "uint8", "byte", "char":
+		return 1
+	case "int16", "uint16":
+		return 2
+	case "int32", "uint32", "float32", "string":
+		return 4
+	case "int64", "uint64", "float64", "time", "duration":
+		return 8
+	}
+	return 0
+}
+
+func (t *DynamicMessageType) deserializeMsg(buf *bytes.Reader, fullName string) (map[string]interface{}, error) {
+	spec, err := t.lookup(fullName)
+	if err != nil {
+		return nil, err
+	}
+	data := make(map[string]interface{})
+	for _, f := range spec.fields {
+		if !f.isArray {
+			value, err := t.deserializeField(buf, f)
+			if err != nil {
+				return nil, err
+			}
+			data[f.name] = value
+			continue
+		}
+		size := f.arrayLen
+		capacity := size
+		if size < 0 {
+			var n uint32
+			if err := binary.Read(buf, binary.LittleEndian, &n); err != nil {
+				return nil, err
+			}
+			minSize := t.elemMinSize(f)
+			if uint64(n)*uint64(minSize) > uint64(buf.Len()) {
+				return nil, io.ErrUnexpectedEOF
+			}
+			size = int(n)
+			capacity = size
+			// Any length fits for elements taking no bytes, so the array
+			// only grows as they are decoded.
+			if minSize == 0 {
+				capacity = 0
+			}
+		}
+		if f.isBuiltin && (f.typeName == "uint8" || f.typeName == "byte" || f.typeName == "char") {
+			octets := make([]uint8, size)
+			if _, err := io.ReadFull(buf, octets); err != nil {
+				return nil, err
+			}
+			data[f.name] = octets
+			continue
+		}
+		elemType := reflect.TypeOf(t.fieldZero(f))
+		array := reflect.MakeSlice(reflect.SliceOf(elemType), 0, capacity)
+		for i := 0; i < size; i++ {
+			value, err := t.deserializeField(buf, f)
+			if err != nil {
+				return nil, err
+			}
+			array = reflect.Append(array, reflect.ValueOf(value))
+		}
+		data[f.name] = array.Interface()
+	}
+	return data, nil
+}
+
+func (t *DynamicMessageType) deserializeField(buf *bytes.Reader, f dynamicField) (interface{}, error) {
+	if !f.isBuiltin {
+		return t.deserializeMsg(buf, f.typeName)
+	}
+	switch f.typeName {
+	case "string":
+		var size uint32
+		if err := binary.Read(buf, binary.LittleEndian, &size); err != nil {
+			return nil, err
+		}
+		if int64(size) > int64(buf.Len()) {
+			return nil, io.ErrUnexpectedEOF
+		}
+		data := make([]byte, int(size))
+		if _, err := io.ReadFull(buf, data); err != nil {
+			return nil, err
+		}
+		return string(data), nil
+	case "time":
+		var v Time
+		if err := binary.Read(buf, binary.LittleEndian, &v.Sec); err != nil {
+			return nil, err
+		}
+		if err := binary.Read(buf, binary.LittleEndian, &v.NSec); err != nil {
+			return nil, err
+		}
+		return v, nil
+	case "duration":
+		var v Duration
+		if err := binary.Read(buf, binary.LittleEndian, &v.Sec); err != nil {
+			return nil, err
+		}
+		if err := binary.Read(buf, binary.LittleEndian, &v.NSec); err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+	ptr := reflect.New(reflect.TypeOf(dynamicBuiltinZero(f.typeName)))
+	if err := binary.Read(buf, binary.LittleEndian, ptr.Interface()); err != nil {
+		return nil, err
+	}
+	return ptr.Elem().Interface(), nil
+}
@@ -0,0 +1,197 @@
+package ros
+
+import (
+	"bytes"
+	"reflect"
+	"runtime"
+	"testing"
+)
+
+const pointStampedDefinition = `# This represents a Point with reference coordinate frame and timestamp
+Header header
+Point point
+
+================================================================================
+MSG: std_msgs/Header
+# Standard metadata for higher-level stamped data types.
+uint32 seq
+time stamp
+string frame_id
+
+================================================================================
+MSG: geometry_msgs/Point
+# This contains the position of a point in free space
+float64 x
+float64 y
+float64 z
+`
+
+func TestDynamicMessageTypeMD5Sum(t *testing.T) {
+	var tests = []struct {
+		name       string
+		definition string
+		md5sum     string
+	}{
+		{"std_msgs/String", "string data\n", "992ce8a1687cec8c8bd883ec73ca41d1"},
+		{"std_msgs/Header", "uint32 seq\ntime stamp\nstring frame_id\n", "2176decaecbce78abc3b96ef049fabed"},
+		{"std_msgs/UInt8MultiArray", "std_msgs/MultiArrayLayout layout\nuint8[] data\n" +
+			dynamicMsgSeparator + "\nMSG: std_msgs/MultiArrayLayout\nMultiArrayDimension[] dim\nuint32 data_offset\n" +
+			dynamicMsgSeparator + "\nMSG: std_msgs/MultiArrayDimension\nstring label\nuint32 size\nuint32 stride\n",
+			"82373f1612381bb6ee473b5cd6f5d89c"},
+		{"geometry_msgs/PointStamped", pointStampedDefinition, "c63aecb41bfdfd6b7e1fac37c7cbe7bf"},
+	}
+	for _, test := range tests {
+		msgType, err := NewDynamicMessageType(test.name, test.definition)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if msgType.MD5Sum() != test.md5sum {
+			t.Errorf("%s: expected %s but %s", test.name, test.md5sum, msgType.MD5Sum())
+		}
+	}
+}
+
+func TestDynamicMessageRoundTrip(t *testing.T) {
+	msgType, err := NewDynamicMessageType("geometry_msgs/PointStamped", pointStampedDefinition)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := []byte{
+		0x01, 0x00, 0x00, 0x00, // seq
+		0x02, 0x00, 0x00, 0x00, 0x03, 0x00, 0x00, 0x00, // stamp
+		0x03, 0x00, 0x00, 0x00, 'm', 'a', 'p', // frame_id
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, // x = 1.0
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40, // y = 2.0
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x08, 0x40, // z = 3.0
+	}
+	msg := msgType.NewMessage().(*DynamicMessage)
+	if err := msg.Deserialize(bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	header := msg.Data["header"].(map[string]interface{})
+	if header["seq"] != uint32(1) || header["stamp"] != NewTime(2, 3) || header["frame_id"] != "map" {
+		t.Error(header)
+	}
+	point := msg.Data["point"].(map[string]interface{})
+	if point["x"] != 1.0 || point["y"] != 2.0 || point["z"] != 3.0 {
+		t.Error(point)
+	}
+
+	var buf bytes.Buffer
+	if err := msg.Serialize(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), data) {
+		t.Errorf("expected %v but %v", data, buf.Bytes())
+	}
+}
+
+func TestDynamicMessageArrays(t *testing.T) {
+	msgType, err := NewDynamicMessageType("foo/Bar", "uint8[] octets\nstring[2] names\nint16[] values\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := msgType.NewMessage().(*DynamicMessage)
+	if names := msg.Data["names"].([]string); len(names) != 2 {
+		t.Error(names)
+	}
+	msg.Data["octets"] = []uint8{1, 2}
+	msg.Data["names"] = []string{"a", "bc"}
+	msg.Data["values"] = []int16{-1}
+
+	var buf bytes.Buffer
+	if err := msg.Serialize(&buf); err != nil {
+		t.Fatal(err)
+	}
+	decoded := msgType.NewMessage().(*DynamicMessage)
+	if err := decoded.Deserialize(bytes.NewReader(buf.Bytes())); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(msg.Data, decoded.Data) {
+		t.Errorf("expected %v but %v", msg.Data, decoded.Data)
+	}
+
+	msg.Data["names"] = []string{"a"}
+	if err := msg.Serialize(&buf); err == nil {
+		t.Error("fixed length array with wrong size must be rejected")
+	}
+}
+
+// A length prefix which the remaining bytes cannot hold is rejected before
+// the array of nested messages is allocated.
+func TestDynamicMessageHugeArray(t *testing.T) {
+	definition := `Header header
+geometry_msgs/PointStamped[] points
+std_msgs/Empty[] empties
+
+================================================================================
+MSG: std_msgs/Header
+uint32 seq
+time stamp
+string frame_id
+
+================================================================================
+MSG: geometry_msgs/PointStamped
+Header header
+Point point
+
+================================================================================
+MSG: geometry_msgs/Point
+float64 x
+float64 y
+float64 z
+
+================================================================================
+MSG: std_msgs/Empty
+`
+	msgType, err := NewDynamicMessageType("foo/PointStampedArray", definition)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size := msgType.minSizes["geometry_msgs/PointStamped"]; size != 40 {
+		t.Errorf("expected minimum size 40 but %d", size)
+	}
+	header := []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+	var tests = [][]byte{
+		append(append([]byte{}, header...), 0xff, 0xff, 0xff, 0x7f),
+		append(append([]byte{}, header...), 0xff, 0xff, 0xff, 0xff),
+	}
+	for i, data := range tests {
+		var before, after runtime.MemStats
+		runtime.ReadMemStats(&before)
+		err := msgType.NewMessage().Deserialize(bytes.NewReader(data))
+		runtime.ReadMemStats(&after)
+		if err == nil {
+			t.Errorf("%d: huge array is accepted", i)
+		}
+		if allocated := after.TotalAlloc - before.TotalAlloc; allocated > 1<<20 {
+			t.Errorf("%d: %d bytes are allocated", i, allocated)
+		}
+	}
+}
+
+// Elements of empty messages take no bytes, so an array of them may end the
+// message.
+func TestDynamicMessageEmptyArray(t *testing.T) {
+	definition := "std_msgs/Empty[] empties\n" + dynamicMsgSeparator + "\nMSG: std_msgs/Empty\n"
+	msgType, err := NewDynamicMessageType("foo/Empties", definition)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := []byte{3, 0, 0, 0}
+	msg := msgType.NewMessage().(*DynamicMessage)
+	if err := msg.Deserialize(bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	if empties := msg.Data["empties"].([]map[string]interface{}); len(empties) != 3 {
+		t.Errorf("expected 3 elements but %v", empties)
+	}
+	var buf bytes.Buffer
+	if err := msg.Serialize(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), data) {
+		t.Errorf("expected %v but %v", data, buf.Bytes())
+	}
+}
@@ -0,0 +1,80 @@
+package ros
+
+// Type-safe wrappers of Node for generated messages and services. Type
+// parameters are inferred from the callbacks, so signature mistakes are
+// compile errors instead of failures at runtime.
+
+// MessagePtr is satisfied by pointers to generated messages.
+type MessagePtr[T any] interface {
+	*T
+	Message
+}
+
+// ServicePtr is satisfied by pointers to generated services.
+type ServicePtr[S any] interface {
+	*S
+	Service
+	Type() ServiceType
+}
+
+func messageTypeOf[T any, PT MessagePtr[T]]() MessageType {
+	return PT(new(T)).Type()
+}
+
+// Subscribe subscribes to the topic with a callback taking the generated
+// message type.
+//
+//	ros.Subscribe(node, "/chatter", func(msg *std_msgs.String) { ... })
+func Subscribe[T any, PT MessagePtr[T]](node Node, topic string, callback func(PT)) Subscriber {
+	return node.NewSubscriber(topic, messageTypeOf[T, PT](), callback)
+}
+
+// SubscribeWithEvent is like Subscribe but the callback also takes the
+// MessageEvent.
+func SubscribeWithEvent[T any, PT MessagePtr[T]](node Node, topic string, callback func(PT, MessageEvent)) Subscriber {
+	return node.NewSubscriber(topic, messageTypeOf[T, PT](), callback)
+}
+
+// TypedPublisher publishes messages of the generated type only.
+type TypedPublisher[T any, PT MessagePtr[T]] struct {
+	pub Publisher
+}
+
+// Advertise creates a publisher of the generated message type.
+//
+//	pub := ros.Advertise[std_msgs.String](node, "/chatter")
+func Advertise[T any, PT MessagePtr[T]](node Node, topic string) *TypedPublisher[T, PT] {
+	return &TypedPublisher[T, PT]{node.NewPublisher(topic, messageTypeOf[T, PT]())}
+}
+
+func (p *TypedPublisher[T, PT]) Publish(msg PT) {
+	p.pub.Publish(msg)
+}
+
+func (p *TypedPublisher[T, PT]) Shutdown() {
+	p.pub.Shutdown()
+}
+
+// Publisher returns the underlying publisher, e.g. to wrap it with
+// NewStampingPublisher.
+func (p *TypedPublisher[T, PT]) Publisher() Publisher {
+	return p.pub
+}
+
+// CallService calls the service with srv.Request and sets the response to
+// srv.Response.
+//
+//	srv := &rospy_tutorials.AddTwoInts{}
+//	srv.Request.A, srv.Request.B = 1, 2
+//	err := ros.CallService(node, "/add_two_ints", srv)
+func CallService[S any, PS ServicePtr[S]](node Node, service string, srv PS, options ...ServiceClientOption) error {
+	client := node.NewServiceClient(service, srv.Type(), options...)
+	defer client.Shutdown()
+	return client.Call(srv)
+}
+
+// ServeService creates a service server whose handler takes the generated
+// service type.
+func ServeService[S any, PS ServicePtr[S]](node Node, service string, handler func(PS) error, options ...ServiceServerOption) ServiceServer {
+	return node.NewServiceServer(service, PS(new(S)).Type(), handler, options...)
+}
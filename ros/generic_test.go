@@ -0,0 +1,188 @@
+package ros
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// Node which records what is created instead of talking to a master.
+type recordingNode struct {
+	Node
+	topic     string
+	msgType   MessageType
+	callback  interface{}
+	publisher *testPublisher
+	srvType   ServiceType
+	handler   interface{}
+	options   []ServiceServerOption
+	called    Service
+}
+
+func (n *recordingNode) NewSubscriber(topic string, msgType MessageType, callback interface{}) Subscriber {
+	n.topic, n.msgType, n.callback = topic, msgType, callback
+	return nil
+}
+
+func (n *recordingNode) NewPublisher(topic string, msgType MessageType) Publisher {
+	n.topic, n.msgType = topic, msgType
+	n.publisher = &testPublisher{}
+	return n.publisher
+}
+
+func (n *recordingNode) NewServiceClient(service string, srvType ServiceType, options ...ServiceClientOption) ServiceClient {
+	n.topic, n.srvType = service, srvType
+	return &recordingServiceClient{n}
+}
+
+func (n *recordingNode) NewServiceServer(service string, srvType ServiceType, handler interface{}, options ...ServiceServerOption) ServiceServer {
+	n.topic, n.srvType, n.handler, n.options = service, srvType, handler, options
+	return nil
+}
+
+type recordingServiceClient struct {
+	node *recordingNode
+}
+
+func (c *recordingServiceClient) Call(srv Service) error {
+	c.node.called = srv
+	srv.ResMessage().(*testInt64Msg).Value = 42
+	return nil
+}
+
+func (c *recordingServiceClient) Shutdown() {}
+
+// Call the callback as subscribers do.
+func deliver(callback interface{}, args ...interface{}) {
+	fun := reflect.ValueOf(callback)
+	var values []reflect.Value
+	for _, arg := range args[:fun.Type().NumIn()] {
+		values = append(values, reflect.ValueOf(arg))
+	}
+	fun.Call(values)
+}
+
+func TestSubscribe(t *testing.T) {
+	node := &recordingNode{}
+	var received *testInt64Msg
+	Subscribe(node, "/value", func(msg *testInt64Msg) {
+		received = msg
+	})
+	if node.topic != "/value" || node.msgType != testInt64MsgType {
+		t.Errorf("unexpected subscription %s %v", node.topic, node.msgType)
+	}
+	msg := &testInt64Msg{Value: 7}
+	deliver(node.callback, msg, MessageEvent{})
+	if received != msg {
+		t.Errorf("expected %v but %v", msg, received)
+	}
+
+	var event MessageEvent
+	SubscribeWithEvent(node, "/value", func(msg *testInt64Msg, e MessageEvent) {
+		received, event = msg, e
+	})
+	deliver(node.callback, msg, MessageEvent{PublisherName: "/talker"})
+	if received != msg || event.PublisherName != "/talker" {
+		t.Errorf("unexpected message %v and event %v", received, event)
+	}
+}
+
+func TestAdvertise(t *testing.T) {
+	node := &recordingNode{}
+	pub := Advertise[testInt64Msg](node, "/value")
+	if node.topic != "/value" || node.msgType != testInt64MsgType {
+		t.Errorf("unexpected publisher %s %v", node.topic, node.msgType)
+	}
+	msg := &testInt64Msg{Value: 3}
+	pub.Publish(msg)
+	if len(node.publisher.published) != 1 || node.publisher.published[0] != msg {
+		t.Errorf("unexpected messages %v", node.publisher.published)
+	}
+	if pub.Publisher() != node.publisher {
+		t.Error("unexpected underlying publisher")
+	}
+}
+
+func TestCallService(t *testing.T) {
+	node := &recordingNode{}
+	srv := &testDoubleSrv{Request: testInt64Msg{21}}
+	if err := CallService(node, "/double", srv); err != nil {
+		t.Fatal(err)
+	}
+	if node.topic != "/double" || node.srvType != (testDoubleSrvType{}) || node.called != srv {
+		t.Errorf("unexpected call %s %v %v", node.topic, node.srvType, node.called)
+	}
+	if srv.Response.Value != 42 {
+		t.Errorf("expected 42 but %d", srv.Response.Value)
+	}
+}
+
+func TestServeService(t *testing.T) {
+	node := &recordingNode{}
+	ServeService(node, "/double", func(srv *testDoubleSrv) error {
+		srv.Response.Value = srv.Request.Value * 2
+		return nil
+	}, WithServiceWorkers(2))
+	if node.topic != "/double" || node.srvType != (testDoubleSrvType{}) || len(node.options) != 1 {
+		t.Errorf("unexpected server %s %v %v", node.topic, node.srvType, node.options)
+	}
+	// The handler is accepted by the server.
+	callback, err := newServiceCallback(node.srvType, node.handler)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := &testDoubleSrv{Request: testInt64Msg{4}}
+	if err := callback(srv); err != nil || srv.Response.Value != 8 {
+		t.Errorf("unexpected result %d, %v", srv.Response.Value, err)
+	}
+}
+
+// The wrappers work with a real node over TCPROS.
+func TestGenericLoopback(t *testing.T) {
+	masterUri, stopMaster := startTestMaster(t)
+	defer stopMaster()
+	node, err := newDefaultNode("/test_node", []string{"__master:=" + masterUri, "__ip:=127.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	node.logger.SetSeverity(LogLevelFatal)
+	defer node.Shutdown()
+
+	pub := Advertise[testInt64Msg](node, "/value")
+	received := make(chan int64, 1)
+	Subscribe(node, "/value", func(msg *testInt64Msg) {
+		select {
+		case received <- msg.Value:
+		default:
+		}
+	})
+	timeout := time.After(5 * time.Second)
+	// Messages published before the connection are lost, so publish
+	// until one is received.
+	for done := false; !done; {
+		pub.Publish(&testInt64Msg{Value: 7})
+		node.SpinOnce()
+		select {
+		case value := <-received:
+			if value != 7 {
+				t.Errorf("expected 7 but %d", value)
+			}
+			done = true
+		case <-timeout:
+			t.Fatal("no message received")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	ServeService(node, "/double", func(srv *testDoubleSrv) error {
+		srv.Response.Value = srv.Request.Value * 2
+		return nil
+	}, WithServiceWorkers(1))
+	srv := &testDoubleSrv{Request: testInt64Msg{21}}
+	if err := CallService(node, "/double", srv); err != nil {
+		t.Fatal(err)
+	}
+	if srv.Response.Value != 42 {
+		t.Errorf("expected 42 but %d", srv.Response.Value)
+	}
+}
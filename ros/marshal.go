@@ -0,0 +1,325 @@
+package ros
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var (
+	timeType     = reflect.TypeOf(Time{})
+	durationType = reflect.TypeOf(Duration{})
+)
+
+// Field name in the message definition taken from the rosmsg struct tag.
+func rosmsgFieldName(field reflect.StructField) (string, bool) {
+	tag, ok := field.Tag.Lookup("rosmsg")
+	if !ok {
+		return "", false
+	}
+	return strings.SplitN(tag, ":", 2)[0], true
+}
+
+func messageStruct(msg Message) (reflect.Value, error) {
+	v := reflect.ValueOf(msg)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("%T is not a pointer to a generated message", msg)
+	}
+	return v.Elem(), nil
+}
+
+// MarshalJSON encodes a generated message as JSON. Keys are the field
+// names of the message definition in declaration order. Time and duration
+// are objects with `secs` and `nsecs`, uint8 arrays are base64 strings
+// and non-finite floats are null.
+func MarshalJSON(msg Message) ([]byte, error) {
+	v, err := messageStruct(msg)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := encodeJSON(&buf, v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func encodeJSON(buf *bytes.Buffer, v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Struct:
+		if v.Type() == timeType || v.Type() == durationType {
+			sec := v.FieldByName("Sec").Uint()
+			nsec := v.FieldByName("NSec").Uint()
+			fmt.Fprintf(buf, `{"secs":%d,"nsecs":%d}`, sec, nsec)
+			return nil
+		}
+		buf.WriteByte('{')
+		first := true
+		for i := 0; i < v.NumField(); i++ {
+			name, ok := rosmsgFieldName(v.Type().Field(i))
+			if !ok {
+				continue
+			}
+			if !first {
+				buf.WriteByte(',')
+			}
+			first = false
+			key, _ := json.Marshal(name)
+			buf.Write(key)
+			buf.WriteByte(':')
+			if err := encodeJSON(buf, v.Field(i)); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			octets := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(octets), v)
+			buf.WriteByte('"')
+			buf.WriteString(base64.StdEncoding.EncodeToString(octets))
+			buf.WriteByte('"')
+			return nil
+		}
+		buf.WriteByte('[')
+		for i := 0; i < v.Len(); i++ {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := encodeJSON(buf, v.Index(i)); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			buf.WriteString("null")
+		} else {
+			buf.WriteString(strconv.FormatFloat(f, 'g', -1, v.Type().Bits()))
+		}
+	default:
+		data, err := json.Marshal(v.Interface())
+		if err != nil {
+			return err
+		}
+		buf.Write(data)
+	}
+	return nil
+}
+
+// UnmarshalJSON decodes JSON produced by MarshalJSON into a generated
+// message. Fields missing in the JSON object are left unchanged.
+func UnmarshalJSON(data []byte, msg Message) error {
+	v, err := messageStruct(msg)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return err
+	}
+	return decodeJSON(v, value, v.Type().Name())
+}
+
+func decodeJSON(v reflect.Value, value interface{}, path string) error {
+	typeError := func() error {
+		return fmt.Errorf("Cannot decode %v into %s of type %s", value, path, v.Type())
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return typeError()
+		}
+		if v.Type() == timeType || v.Type() == durationType {
+			for key, field := range map[string]string{"secs": "Sec", "nsecs": "NSec"} {
+				if item, ok := object[key]; ok {
+					if err := decodeJSON(v.FieldByName(field), item, path+"."+key); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		}
+		for i := 0; i < v.NumField(); i++ {
+			name, ok := rosmsgFieldName(v.Type().Field(i))
+			if !ok {
+				continue
+			}
+			if item, ok := object[name]; ok {
+				if err := decodeJSON(v.Field(i), item, path+"."+name); err != nil {
+					return err
+				}
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		var items []interface{}
+		if s, ok := value.(string); ok && v.Type().Elem().Kind() == reflect.Uint8 {
+			octets, err := base64.StdEncoding.DecodeString(s)
+			if err != nil {
+				return fmt.Errorf("%s: %v", path, err)
+			}
+			if v.Kind() == reflect.Slice {
+				v.Set(reflect.MakeSlice(v.Type(), len(octets), len(octets)))
+			} else if v.Len() != len(octets) {
+				return fmt.Errorf("%s must have %d elements but %d", path, v.Len(), len(octets))
+			}
+			reflect.Copy(v, reflect.ValueOf(octets))
+			return nil
+		} else if items, ok = value.([]interface{}); !ok {
+			return typeError()
+		}
+		if v.Kind() == reflect.Slice {
+			v.Set(reflect.MakeSlice(v.Type(), len(items), len(items)))
+		} else if v.Len() != len(items) {
+			return fmt.Errorf("%s must have %d elements but %d", path, v.Len(), len(items))
+		}
+		for i, item := range items {
+			if err := decodeJSON(v.Index(i), item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Bool:
+		b, ok := value.(bool)
+		if !ok {
+			return typeError()
+		}
+		v.SetBool(b)
+	case reflect.String:
+		s, ok := value.(string)
+		if !ok {
+			return typeError()
+		}
+		v.SetString(s)
+	case reflect.Float32, reflect.Float64:
+		if value == nil {
+			v.SetFloat(math.NaN())
+			return nil
+		}
+		n, ok := value.(json.Number)
+		if !ok {
+			return typeError()
+		}
+		f, err := strconv.ParseFloat(string(n), v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%s: %v", path, err)
+		}
+		v.SetFloat(f)
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, ok := value.(json.Number)
+		if !ok {
+			return typeError()
+		}
+		i, err := strconv.ParseInt(string(n), 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%s: %v", path, err)
+		}
+		v.SetInt(i)
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, ok := value.(json.Number)
+		if !ok {
+			return typeError()
+		}
+		u, err := strconv.ParseUint(string(n), 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%s: %v", path, err)
+		}
+		v.SetUint(u)
+	default:
+		return typeError()
+	}
+	return nil
+}
+
+// MarshalYAML formats a generated message as YAML in the same layout as
+// `rostopic echo`.
+func MarshalYAML(msg Message) ([]byte, error) {
+	v, err := messageStruct(msg)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(formatYAML(v, "")), nil
+}
+
+func formatYAMLFloat(f float64, bits int) string {
+	switch {
+	case math.IsNaN(f):
+		return "nan"
+	case math.IsInf(f, 1):
+		return "inf"
+	case math.IsInf(f, -1):
+		return "-inf"
+	}
+	s := strconv.FormatFloat(f, 'g', -1, bits)
+	if !strings.ContainsAny(s, ".e") {
+		s += ".0"
+	}
+	return s
+}
+
+func formatYAMLScalar(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return "True"
+		}
+		return "False"
+	case reflect.String:
+		if v.Len() == 0 {
+			return "''"
+		}
+		return strconv.Quote(v.String())
+	case reflect.Float32, reflect.Float64:
+		return formatYAMLFloat(v.Float(), v.Type().Bits())
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+}
+
+func formatYAML(v reflect.Value, indent string) string {
+	switch v.Kind() {
+	case reflect.Struct:
+		if v.Type() == timeType || v.Type() == durationType {
+			return fmt.Sprintf("\n%ssecs: %d\n%snsecs: %9d", indent,
+				v.FieldByName("Sec").Uint(), indent, v.FieldByName("NSec").Uint())
+		}
+		var lines []string
+		for i := 0; i < v.NumField(); i++ {
+			name, ok := rosmsgFieldName(v.Type().Field(i))
+			if !ok {
+				continue
+			}
+			lines = append(lines, fmt.Sprintf("%s%s: %s", indent, name, formatYAML(v.Field(i), indent+"  ")))
+		}
+		if len(indent) > 0 {
+			return "\n" + strings.Join(lines, "\n")
+		}
+		return strings.Join(lines, "\n")
+	case reflect.Slice, reflect.Array:
+		if v.Len() == 0 {
+			return "[]"
+		}
+		if v.Type().Elem().Kind() != reflect.Struct {
+			items := make([]string, v.Len())
+			for i := 0; i < v.Len(); i++ {
+				items[i] = formatYAMLScalar(v.Index(i))
+			}
+			return "[" + strings.Join(items, ", ") + "]"
+		}
+		items := make([]string, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			items[i] = indent + "- " + formatYAML(v.Index(i), indent+"  ")
+		}
+		return "\n" + strings.Join(items, "\n")
+	default:
+		return formatYAMLScalar(v)
+	}
+}
@@ -0,0 +1,129 @@
+package ros
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"testing"
+)
+
+type testPoint struct {
+	X float64 `rosmsg:"x:float64"`
+	Y float64 `rosmsg:"y:float64"`
+}
+
+func (m *testPoint) Type() MessageType                 { return nil }
+func (m *testPoint) Serialize(buf *bytes.Buffer) error { return nil }
+func (m *testPoint) Deserialize(buf *bytes.Reader) error {
+	return nil
+}
+
+type testMarshalMsg struct {
+	Stamp    Time        `rosmsg:"stamp:time"`
+	FrameId  string      `rosmsg:"frame_id:string"`
+	Ok       bool        `rosmsg:"ok:bool"`
+	Count    int16       `rosmsg:"count:int16"`
+	Data     []uint8     `rosmsg:"data:uint8[]"`
+	Values   [2]float32  `rosmsg:"values:float32[2]"`
+	Points   []testPoint `rosmsg:"points:Point[]"`
+	Timeout  Duration    `rosmsg:"timeout:duration"`
+	internal int
+}
+
+func (m *testMarshalMsg) Type() MessageType                 { return nil }
+func (m *testMarshalMsg) Serialize(buf *bytes.Buffer) error { return nil }
+func (m *testMarshalMsg) Deserialize(buf *bytes.Reader) error {
+	return nil
+}
+
+func newTestMarshalMsg() *testMarshalMsg {
+	return &testMarshalMsg{
+		Stamp:   NewTime(1, 2),
+		FrameId: "map",
+		Ok:      true,
+		Count:   -3,
+		Data:    []uint8{1, 2, 3},
+		Values:  [2]float32{0.5, 1},
+		Points:  []testPoint{{1, 2}},
+		Timeout: NewDuration(3, 0),
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	const expected = `{"stamp":{"secs":1,"nsecs":2},"frame_id":"map","ok":true,"count":-3,` +
+		`"data":"AQID","values":[0.5,1],"points":[{"x":1,"y":2}],"timeout":{"secs":3,"nsecs":0}}`
+	data, err := MarshalJSON(newTestMarshalMsg())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != expected {
+		t.Errorf("expected %s but %s", expected, data)
+	}
+
+	var msg testMarshalMsg
+	if err := UnmarshalJSON(data, &msg); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&msg, newTestMarshalMsg()) {
+		t.Errorf("expected %v but %v", newTestMarshalMsg(), msg)
+	}
+}
+
+func TestMarshalJSONNonFinite(t *testing.T) {
+	msg := testPoint{math.NaN(), 1}
+	data, err := MarshalJSON(&msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"x":null,"y":1}` {
+		t.Error(string(data))
+	}
+	msg = testPoint{}
+	if err := UnmarshalJSON(data, &msg); err != nil {
+		t.Fatal(err)
+	}
+	if !math.IsNaN(msg.X) || msg.Y != 1 {
+		t.Error(msg)
+	}
+}
+
+func TestUnmarshalJSONErrors(t *testing.T) {
+	var tests = []string{
+		`{"count":40000}`,
+		`{"count":"1"}`,
+		`{"values":[1,2,3]}`,
+		`{"data":"not base64!"}`,
+		`[]`,
+	}
+	for _, test := range tests {
+		var msg testMarshalMsg
+		if err := UnmarshalJSON([]byte(test), &msg); err == nil {
+			t.Errorf("%s must be rejected", test)
+		}
+	}
+}
+
+func TestMarshalYAML(t *testing.T) {
+	const expected = `stamp: 
+  secs: 1
+  nsecs:         2
+frame_id: "map"
+ok: True
+count: -3
+data: [1, 2, 3]
+values: [0.5, 1.0]
+points: 
+  - 
+    x: 1.0
+    y: 2.0
+timeout: 
+  secs: 3
+  nsecs:         0`
+	data, err := MarshalYAML(newTestMarshalMsg())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != expected {
+		t.Errorf("expected:\n%s\nbut:\n%s", expected, data)
+	}
+}
@@ -5,6 +5,8 @@ import (
 )
 
 type MessageType interface {
+	// Full message definition including dependencies. Publishers send it
+	// as `message_definition` in the connection header.
 	Text() string
 	MD5Sum() string
 	Name() string
@@ -16,3 +18,18 @@ type Message interface {
 	Serialize(buf *bytes.Buffer) error
 	Deserialize(buf *bytes.Reader) error
 }
+
+// Messages generated by gengo report their size on the wire so that
+// buffers can be allocated once.
+type sizedMessage interface {
+	SerializedLength() int
+}
+
+func serializeMessage(msg Message) ([]byte, error) {
+	var buf bytes.Buffer
+	if sized, ok := msg.(sizedMessage); ok {
+		buf.Grow(sized.SerializedLength())
+	}
+	err := msg.Serialize(&buf)
+	return buf.Bytes(), err
+}
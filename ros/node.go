@@ -49,7 +49,10 @@ func processArguments(args []string) (NameMap, NameMap, NameMap, []string) {
 }
 
 // *defaultNode implements Node interface
-// a defaultNode instance must be accessed in user goroutine.
+// The maps of publishers, subscribers and servers are guarded by mutex, as
+// the slave API reads them from the XMLRPC goroutine while user goroutines
+// add to them. registerMutex serializes creating publishers, subscribers
+// and servers, which calls the master without holding mutex.
 type defaultNode struct {
 	name           string
 	namespace      string
@@ -58,9 +61,12 @@ type defaultNode struct {
 	xmlrpcUri      string
 	xmlrpcListener net.Listener
 	xmlrpcHandler  *xmlrpc.Handler
+	xmlrpcServer   *http.Server
 	subscribers    map[string]*defaultSubscriber
 	publishers     map[string]*defaultPublisher
 	servers        map[string]*defaultServiceServer
+	mutex          sync.Mutex
+	registerMutex  sync.Mutex
 	jobChan        chan func()
 	interruptChan  chan os.Signal
 	logger         Logger
@@ -214,7 +220,8 @@ func newDefaultNode(name string, args []string) (*defaultNode, error) {
 		},
 	}
 	node.xmlrpcHandler = xmlrpc.NewHandler(m)
-	go http.Serve(node.xmlrpcListener, node.xmlrpcHandler)
+	node.xmlrpcServer = &http.Server{Handler: node.xmlrpcHandler}
+	go node.xmlrpcServer.Serve(node.xmlrpcListener)
 	logger.Debugf("Started %s", node.qualifiedName)
 	return node, nil
 }
@@ -235,36 +242,40 @@ func (node *defaultNode) getBusInfo(callerId string) (interface{}, error) {
 }
 
 func (node *defaultNode) getMasterUri(callerId string) (interface{}, error) {
-	return buildRosApiResult(0, "Success", node.masterUri), nil
+	return buildRosApiResult(ApiStatusSuccess, "Success", node.masterUri), nil
 }
 
 func (node *defaultNode) shutdown(callerId string, msg string) (interface{}, error) {
 	node.okMutex.Lock()
 	node.ok = false
 	node.okMutex.Unlock()
-	return buildRosApiResult(0, "Success", 0), nil
+	return buildRosApiResult(ApiStatusSuccess, "Success", 0), nil
 }
 
 func (node *defaultNode) getPid(callerId string) (interface{}, error) {
-	return buildRosApiResult(0, "Success", os.Getpid()), nil
+	return buildRosApiResult(ApiStatusSuccess, "Success", os.Getpid()), nil
 }
 
 func (node *defaultNode) getSubscriptions(callerId string) (interface{}, error) {
 	result := []interface{}{}
+	node.mutex.Lock()
 	for t, s := range node.subscribers {
 		pair := []interface{}{t, s.msgType.Name()}
 		result = append(result, pair)
 	}
-	return buildRosApiResult(0, "Success", result), nil
+	node.mutex.Unlock()
+	return buildRosApiResult(ApiStatusSuccess, "Success", result), nil
 }
 
 func (node *defaultNode) getPublications(callerId string) (interface{}, error) {
 	result := []interface{}{}
+	node.mutex.Lock()
 	for t, p := range node.publishers {
 		pair := []interface{}{t, p.msgType.Name()}
 		result = append(result, pair)
 	}
-	return buildRosApiResult(0, "Success", result), nil
+	node.mutex.Unlock()
+	return buildRosApiResult(ApiStatusSuccess, "Success", result), nil
 }
 
 func (node *defaultNode) paramUpdate(callerId string, key string, value interface{}) (interface{}, error) {
@@ -275,7 +286,10 @@ func (node *defaultNode) publisherUpdate(callerId string, topic string, publishe
 	node.logger.Debug("Slave API publisherUpdate() called.")
 	var code int32
 	var message string
-	if sub, ok := node.subscribers[topic]; !ok {
+	node.mutex.Lock()
+	sub, ok := node.subscribers[topic]
+	node.mutex.Unlock()
+	if !ok {
 		node.logger.Debug("publisherUpdate() called without subscribing topic.")
 		code = 0
 		message = "No such topic"
@@ -296,11 +310,14 @@ func (node *defaultNode) requestTopic(callerId string, topic string, protocols [
 	var code int32
 	var message string
 	var value interface{}
-	if pub, ok := node.publishers[topic]; !ok {
+	node.mutex.Lock()
+	pub, ok := node.publishers[topic]
+	node.mutex.Unlock()
+	if !ok {
 		node.logger.Debug("requestTopic() called with not publishing topic.")
 		code = 0
 		message = "No such topic"
-		value = nil
+		value = []interface{}{}
 	} else {
 		selectedProtocol := make([]interface{}, 0)
 		for _, v := range protocols {
@@ -334,10 +351,34 @@ func (node *defaultNode) NewPublisher(topic string, msgType MessageType) Publish
 }
 
 func (node *defaultNode) NewPublisherWithCallbacks(topic string, msgType MessageType, connectCallback, disconnectCallback func(SingleSubscriberPublisher)) Publisher {
+	return node.newPublisher(topic, msgType, false, connectCallback, disconnectCallback)
+}
+
+func (node *defaultNode) NewLatchedPublisher(topic string, msgType MessageType) Publisher {
+	return node.newPublisher(topic, msgType, true, nil, nil)
+}
+
+func (node *defaultNode) newPublisher(topic string, msgType MessageType, latching bool, connectCallback, disconnectCallback func(SingleSubscriberPublisher)) Publisher {
 	name := node.nameResolver.remap(topic)
+	node.registerMutex.Lock()
+	defer node.registerMutex.Unlock()
+	node.mutex.Lock()
 	pub, ok := node.publishers[name]
+	node.mutex.Unlock()
 	logger := node.logger
 	if !ok {
+		// Subscribers may call requestTopic as soon as the publisher is
+		// registered, so it is registered last.
+		pub = newDefaultPublisher(node, name, msgType, latching, connectCallback, disconnectCallback)
+		node.mutex.Lock()
+		node.publishers[name] = pub
+		node.mutex.Unlock()
+		node.waitGroup.Add(1)
+		go func() {
+			pub.start(&node.waitGroup)
+			node.removePublisher(pub)
+		}()
+
 		_, err := callRosApi(node.masterUri, "registerPublisher",
 			node.qualifiedName,
 			name, msgType.Name(),
@@ -345,74 +386,146 @@ func (node *defaultNode) NewPublisherWithCallbacks(topic string, msgType Message
 		if err != nil {
 			logger.Fatalf("Failed to call registerPublisher(): %s", err)
 		}
-
-		pub = newDefaultPublisher(node, name, msgType, connectCallback, disconnectCallback)
-		node.publishers[name] = pub
-		go pub.start(&node.waitGroup)
 	}
 	return pub
 }
 
 func (node *defaultNode) NewSubscriber(topic string, msgType MessageType, callback interface{}) Subscriber {
 	name := node.nameResolver.remap(topic)
+	node.registerMutex.Lock()
+	defer node.registerMutex.Unlock()
+	node.mutex.Lock()
 	sub, ok := node.subscribers[name]
+	node.mutex.Unlock()
+	if !ok {
+		sub = newDefaultSubscriber(name, msgType, callback)
+		node.startSubscriber(sub)
+	} else {
+		sub.addCallbackChan <- callback
+	}
+	sub.users++
+	return sub
+}
+
+func (node *defaultNode) SubscribeChan(topic string, msgType MessageType, bufferSize int, options ...SubscribeChanOption) (<-chan ReceivedMessage, Subscriber) {
+	name := node.nameResolver.remap(topic)
+	sink := newMessageSink(bufferSize, options)
+	node.registerMutex.Lock()
+	defer node.registerMutex.Unlock()
+	node.mutex.Lock()
+	sub, ok := node.subscribers[name]
+	node.mutex.Unlock()
+	if !ok {
+		// Add the sink before any message arrives.
+		sub = newDefaultSubscriber(name, msgType, nil)
+		sub.sinks = append(sub.sinks, sink)
+		node.startSubscriber(sub)
+	} else {
+		sub.addSinkChan <- sink
+	}
+	sub.users++
+	return sink.ch, &sinkSubscriber{node: node, sub: sub, sink: sink}
+}
+
+// Remove a channel of SubscribeChan, and the subscription with the last of
+// its users.
+func (node *defaultNode) removeSink(sub *defaultSubscriber, sink *messageSink) {
+	node.registerMutex.Lock()
+	defer node.registerMutex.Unlock()
+	sub.users--
+	if sub.users > 0 {
+		sub.removeSinkChan <- sink
+		return
+	}
+	// Forgotten now, so that a new subscription is made for the topic.
+	node.removeSubscriber(sub)
+	sub.Shutdown()
+}
+
+// Register the subscription to the master and start its goroutine. Called
+// with registerMutex locked.
+func (node *defaultNode) startSubscriber(sub *defaultSubscriber) {
 	logger := node.logger
+	node.logger.Debug("Call Master API registerSubscriber")
+	result, err := callRosApi(node.masterUri, "registerSubscriber",
+		node.qualifiedName,
+		sub.topic,
+		sub.msgType.Name(),
+		node.xmlrpcUri)
+	if err != nil {
+		logger.Fatalf("Failed to call registerSubscriber() for %s.", err)
+	}
+	list, ok := result.([]interface{})
 	if !ok {
-		node.logger.Debug("Call Master API registerSubscriber")
-		result, err := callRosApi(node.masterUri, "registerSubscriber",
-			node.qualifiedName,
-			name,
-			msgType.Name(),
-			node.xmlrpcUri)
-		if err != nil {
-			logger.Fatalf("Failed to call registerSubscriber() for %s.", err)
-		}
-		list, ok := result.([]interface{})
+		logger.Fatalf("result is not []string but %s.", reflect.TypeOf(result).String())
+	}
+	var publishers []string
+	for _, item := range list {
+		s, ok := item.(string)
 		if !ok {
-			logger.Fatalf("result is not []string but %s.", reflect.TypeOf(result).String())
-		}
-		var publishers []string
-		for _, item := range list {
-			s, ok := item.(string)
-			if !ok {
-				logger.Fatal("Publisher list contains no string object")
-			}
-			publishers = append(publishers, s)
+			logger.Fatal("Publisher list contains no string object")
 		}
+		publishers = append(publishers, s)
+	}
 
-		logger.Debugf("Publisher URI list: ", publishers)
+	logger.Debugf("Publisher URI list: ", publishers)
 
-		sub = newDefaultSubscriber(name, msgType, callback)
-		node.subscribers[name] = sub
+	node.mutex.Lock()
+	node.subscribers[sub.topic] = sub
+	node.mutex.Unlock()
 
-		logger.Debugf("Start subscriber goroutine for topic '%s'", sub.topic)
-		go sub.start(&node.waitGroup, node.qualifiedName, node.xmlrpcUri, node.masterUri, node.jobChan, logger)
-		logger.Debugf("Done")
-		sub.pubListChan <- publishers
-		logger.Debugf("Update publisher list for topic '%s'", sub.topic)
-	} else {
-		sub.callbacks = append(sub.callbacks, callback)
+	logger.Debugf("Start subscriber goroutine for topic '%s'", sub.topic)
+	node.waitGroup.Add(1)
+	go func() {
+		sub.start(&node.waitGroup, node.qualifiedName, node.xmlrpcUri, node.masterUri, node.jobChan, logger)
+		node.removeSubscriber(sub)
+	}()
+	logger.Debugf("Done")
+	sub.pubListChan <- publishers
+	logger.Debugf("Update publisher list for topic '%s'", sub.topic)
+}
+
+// Forget a publisher which has been shut down, unless it is replaced.
+func (node *defaultNode) removePublisher(pub *defaultPublisher) {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+	if node.publishers[pub.topic] == pub {
+		delete(node.publishers, pub.topic)
 	}
-	return sub
 }
 
-func (node *defaultNode) NewServiceClient(service string, srvType ServiceType) ServiceClient {
+// Forget a subscriber which has been shut down, unless it is replaced.
+func (node *defaultNode) removeSubscriber(sub *defaultSubscriber) {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+	if node.subscribers[sub.topic] == sub {
+		delete(node.subscribers, sub.topic)
+	}
+}
+
+func (node *defaultNode) NewServiceClient(service string, srvType ServiceType, options ...ServiceClientOption) ServiceClient {
 	name := node.nameResolver.remap(service)
-	client := newDefaultServiceClient(node.logger, node.qualifiedName, node.masterUri, name, srvType)
+	client := newDefaultServiceClient(node.logger, node.qualifiedName, node.masterUri, name, srvType, options...)
 	return client
 }
 
-func (node *defaultNode) NewServiceServer(service string, srvType ServiceType, handler interface{}) ServiceServer {
+func (node *defaultNode) NewServiceServer(service string, srvType ServiceType, handler interface{}, options ...ServiceServerOption) ServiceServer {
 	name := node.nameResolver.remap(service)
+	node.registerMutex.Lock()
+	defer node.registerMutex.Unlock()
+	node.mutex.Lock()
 	server, ok := node.servers[name]
+	node.mutex.Unlock()
 	if ok {
 		server.Shutdown()
 	}
-	server = newDefaultServiceServer(node, name, srvType, handler)
+	server = newDefaultServiceServer(node, name, srvType, handler, options...)
 	if server == nil {
 		return nil
 	}
+	node.mutex.Lock()
 	node.servers[name] = server
+	node.mutex.Unlock()
 	return server
 }
 
@@ -445,23 +558,40 @@ func (node *defaultNode) Shutdown() {
 	node.okMutex.Lock()
 	node.ok = false
 	node.okMutex.Unlock()
-	node.logger.Debug("Shutdown subscribers")
+	// Shut them down without the lock, as they remove themselves.
+	node.mutex.Lock()
+	var subscribers []*defaultSubscriber
 	for _, s := range node.subscribers {
+		subscribers = append(subscribers, s)
+	}
+	var publishers []*defaultPublisher
+	for _, p := range node.publishers {
+		publishers = append(publishers, p)
+	}
+	var servers []*defaultServiceServer
+	for _, s := range node.servers {
+		servers = append(servers, s)
+	}
+	node.mutex.Unlock()
+	node.logger.Debug("Shutdown subscribers")
+	for _, s := range subscribers {
 		s.Shutdown()
 	}
 	node.logger.Debug("Shutdown subscribers...done")
 	node.logger.Debug("Shutdown publishers")
-	for _, p := range node.publishers {
+	for _, p := range publishers {
 		p.Shutdown()
 	}
 	node.logger.Debug("Shutdown publishers...done")
 	node.logger.Debug("Shutdown servers")
-	for _, s := range node.servers {
+	for _, s := range servers {
 		s.Shutdown()
 	}
 	node.logger.Debug("Shutdown servers...done")
 	node.logger.Debug("Close XMLRPC lisetner")
-	node.xmlrpcListener.Close()
+	// Idle connections are closed too, or clients keeping them alive
+	// would reach this node at its port after another server takes it.
+	node.xmlrpcServer.Close()
 	node.logger.Debug("Close XMLRPC done")
 	node.logger.Debug("Wait XMLRPC server shutdown")
 	node.xmlrpcHandler.WaitForShutdown()
@@ -513,6 +643,10 @@ func (node *defaultNode) Logger() Logger {
 	return node.logger
 }
 
+func (node *defaultNode) Name() string {
+	return canonicalizeName(node.qualifiedName)
+}
+
 func (node *defaultNode) NonRosArgs() []string {
 	return node.nonRosArgs
 }
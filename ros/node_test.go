@@ -1,7 +1,14 @@
 package ros
 
 import (
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/akio/rosgo/xmlrpc"
 )
 
 func TestLoadJsonFromString(t *testing.T) {
@@ -17,3 +24,190 @@ func TestLoadJsonFromString(t *testing.T) {
 		t.Error(i)
 	}
 }
+
+// A master which accepts any registration, for tests of nodes. Subscribers
+// are told the publishers registered so far and services can be looked up,
+// so that nodes can talk to themselves.
+func startTestMaster(t *testing.T) (string, func()) {
+	return startNotifyingTestMaster(t, nil)
+}
+
+// Like startTestMaster, but calls onRegister before registerPublisher
+// returns, as roscore notifies subscribers meanwhile.
+func startNotifyingTestMaster(t *testing.T, onRegister func(topic, callerApi string)) (string, func()) {
+	success := func(value interface{}) (interface{}, error) {
+		return buildRosApiResult(ApiStatusSuccess, "", value), nil
+	}
+	var mutex sync.Mutex
+	publishers := make(map[string][]interface{})
+	services := make(map[string]string)
+	handler := xmlrpc.NewHandler(map[string]xmlrpc.Method{
+		"registerPublisher": func(callerId, topic, topicType, callerApi string) (interface{}, error) {
+			if onRegister != nil {
+				onRegister(topic, callerApi)
+			}
+			mutex.Lock()
+			defer mutex.Unlock()
+			publishers[topic] = append(publishers[topic], callerApi)
+			return success([]interface{}{})
+		},
+		"unregisterPublisher": func(callerId, topic, callerApi string) (interface{}, error) {
+			return success(1)
+		},
+		"registerSubscriber": func(callerId, topic, topicType, callerApi string) (interface{}, error) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			return success(append([]interface{}{}, publishers[topic]...))
+		},
+		"unregisterSubscriber": func(callerId, topic, callerApi string) (interface{}, error) {
+			return success(1)
+		},
+		"registerService": func(callerId, service, serviceApi, callerApi string) (interface{}, error) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			services[service] = serviceApi
+			return success(1)
+		},
+		"unregisterService": func(callerId, service, serviceApi string) (interface{}, error) {
+			return success(1)
+		},
+		"lookupService": func(callerId, service string) (interface{}, error) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			return success(services[service])
+		},
+	})
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := &http.Server{Handler: handler}
+	go server.Serve(listener)
+	return "http://" + listener.Addr().String(), func() {
+		server.Close()
+		handler.WaitForShutdown()
+	}
+}
+
+func topics(t *testing.T, node *defaultNode, method string) []string {
+	result, err := callRosApi(node.xmlrpcUri, method, "/tester")
+	if err != nil {
+		t.Error(err)
+		return nil
+	}
+	var names []string
+	for _, pair := range result.([]interface{}) {
+		names = append(names, pair.([]interface{})[0].(string))
+	}
+	return names
+}
+
+func TestNodeConcurrentIntrospection(t *testing.T) {
+	// Subscribers may request a topic as soon as it is registered.
+	protocols := []interface{}{[]interface{}{"TCPROS"}}
+	masterUri, stopMaster := startNotifyingTestMaster(t, func(topic, callerApi string) {
+		result, err := callRosApi(callerApi, "requestTopic", "/tester", topic, protocols)
+		if err != nil {
+			t.Errorf("requestTopic(%s) failed: %v", topic, err)
+		} else if params := result.([]interface{}); len(params) != 3 || params[0] != "TCPROS" {
+			t.Errorf("unexpected protocol %v for %s", params, topic)
+		}
+	})
+	defer stopMaster()
+	node, err := newDefaultNode("/test_node", []string{"__master:=" + masterUri, "__ip:=127.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	node.logger.SetSeverity(LogLevelFatal)
+
+	const n = 20
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < n; i++ {
+			node.NewPublisher(fmt.Sprintf("/pub%d", i), testInt64MsgType)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < n; i++ {
+			node.NewSubscriber(fmt.Sprintf("/sub%d", i), testInt64MsgType, func(msg *testInt64Msg) {})
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < n; i++ {
+			topics(t, node, "getPublications")
+			topics(t, node, "getSubscriptions")
+		}
+	}()
+	wg.Wait()
+	if pubs := topics(t, node, "getPublications"); len(pubs) != n {
+		t.Errorf("expected %d publications, got %v", n, pubs)
+	}
+	if subs := topics(t, node, "getSubscriptions"); len(subs) != n {
+		t.Errorf("expected %d subscriptions, got %v", n, subs)
+	}
+
+	// Topics shut down are no longer reported.
+	node.NewSubscriber("/sub0", testInt64MsgType, func(msg *testInt64Msg) {}).Shutdown()
+	node.NewPublisher("/pub0", testInt64MsgType).Shutdown()
+	deadline := time.Now().Add(5 * time.Second)
+	for len(topics(t, node, "getSubscriptions")) != n-1 || len(topics(t, node, "getPublications")) != n-1 {
+		if time.Now().After(deadline) {
+			t.Fatal("shut down topics are still reported")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	node.Shutdown()
+}
+
+func TestNodeRequestTopic(t *testing.T) {
+	masterUri, stopMaster := startTestMaster(t)
+	defer stopMaster()
+	node, err := newDefaultNode("/test_node", []string{"__master:=" + masterUri, "__ip:=127.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	node.logger.SetSeverity(LogLevelFatal)
+	defer node.Shutdown()
+
+	// The publisher can serve requests once it is registered.
+	node.NewPublisher("/pub", testInt64MsgType)
+	protocols := []interface{}{[]interface{}{"TCPROS"}}
+	result, err := callRosApi(node.xmlrpcUri, "requestTopic", "/tester", "/pub", protocols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if params := result.([]interface{}); len(params) != 3 || params[0] != "TCPROS" {
+		t.Errorf("unexpected protocol %v", params)
+	}
+
+	// Other topics are refused with a ROS API result.
+	result, err = xmlrpc.Call(node.xmlrpcUri, "requestTopic", "/tester", "/missing", protocols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code := result.([]interface{})[0]; code != int32(0) {
+		t.Errorf("expected code 0 but %v", code)
+	}
+}
+
+// Connections kept alive by clients do not reach a node which is shut down.
+func TestNodeShutdownClosesConnections(t *testing.T) {
+	masterUri, stopMaster := startTestMaster(t)
+	defer stopMaster()
+	node, err := newDefaultNode("/test_node", []string{"__master:=" + masterUri, "__ip:=127.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	node.logger.SetSeverity(LogLevelFatal)
+	if _, err := callRosApi(node.xmlrpcUri, "getPublications", "/tester"); err != nil {
+		t.Fatal(err)
+	}
+	node.Shutdown()
+	if _, err := callRosApi(node.xmlrpcUri, "getPublications", "/tester"); err == nil {
+		t.Error("a node which is shut down still answers")
+	}
+}
@@ -1,7 +1,6 @@
 package ros
 
 import (
-	"bytes"
 	"container/list"
 	"encoding/binary"
 	"encoding/hex"
@@ -27,16 +26,20 @@ type defaultPublisher struct {
 	msgType            MessageType
 	msgChan            chan []byte
 	shutdownChan       chan struct{}
+	sessionChan        chan *remoteSubscriberSession
 	sessions           *list.List
 	sessionErrorChan   chan error
 	listenerErrorChan  chan error
 	listener           net.Listener
 	connectCallback    func(SingleSubscriberPublisher)
 	disconnectCallback func(SingleSubscriberPublisher)
+	latching           bool
+	// The last message, which is sent to new subscribers if latching.
+	lastMsg []byte
 }
 
 func newDefaultPublisher(node *defaultNode,
-	topic string, msgType MessageType,
+	topic string, msgType MessageType, latching bool,
 	connectCallback, disconnectCallback func(SingleSubscriberPublisher)) *defaultPublisher {
 	pub := new(defaultPublisher)
 	pub.node = node
@@ -44,11 +47,13 @@ func newDefaultPublisher(node *defaultNode,
 	pub.msgType = msgType
 	pub.shutdownChan = make(chan struct{}, 10)
 	pub.msgChan = make(chan []byte, 10)
+	pub.sessionChan = make(chan *remoteSubscriberSession, 10)
 	pub.listenerErrorChan = make(chan error, 10)
 	pub.sessionErrorChan = make(chan error, 10)
 	pub.sessions = list.New()
 	pub.connectCallback = connectCallback
 	pub.disconnectCallback = disconnectCallback
+	pub.latching = latching
 	if listener, err := listenRandomPort(node.listenIp, 10); err != nil {
 		panic(err)
 	} else {
@@ -60,7 +65,6 @@ func newDefaultPublisher(node *defaultNode,
 func (pub *defaultPublisher) start(wg *sync.WaitGroup) {
 	logger := pub.node.logger
 	logger.Debugf("Publisher goroutine for %s started.", pub.topic)
-	wg.Add(1)
 	defer func() {
 		logger.Debug("defaultPublisher.start exit")
 		wg.Done()
@@ -73,10 +77,21 @@ func (pub *defaultPublisher) start(wg *sync.WaitGroup) {
 		select {
 		case msg := <-pub.msgChan:
 			logger.Debug("Receive msgChan")
+			if pub.latching {
+				pub.lastMsg = msg
+			}
 			for e := pub.sessions.Front(); e != nil; e = e.Next() {
 				session := e.Value.(*remoteSubscriberSession)
 				session.msgChan <- msg
 			}
+		case session := <-pub.sessionChan:
+			// Sessions are added here so that a latched message is
+			// sent to each of them exactly once.
+			pub.sessions.PushBack(session)
+			if pub.lastMsg != nil {
+				session.msgChan <- pub.lastMsg
+			}
+			go session.start()
 		case err := <-pub.listenerErrorChan:
 			logger.Debug("Listener closed unexpectedly: %s", err)
 			pub.listener.Close()
@@ -99,9 +114,11 @@ func (pub *defaultPublisher) start(wg *sync.WaitGroup) {
 			if err != nil {
 				logger.Warn(err)
 			}
+			// Closed rather than sent to, as sessions whose subscriber
+			// has gone may have returned already.
 			for e := pub.sessions.Front(); e != nil; e = e.Next() {
 				session := e.Value.(*remoteSubscriberSession)
-				session.quitChan <- struct{}{}
+				close(session.quitChan)
 			}
 			pub.sessions.Init() // Clear all sessions
 			return
@@ -126,17 +143,14 @@ func (pub *defaultPublisher) listenRemoteSubscriber() {
 			return
 		} else {
 			logger.Debugf("Connected %s", conn.RemoteAddr().String())
-			session := newRemoteSubscriberSession(pub, conn)
-			pub.sessions.PushBack(session)
-			go session.start()
+			pub.sessionChan <- newRemoteSubscriberSession(pub, conn)
 		}
 	}
 }
 
 func (pub *defaultPublisher) Publish(msg Message) {
-	var buf bytes.Buffer
-	_ = msg.Serialize(&buf)
-	pub.msgChan <- buf.Bytes()
+	data, _ := serializeMessage(msg)
+	pub.msgChan <- data
 }
 
 func (pub *defaultPublisher) Shutdown() {
@@ -159,6 +173,7 @@ type remoteSubscriberSession struct {
 	typeText           string
 	md5sum             string
 	typeName           string
+	latching           bool
 	quitChan           chan struct{}
 	msgChan            chan []byte
 	errorChan          chan error
@@ -175,6 +190,7 @@ func newRemoteSubscriberSession(pub *defaultPublisher, conn net.Conn) *remoteSub
 	session.typeText = pub.msgType.Text()
 	session.md5sum = pub.msgType.MD5Sum()
 	session.typeName = pub.msgType.Name()
+	session.latching = pub.latching
 	session.quitChan = make(chan struct{})
 	session.msgChan = make(chan []byte, 10)
 	session.errorChan = pub.sessionErrorChan
@@ -191,9 +207,8 @@ type singleSubPub struct {
 }
 
 func (ssp *singleSubPub) Publish(msg Message) {
-	var buf bytes.Buffer
-	_ = msg.Serialize(&buf)
-	ssp.msgChan <- buf.Bytes()
+	data, _ := serializeMessage(msg)
+	ssp.msgChan <- data
 }
 
 func (ssp *singleSubPub) GetSubscriberName() string {
@@ -245,7 +260,7 @@ func (session *remoteSubscriberSession) start() {
 		headerMap[h.key] = h.value
 		logger.Debugf("  `%s` = `%s`", h.key, h.value)
 	}
-	if headerMap["type"] != session.typeName || headerMap["md5sum"] != session.md5sum {
+	if headerMap["md5sum"] != "*" && (headerMap["type"] != session.typeName || headerMap["md5sum"] != session.md5sum) {
 		panic(errors.New("Incomatible message type!"))
 	}
 	ssp.subName = headerMap["callerid"]
@@ -257,7 +272,11 @@ func (session *remoteSubscriberSession) start() {
 	var resHeaders []header
 	resHeaders = append(resHeaders, header{"message_definition", session.typeText})
 	resHeaders = append(resHeaders, header{"callerid", session.nodeId})
-	resHeaders = append(resHeaders, header{"latching", "0"})
+	latching := "0"
+	if session.latching {
+		latching = "1"
+	}
+	resHeaders = append(resHeaders, header{"latching", latching})
 	resHeaders = append(resHeaders, header{"md5sum", session.md5sum})
 	resHeaders = append(resHeaders, header{"topic", session.topic})
 	resHeaders = append(resHeaders, header{"type", session.typeName})
@@ -274,47 +293,55 @@ func (session *remoteSubscriberSession) start() {
 	logger.Debug("Start sending messages...")
 	queue := list.New()
 	queueMaxSize := 100
+	var pending []byte
+	// A ticker rather than a timeout of select, which messages arriving
+	// often would keep from firing.
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
 	for {
 		//logger.Debug("session.remoteSubscriberSession")
 		select {
 		case msg := <-session.msgChan:
 			logger.Debug("Receive msgChan")
-			if queue.Len() == queueMaxSize {
+			// The oldest message is dropped when the queue is full. A
+			// message partly written is held as one of the queue but is
+			// never dropped, which would break the stream.
+			held := queue.Len()
+			if len(pending) > 0 {
+				held++
+			}
+			if held == queueMaxSize && queue.Len() > 0 {
 				queue.Remove(queue.Front())
 			}
 			queue.PushBack(msg)
 		case <-session.quitChan:
 			logger.Debug("Receive quitChan")
 			return
-		case <-time.After(10 * time.Millisecond):
-			if queue.Len() > 0 {
-				logger.Debug("writing")
-				msg := queue.Front().Value.([]byte)
-				queue.Remove(queue.Front())
-				logger.Debug(hex.EncodeToString(msg))
-				session.conn.SetDeadline(time.Now().Add(10 * time.Millisecond))
-				size := uint32(len(msg))
-				if err := binary.Write(session.conn, binary.LittleEndian, size); err != nil {
-					if neterr, ok := err.(net.Error); ok && neterr.Timeout() {
-						logger.Debug("timeout")
-						continue
-					} else {
-						logger.Error(err)
-						panic(err)
-					}
+		case <-ticker.C:
+			// What is left of a message is written before the next one,
+			// so that a timeout does not break the stream.
+			for len(pending) > 0 || queue.Len() > 0 {
+				if len(pending) == 0 {
+					logger.Debug("writing")
+					msg := queue.Front().Value.([]byte)
+					queue.Remove(queue.Front())
+					logger.Debug(hex.EncodeToString(msg))
+					pending = make([]byte, 4+len(msg))
+					binary.LittleEndian.PutUint32(pending, uint32(len(msg)))
+					copy(pending[4:], msg)
 				}
-				logger.Debug(len(msg))
 				session.conn.SetDeadline(time.Now().Add(10 * time.Millisecond))
-				if _, err := session.conn.Write(msg); err != nil {
+				n, err := session.conn.Write(pending)
+				pending = pending[n:]
+				if err != nil {
 					if neterr, ok := err.(net.Error); ok && neterr.Timeout() {
 						logger.Debug("timeout")
-						continue
+						break
 					} else {
 						logger.Error(err)
 						panic(err)
 					}
 				}
-				logger.Debug(hex.EncodeToString(msg))
 			}
 		}
 	}
@@ -0,0 +1,207 @@
+package ros
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Connect to a publisher like a subscriber and return the connection and
+// the response header.
+func connectRawSubscriber(t *testing.T, pub *defaultPublisher) (net.Conn, map[string]string) {
+	conn, err := net.Dial("tcp", pub.listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+	headers := []header{
+		{"callerid", "/tester"},
+		{"topic", pub.topic},
+		{"md5sum", testInt64MsgType.MD5Sum()},
+		{"type", testInt64MsgType.Name()},
+	}
+	if err := writeConnectionHeader(headers, conn); err != nil {
+		t.Fatal(err)
+	}
+	resHeaders, err := readConnectionHeader(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	headerMap := make(map[string]string)
+	for _, h := range resHeaders {
+		headerMap[h.key] = h.value
+	}
+	return conn, headerMap
+}
+
+func readInt64Msg(t *testing.T, conn net.Conn) int64 {
+	var size uint32
+	if err := binary.Read(conn, binary.LittleEndian, &size); err != nil {
+		t.Fatal(err)
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(conn, data); err != nil {
+		t.Fatal(err)
+	}
+	var msg testInt64Msg
+	if err := msg.Deserialize(bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	return msg.Value
+}
+
+// Wait until the publisher goroutine has taken the published messages.
+func waitPublished(pub *defaultPublisher) {
+	for len(pub.msgChan) > 0 {
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestLatchedPublisher(t *testing.T) {
+	masterUri, stopMaster := startTestMaster(t)
+	defer stopMaster()
+	node, err := newDefaultNode("/test_node", []string{"__master:=" + masterUri, "__ip:=127.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	node.logger.SetSeverity(LogLevelFatal)
+	defer node.Shutdown()
+
+	pub := node.NewLatchedPublisher("/latched", testInt64MsgType).(*defaultPublisher)
+	pub.Publish(&testInt64Msg{1})
+	pub.Publish(&testInt64Msg{2})
+	waitPublished(pub)
+	conn, headers := connectRawSubscriber(t, pub)
+	defer conn.Close()
+	if headers["latching"] != "1" {
+		t.Errorf("expected latching 1 but %q", headers["latching"])
+	}
+	// Only the last message is sent on connection, and only once.
+	if value := readInt64Msg(t, conn); value != 2 {
+		t.Errorf("expected latched 2 but %d", value)
+	}
+	pub.Publish(&testInt64Msg{3})
+	if value := readInt64Msg(t, conn); value != 3 {
+		t.Errorf("expected 3 but %d", value)
+	}
+
+	plain := node.NewPublisher("/plain", testInt64MsgType).(*defaultPublisher)
+	plain.Publish(&testInt64Msg{1})
+	waitPublished(plain)
+	plainConn, headers := connectRawSubscriber(t, plain)
+	defer plainConn.Close()
+	if headers["latching"] != "0" {
+		t.Errorf("expected latching 0 but %q", headers["latching"])
+	}
+	plain.Publish(&testInt64Msg{2})
+	if value := readInt64Msg(t, plainConn); value != 2 {
+		t.Errorf("expected 2 but %d", value)
+	}
+}
+
+// A subscriber which stops reading makes writes time out in the middle of a
+// message. The rest of it is written first when the subscriber reads again,
+// and the oldest of the messages queued meanwhile are dropped.
+func TestPublisherWriteTimeoutMidFrame(t *testing.T) {
+	masterUri, stopMaster := startTestMaster(t)
+	defer stopMaster()
+	node, err := newDefaultNode("/test_node", []string{"__master:=" + masterUri, "__ip:=127.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	node.logger.SetSeverity(LogLevelFatal)
+	defer node.Shutdown()
+
+	// A pipe has no buffer, so writes block until the subscriber reads.
+	conn, subConn := net.Pipe()
+	defer subConn.Close()
+	session := newRemoteSubscriberSession(newDefaultPublisher(node, "/values", testInt64MsgType, false, nil, nil), conn)
+	go session.start()
+	defer close(session.quitChan)
+	subConn.SetDeadline(time.Now().Add(5 * time.Second))
+	headers := []header{
+		{"callerid", "/tester"},
+		{"topic", "/values"},
+		{"md5sum", testInt64MsgType.MD5Sum()},
+		{"type", testInt64MsgType.Name()},
+	}
+	if err := writeConnectionHeader(headers, subConn); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readConnectionHeader(subConn); err != nil {
+		t.Fatal(err)
+	}
+
+	send := func(value int64) {
+		data, _ := serializeMessage(&testInt64Msg{value})
+		session.msgChan <- data
+	}
+	send(0)
+	// Read the size and a part of the body, and stop reading for longer
+	// than the write timeout.
+	partial := make([]byte, 6)
+	if _, err := io.ReadFull(subConn, partial); err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i < 150; i++ {
+		send(int64(i))
+	}
+	for len(session.msgChan) > 0 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(50 * time.Millisecond)
+
+	rest := make([]byte, 6)
+	if _, err := io.ReadFull(subConn, rest); err != nil {
+		t.Fatal(err)
+	}
+	data := append(partial, rest...)
+	if size := binary.LittleEndian.Uint32(data); size != 8 {
+		t.Fatalf("expected size 8 but %d", size)
+	}
+	if value := int64(binary.LittleEndian.Uint64(data[4:])); value != 0 {
+		t.Errorf("expected 0 but %d", value)
+	}
+	// 100 messages are held including the one partly written.
+	for i := 51; i < 150; i++ {
+		if value := readInt64Msg(t, subConn); value != int64(i) {
+			t.Fatalf("expected %d but %d", i, value)
+		}
+	}
+}
+
+// Sessions whose subscribers have gone may have returned before the
+// publisher is shut down.
+func TestPublisherShutdownWithEndedSessions(t *testing.T) {
+	masterUri, stopMaster := startTestMaster(t)
+	defer stopMaster()
+	node, err := newDefaultNode("/test_node", []string{"__master:=" + masterUri, "__ip:=127.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	node.logger.SetSeverity(LogLevelFatal)
+	defer node.Shutdown()
+
+	pub := newDefaultPublisher(node, "/ended", testInt64MsgType, false, nil, nil)
+	// The goroutine of the session has returned, so nothing receives from
+	// its channels.
+	pub.sessions.PushBack(newRemoteSubscriberSession(pub, nil))
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go pub.start(&wg)
+	pub.Shutdown()
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the publisher waits for an ended session")
+	}
+}
@@ -0,0 +1,67 @@
+package ros
+
+import (
+	"bytes"
+	"io"
+)
+
+// RawMessageType describes messages that are passed around as serialized
+// bytes without being decoded. It can be used to publish bytes received
+// from another connection as they are.
+type RawMessageType struct {
+	name   string
+	md5sum string
+	text   string
+}
+
+// Subscribing with AnyMessageType connects to publishers of any type
+// (md5sum `*`). Received messages are *RawMessage whose Type() reports
+// the type, md5sum and message definition of the publisher.
+var AnyMessageType = NewRawMessageType("*", "*", "")
+
+func NewRawMessageType(name string, md5sum string, text string) *RawMessageType {
+	return &RawMessageType{name, md5sum, text}
+}
+
+func newRawMessageTypeFromHeader(header map[string]string) *RawMessageType {
+	return NewRawMessageType(header["type"], header["md5sum"], header["message_definition"])
+}
+
+func (t *RawMessageType) Text() string {
+	return t.text
+}
+
+func (t *RawMessageType) Name() string {
+	return t.name
+}
+
+func (t *RawMessageType) MD5Sum() string {
+	return t.md5sum
+}
+
+func (t *RawMessageType) NewMessage() Message {
+	m := new(RawMessage)
+	m.msgType = t
+	return m
+}
+
+// RawMessage holds a message in its serialized form.
+type RawMessage struct {
+	msgType *RawMessageType
+	Data    []byte
+}
+
+func (m *RawMessage) Type() MessageType {
+	return m.msgType
+}
+
+func (m *RawMessage) Serialize(buf *bytes.Buffer) error {
+	_, err := buf.Write(m.Data)
+	return err
+}
+
+func (m *RawMessage) Deserialize(buf *bytes.Reader) error {
+	m.Data = make([]byte, buf.Len())
+	_, err := io.ReadFull(buf, m.Data)
+	return err
+}
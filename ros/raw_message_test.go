@@ -0,0 +1,42 @@
+package ros
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRawMessageRoundTrip(t *testing.T) {
+	data := []byte{0x03, 0x00, 0x00, 0x00, 'f', 'o', 'o'}
+	msg := AnyMessageType.NewMessage()
+	if err := msg.Deserialize(bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := msg.Serialize(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), data) {
+		t.Errorf("expected %v but %v", data, buf.Bytes())
+	}
+}
+
+func TestRawMessageTypeFromHeader(t *testing.T) {
+	header := map[string]string{
+		"type":               "std_msgs/String",
+		"md5sum":             "992ce8a1687cec8c8bd883ec73ca41d1",
+		"message_definition": "string data\n",
+	}
+	msgType := newRawMessageTypeFromHeader(header)
+	if msgType.Name() != "std_msgs/String" {
+		t.Error(msgType.Name())
+	}
+	if msgType.MD5Sum() != "992ce8a1687cec8c8bd883ec73ca41d1" {
+		t.Error(msgType.MD5Sum())
+	}
+	if msgType.Text() != "string data\n" {
+		t.Error(msgType.Text())
+	}
+	if msg, ok := msgType.NewMessage().(*RawMessage); !ok || msg.Type() != msgType {
+		t.Error(msg)
+	}
+}
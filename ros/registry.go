@@ -0,0 +1,140 @@
+package ros
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Registry looks up message and service types by name or md5sum. Code
+// generated by gengo registers its types to DefaultRegistry at init time,
+// so types of all imported message packages are available.
+type Registry struct {
+	mutex    sync.RWMutex
+	msgTypes map[string]MessageType
+	srvTypes map[string]ServiceType
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		msgTypes: make(map[string]MessageType),
+		srvTypes: make(map[string]ServiceType),
+	}
+}
+
+// DefaultRegistry holds the types registered by generated code.
+var DefaultRegistry = NewRegistry()
+
+// RegisterMessageType adds the type to DefaultRegistry.
+func RegisterMessageType(msgType MessageType) {
+	DefaultRegistry.RegisterMessageType(msgType)
+}
+
+// RegisterServiceType adds the type to DefaultRegistry.
+func RegisterServiceType(srvType ServiceType) {
+	DefaultRegistry.RegisterServiceType(srvType)
+}
+
+// RegisterMessageType adds the type. A type registered before with the
+// same name is replaced.
+func (r *Registry) RegisterMessageType(msgType MessageType) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.msgTypes[msgType.Name()] = msgType
+}
+
+// RegisterServiceType adds the type. A type registered before with the
+// same name is replaced.
+func (r *Registry) RegisterServiceType(srvType ServiceType) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.srvTypes[srvType.Name()] = srvType
+}
+
+// MessageType returns the type of the name such as "sensor_msgs/Image".
+func (r *Registry) MessageType(name string) (MessageType, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	msgType, ok := r.msgTypes[name]
+	return msgType, ok
+}
+
+// ServiceType returns the type of the name such as "std_srvs/Trigger".
+func (r *Registry) ServiceType(name string) (ServiceType, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	srvType, ok := r.srvTypes[name]
+	return srvType, ok
+}
+
+// MessageTypesByMD5Sum returns the types with the md5sum sorted by name.
+// Different types may have the same md5sum, e.g. all empty messages.
+func (r *Registry) MessageTypesByMD5Sum(md5sum string) []MessageType {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	var msgTypes []MessageType
+	for _, msgType := range r.msgTypes {
+		if msgType.MD5Sum() == md5sum {
+			msgTypes = append(msgTypes, msgType)
+		}
+	}
+	sort.Slice(msgTypes, func(i, j int) bool {
+		return msgTypes[i].Name() < msgTypes[j].Name()
+	})
+	return msgTypes
+}
+
+// ServiceTypesByMD5Sum returns the types with the md5sum sorted by name.
+func (r *Registry) ServiceTypesByMD5Sum(md5sum string) []ServiceType {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	var srvTypes []ServiceType
+	for _, srvType := range r.srvTypes {
+		if srvType.MD5Sum() == md5sum {
+			srvTypes = append(srvTypes, srvType)
+		}
+	}
+	sort.Slice(srvTypes, func(i, j int) bool {
+		return srvTypes[i].Name() < srvTypes[j].Name()
+	})
+	return srvTypes
+}
+
+// MessageTypeNames returns the names of the registered message types in
+// sorted order.
+func (r *Registry) MessageTypeNames() []string {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	names := make([]string, 0, len(r.msgTypes))
+	for name := range r.msgTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ServiceTypeNames returns the names of the registered service types in
+// sorted order.
+func (r *Registry) ServiceTypeNames() []string {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	names := make([]string, 0, len(r.srvTypes))
+	for name := range r.srvTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewMessage creates a message of the type. If md5sum is not empty, it
+// must match that of the registered type.
+func (r *Registry) NewMessage(name string, md5sum string) (Message, error) {
+	msgType, ok := r.MessageType(name)
+	if !ok {
+		return nil, fmt.Errorf("message type %s is not registered", name)
+	}
+	if md5sum != "" && md5sum != msgType.MD5Sum() {
+		return nil, fmt.Errorf("md5sum of %s is %s but %s is requested", name, msgType.MD5Sum(), md5sum)
+	}
+	return msgType.NewMessage(), nil
+}
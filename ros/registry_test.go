@@ -0,0 +1,93 @@
+package ros
+
+import (
+	"reflect"
+	"testing"
+)
+
+type testServiceType struct {
+	name   string
+	md5sum string
+}
+
+func (t *testServiceType) Name() string              { return t.name }
+func (t *testServiceType) MD5Sum() string            { return t.md5sum }
+func (t *testServiceType) RequestType() MessageType  { return nil }
+func (t *testServiceType) ResponseType() MessageType { return nil }
+func (t *testServiceType) NewService() Service       { return nil }
+
+func TestRegistryMessageType(t *testing.T) {
+	r := NewRegistry()
+	str := NewRawMessageType("std_msgs/String", "992ce8a1687cec8c8bd883ec73ca41d1", "string data\n")
+	empty := NewRawMessageType("std_msgs/Empty", "d41d8cd98f00b204e9800998ecf8427e", "")
+	emptyReq := NewRawMessageType("std_srvs/EmptyRequest", "d41d8cd98f00b204e9800998ecf8427e", "")
+	r.RegisterMessageType(str)
+	r.RegisterMessageType(emptyReq)
+	r.RegisterMessageType(empty)
+
+	if msgType, ok := r.MessageType("std_msgs/String"); !ok || msgType != str {
+		t.Errorf("unexpected type %v", msgType)
+	}
+	if _, ok := r.MessageType("std_msgs/Int32"); ok {
+		t.Error("unregistered type is found")
+	}
+	byMD5 := r.MessageTypesByMD5Sum("d41d8cd98f00b204e9800998ecf8427e")
+	if !reflect.DeepEqual(byMD5, []MessageType{empty, emptyReq}) {
+		t.Errorf("unexpected types %v", byMD5)
+	}
+	if byMD5 := r.MessageTypesByMD5Sum("0123"); len(byMD5) != 0 {
+		t.Errorf("unexpected types %v", byMD5)
+	}
+	expectedNames := []string{"std_msgs/Empty", "std_msgs/String", "std_srvs/EmptyRequest"}
+	if names := r.MessageTypeNames(); !reflect.DeepEqual(names, expectedNames) {
+		t.Errorf("expected %v but %v", expectedNames, names)
+	}
+
+	// The last one replaces.
+	str2 := NewRawMessageType("std_msgs/String", "992ce8a1687cec8c8bd883ec73ca41d1", "string data\n")
+	r.RegisterMessageType(str2)
+	if msgType, _ := r.MessageType("std_msgs/String"); msgType != str2 {
+		t.Errorf("unexpected type %v", msgType)
+	}
+}
+
+func TestRegistryNewMessage(t *testing.T) {
+	r := NewRegistry()
+	r.RegisterMessageType(NewRawMessageType("std_msgs/String", "992ce8a1687cec8c8bd883ec73ca41d1", "string data\n"))
+	for _, md5sum := range []string{"", "992ce8a1687cec8c8bd883ec73ca41d1"} {
+		msg, err := r.NewMessage("std_msgs/String", md5sum)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if msg.Type().Name() != "std_msgs/String" {
+			t.Errorf("unexpected type %s", msg.Type().Name())
+		}
+	}
+	if _, err := r.NewMessage("std_msgs/String", "d41d8cd98f00b204e9800998ecf8427e"); err == nil {
+		t.Error("expected an error for a wrong md5sum")
+	}
+	if _, err := r.NewMessage("std_msgs/Int32", ""); err == nil {
+		t.Error("expected an error for an unregistered type")
+	}
+}
+
+func TestRegistryServiceType(t *testing.T) {
+	r := NewRegistry()
+	trigger := &testServiceType{"std_srvs/Trigger", "937c9679a518e3a18d831e57125ea522"}
+	empty := &testServiceType{"std_srvs/Empty", "d41d8cd98f00b204e9800998ecf8427e"}
+	r.RegisterServiceType(trigger)
+	r.RegisterServiceType(empty)
+	if srvType, ok := r.ServiceType("std_srvs/Trigger"); !ok || srvType != trigger {
+		t.Errorf("unexpected type %v", srvType)
+	}
+	if _, ok := r.ServiceType("std_srvs/SetBool"); ok {
+		t.Error("unregistered type is found")
+	}
+	if byMD5 := r.ServiceTypesByMD5Sum(empty.MD5Sum()); !reflect.DeepEqual(byMD5, []ServiceType{empty}) {
+		t.Errorf("unexpected types %v", byMD5)
+	}
+	expectedNames := []string{"std_srvs/Empty", "std_srvs/Trigger"}
+	if names := r.ServiceTypeNames(); !reflect.DeepEqual(names, expectedNames) {
+		t.Errorf("expected %v but %v", expectedNames, names)
+	}
+}
@@ -13,6 +13,9 @@ type Node interface {
 	NewPublisherWithCallbacks(topic string,
 		msgType MessageType,
 		connectCallback, disconnectCallback func(SingleSubscriberPublisher)) Publisher
+	// Create a publisher which keeps the last message and sends it to
+	// subscribers connecting later, like a latched publisher of roscpp.
+	NewLatchedPublisher(topic string, msgType MessageType) Publisher
 	// callback should be a function which takes 0, 1, or 2 arguments.
 	// If it takes 0 arguments, it will simply be called without the
 	// message.  1-argument functions are the normal case, and the
@@ -20,9 +23,22 @@ type Node interface {
 	// function takes 2 arguments, the first argument should be of the
 	// generated message type and the second argument should be of
 	// type MessageEvent.
+	// Subscribing with AnyMessageType accepts publishers of any type and
+	// passes each message to the callback as *RawMessage.
 	NewSubscriber(topic string, msgType MessageType, callback interface{}) Subscriber
-	NewServiceClient(service string, srvType ServiceType) ServiceClient
-	NewServiceServer(service string, srvType ServiceType, callback interface{}) ServiceServer
+	// SubscribeChan is like NewSubscriber but delivers messages to a
+	// channel with the capacity of bufferSize instead of a callback.
+	// Options decide what to do when the channel is full. The channel is
+	// closed when the subscriber is shut down, which leaves other
+	// subscribers of the topic on the node receiving.
+	SubscribeChan(topic string, msgType MessageType, bufferSize int, options ...SubscribeChanOption) (<-chan ReceivedMessage, Subscriber)
+	// Options set e.g. how long calls wait for responses.
+	NewServiceClient(service string, srvType ServiceType, options ...ServiceClientOption) ServiceClient
+	// handler should be a function which takes the generated service type,
+	// or its request and response types, and returns error. If it returns
+	// an error or panics, the client gets a failure with the error message.
+	// Handlers are called by Spin unless options say otherwise.
+	NewServiceServer(service string, srvType ServiceType, handler interface{}, options ...ServiceServerOption) ServiceServer
 
 	OK() bool
 	SpinOnce()
@@ -37,6 +53,9 @@ type Node interface {
 
 	Logger() Logger
 
+	// Name returns the fully qualified name of the node, e.g. "/ns/talker".
+	Name() string
+
 	NonRosArgs() []string
 }
 
@@ -82,6 +101,7 @@ type ServiceServer interface {
 }
 
 type ServiceClient interface {
+	// Call returns *ServiceError if the server fails to handle the request.
 	Call(srv Service) error
 	Shutdown()
 }
@@ -3,7 +3,6 @@ package ros
 import (
 	"bytes"
 	"encoding/binary"
-	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -11,27 +10,62 @@ import (
 	"time"
 )
 
+// ServiceError is returned by ServiceClient.Call when the server fails to
+// handle the request, e.g. its handler returns an error, or does not
+// respond in time.
+type ServiceError struct {
+	Service string
+	// Sent by the server. It may be empty.
+	Message string
+	// Whether the server did not respond within the response timeout.
+	Timeout bool
+}
+
+func (e *ServiceError) Error() string {
+	if e.Timeout {
+		return fmt.Sprintf("service [%s] did not respond: %s", e.Service, e.Message)
+	}
+	return fmt.Sprintf("service [%s] responded with an error: %s", e.Service, e.Message)
+}
+
+// How long a client waits for the response by default.
+const defaultServiceResponseTimeout = 30 * time.Second
+
+// ServiceClientOption configures how a service client calls the service.
+type ServiceClientOption func(*defaultServiceClient)
+
+// WithResponseTimeout sets how long Call waits for the server to handle a
+// request. It defaults to 30 seconds; zero or less waits forever.
+func WithResponseTimeout(timeout time.Duration) ServiceClientOption {
+	return func(c *defaultServiceClient) {
+		c.responseTimeout = timeout
+	}
+}
+
 type defaultServiceClient struct {
-	logger    Logger
-	service   string
-	srvType   ServiceType
-	masterUri string
-	nodeId    string
+	logger          Logger
+	service         string
+	srvType         ServiceType
+	masterUri       string
+	nodeId          string
+	responseTimeout time.Duration
 }
 
-func newDefaultServiceClient(logger Logger, nodeId string, masterUri string, service string, srvType ServiceType) *defaultServiceClient {
+func newDefaultServiceClient(logger Logger, nodeId string, masterUri string, service string, srvType ServiceType, options ...ServiceClientOption) *defaultServiceClient {
 	client := new(defaultServiceClient)
 	client.logger = logger
 	client.service = service
 	client.srvType = srvType
 	client.masterUri = masterUri
 	client.nodeId = nodeId
+	client.responseTimeout = defaultServiceResponseTimeout
+	for _, option := range options {
+		option(client)
+	}
 	return client
 }
 
 func (c *defaultServiceClient) Call(srv Service) error {
-	logger := c.logger
-
 	result, err := callRosApi(c.masterUri, "lookupService", c.nodeId, c.service)
 	if err != nil {
 		return err
@@ -47,11 +81,16 @@ func (c *defaultServiceClient) Call(srv Service) error {
 		return err
 	}
 
-	var conn net.Conn
-	conn, err = net.Dial("tcp", serviceUrl.Host)
+	return c.doServiceRequest(srv, serviceUrl.Host)
+}
+
+func (c *defaultServiceClient) doServiceRequest(srv Service, address string) error {
+	logger := c.logger
+	conn, err := net.Dial("tcp", address)
 	if err != nil {
 		return err
 	}
+	defer conn.Close()
 
 	// 1. Write connection header
 	var headers []header
@@ -88,9 +127,7 @@ func (c *defaultServiceClient) Call(srv Service) error {
 	}
 
 	// 3. Send request
-	var buf bytes.Buffer
-	_ = srv.ReqMessage().Serialize(&buf)
-	reqMsg := buf.Bytes()
+	reqMsg, _ := serializeMessage(srv.ReqMessage())
 	size := uint32(len(reqMsg))
 	conn.SetDeadline(time.Now().Add(10 * time.Millisecond))
 	if err := binary.Write(conn, binary.LittleEndian, size); err != nil {
@@ -103,9 +140,17 @@ func (c *defaultServiceClient) Call(srv Service) error {
 	}
 
 	// 4. Read OK byte
+	// The server may take long to handle the request.
 	var ok byte
-	conn.SetDeadline(time.Now().Add(10 * time.Millisecond))
+	if c.responseTimeout > 0 {
+		conn.SetDeadline(time.Now().Add(c.responseTimeout))
+	} else {
+		conn.SetDeadline(time.Time{})
+	}
 	if err := binary.Read(conn, binary.LittleEndian, &ok); err != nil {
+		if neterr, isNetErr := err.(net.Error); isNetErr && neterr.Timeout() {
+			return &ServiceError{Service: c.service, Message: fmt.Sprintf("no response within %v", c.responseTimeout), Timeout: true}
+		}
 		return err
 	} else {
 		if ok == 0 {
@@ -119,7 +164,7 @@ func (c *defaultServiceClient) Call(srv Service) error {
 				if _, err := io.ReadFull(conn, errMsg); err != nil {
 					return err
 				} else {
-					return errors.New(string(errMsg))
+					return &ServiceError{Service: c.service, Message: string(errMsg)}
 				}
 			}
 		}
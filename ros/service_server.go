@@ -25,36 +25,157 @@ func (e *remoteClientSessionError) Error() string {
 	return fmt.Sprintf("remoteClientSession %v error: %v", e.session, e.err)
 }
 
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// Convert a service handler to a function calling it. The handler takes
+// either the service or its request and response messages, and returns an
+// error which is sent to the client.
+func newServiceCallback(srvType ServiceType, handler interface{}) (func(Service) error, error) {
+	fun := reflect.ValueOf(handler)
+	if fun.Kind() != reflect.Func {
+		return nil, fmt.Errorf("Service handler must be a function but %T", handler)
+	}
+	funType := fun.Type()
+	if funType.NumOut() != 1 || funType.Out(0) != errorType {
+		return nil, fmt.Errorf("Service handler must return only error but %v", funType)
+	}
+	srv := srvType.NewService()
+	switch funType.NumIn() {
+	case 1:
+		if !reflect.TypeOf(srv).AssignableTo(funType.In(0)) {
+			return nil, fmt.Errorf("Service handler must take %T but %v", srv, funType)
+		}
+		return func(srv Service) error {
+			return callServiceHandler(fun, reflect.ValueOf(srv))
+		}, nil
+	case 2:
+		if !reflect.TypeOf(srv.ReqMessage()).AssignableTo(funType.In(0)) ||
+			!reflect.TypeOf(srv.ResMessage()).AssignableTo(funType.In(1)) {
+			return nil, fmt.Errorf("Service handler must take %T and %T but %v", srv.ReqMessage(), srv.ResMessage(), funType)
+		}
+		return func(srv Service) error {
+			return callServiceHandler(fun, reflect.ValueOf(srv.ReqMessage()), reflect.ValueOf(srv.ResMessage()))
+		}, nil
+	default:
+		return nil, fmt.Errorf("Service handler must take the service or its request and response but %v", funType)
+	}
+}
+
+// A panic in the handler is reported to the client as roscpp does for
+// exceptions.
+func callServiceHandler(fun reflect.Value, args ...reflect.Value) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("Service handler panicked: %v", r)
+		}
+	}()
+	if err, ok := fun.Call(args)[0].Interface().(error); ok {
+		return err
+	}
+	return nil
+}
+
+// What a service server does with requests beyond its in-flight limit.
+type ServiceQueuePolicy int
+
+const (
+	// Requests wait until a request in flight is handled.
+	ServiceQueueWait ServiceQueuePolicy = iota
+	// Requests fail immediately with a busy error.
+	ServiceQueueReject
+)
+
+// How long a session waits for the handler by default.
+const defaultServiceTimeout = 1000 * time.Millisecond
+
+type serviceServerOptions struct {
+	workers     int
+	maxInFlight int
+	queuePolicy ServiceQueuePolicy
+	timeout     time.Duration
+}
+
+// ServiceServerOption configures how a service server handles requests.
+// By default, handlers are called one at a time by Spin or SpinOnce
+// together with subscriber callbacks.
+type ServiceServerOption func(*serviceServerOptions)
+
+// WithServiceWorkers makes the server call the handler on n goroutines of
+// its own, so that requests are handled concurrently with each other and
+// with other callbacks. The handler must be safe for concurrent use.
+func WithServiceWorkers(n int) ServiceServerOption {
+	return func(o *serviceServerOptions) {
+		o.workers = n
+	}
+}
+
+// WithMaxInFlight limits the number of requests being handled or waiting
+// for a worker. It defaults to the number of workers, or unlimited
+// without workers.
+func WithMaxInFlight(n int) ServiceServerOption {
+	return func(o *serviceServerOptions) {
+		o.maxInFlight = n
+	}
+}
+
+// WithQueuePolicy sets what to do with requests beyond the in-flight
+// limit. It defaults to ServiceQueueWait.
+func WithQueuePolicy(policy ServiceQueuePolicy) ServiceServerOption {
+	return func(o *serviceServerOptions) {
+		o.queuePolicy = policy
+	}
+}
+
+// WithServiceTimeout sets how long a dispatched request may take to be
+// handled before the connection is closed without a response. It defaults
+// to one second; zero or less waits forever.
+func WithServiceTimeout(timeout time.Duration) ServiceServerOption {
+	return func(o *serviceServerOptions) {
+		o.timeout = timeout
+	}
+}
+
 type defaultServiceServer struct {
 	node             *defaultNode
 	service          string
 	srvType          ServiceType
-	handler          interface{}
-	listener         *net.TCPListener
+	callback         func(Service) error
+	listener         net.Listener
 	sessions         *list.List
 	shutdownChan     chan struct{}
 	sessionErrorChan chan error
+	// Closed when the server is shut down.
+	quitChan    chan struct{}
+	queuePolicy ServiceQueuePolicy
+	timeout     time.Duration
+	// Jobs for workers. If nil, jobs are run by the node.
+	workerChan chan func()
+	// Holds a value for each request in flight. If nil, it is unlimited.
+	inFlightChan chan struct{}
 }
 
-func newDefaultServiceServer(node *defaultNode, service string, srvType ServiceType, handler interface{}) *defaultServiceServer {
+func newDefaultServiceServer(node *defaultNode, service string, srvType ServiceType, handler interface{}, options ...ServiceServerOption) *defaultServiceServer {
 	logger := node.logger
+	callback, err := newServiceCallback(srvType, handler)
+	if err != nil {
+		logger.Errorf("Failed to create service %s: %v", service, err)
+		return nil
+	}
 	server := new(defaultServiceServer)
 	if listener, err := listenRandomPort(node.listenIp, 10); err != nil {
 		panic(err)
 	} else {
-		if tcpListener, ok := listener.(*net.TCPListener); ok {
-			server.listener = tcpListener
-		} else {
-			panic(fmt.Errorf("Server listener is not TCPListener"))
-		}
+		server.listener = listener
 	}
 	server.node = node
 	server.service = service
 	server.srvType = srvType
-	server.handler = handler
+	server.callback = callback
 	server.sessions = list.New()
 	server.shutdownChan = make(chan struct{}, 10)
 	server.sessionErrorChan = make(chan error, 10)
+	server.quitChan = make(chan struct{})
+	server.configure(options)
 	_, port, err := net.SplitHostPort(server.listener.Addr().String())
 	if err != nil {
 		// Not reached
@@ -70,46 +191,142 @@ func newDefaultServiceServer(node *defaultNode, service string, srvType ServiceT
 	if err != nil {
 		logger.Errorf("Failed to register service %s", service)
 		server.listener.Close()
+		close(server.quitChan)
 		return nil
 	}
+	node.waitGroup.Add(1)
 	go server.start()
 	return server
 }
 
+// Apply the options and start workers.
+func (s *defaultServiceServer) configure(options []ServiceServerOption) {
+	o := serviceServerOptions{timeout: defaultServiceTimeout}
+	for _, option := range options {
+		option(&o)
+	}
+	s.queuePolicy = o.queuePolicy
+	s.timeout = o.timeout
+	maxInFlight := o.maxInFlight
+	if o.workers > 0 {
+		if maxInFlight <= 0 {
+			maxInFlight = o.workers
+		}
+		s.workerChan = make(chan func())
+		for i := 0; i < o.workers; i++ {
+			go s.runWorker()
+		}
+	}
+	if maxInFlight > 0 {
+		s.inFlightChan = make(chan struct{}, maxInFlight)
+	}
+}
+
+func (s *defaultServiceServer) runWorker() {
+	for {
+		select {
+		case job := <-s.workerChan:
+			job()
+		case <-s.quitChan:
+			return
+		}
+	}
+}
+
+// Take a slot for a request in flight. It fails if the server is shut
+// down, which is checked first so that requests are not reported busy
+// while shutting down, or if the request is rejected.
+func (s *defaultServiceServer) acquire() error {
+	select {
+	case <-s.quitChan:
+		return s.shuttingDownError()
+	default:
+	}
+	if s.inFlightChan == nil {
+		return nil
+	}
+	if s.queuePolicy == ServiceQueueReject {
+		select {
+		case s.inFlightChan <- struct{}{}:
+			return nil
+		default:
+			return fmt.Errorf("Service %s is busy", s.service)
+		}
+	}
+	select {
+	case s.inFlightChan <- struct{}{}:
+		return nil
+	case <-s.quitChan:
+		return s.shuttingDownError()
+	}
+}
+
+func (s *defaultServiceServer) release() {
+	if s.inFlightChan != nil {
+		<-s.inFlightChan
+	}
+}
+
+// Run the job on a worker or the node. It fails if the server is shut
+// down.
+func (s *defaultServiceServer) dispatch(job func()) error {
+	if s.workerChan == nil {
+		s.node.jobChan <- job
+		return nil
+	}
+	select {
+	case s.workerChan <- job:
+		return nil
+	case <-s.quitChan:
+		return s.shuttingDownError()
+	}
+}
+
+func (s *defaultServiceServer) shuttingDownError() error {
+	return fmt.Errorf("Service %s is shutting down", s.service)
+}
+
 func (s *defaultServiceServer) Shutdown() {
 	s.shutdownChan <- struct{}{}
 }
 
+func (s *defaultServiceServer) listenRemoteClient(connChan chan<- net.Conn) {
+	logger := s.node.logger
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			logger.Debugf("s.listener.Accept() failed: %v", err)
+			return
+		}
+		select {
+		case connChan <- conn:
+		case <-s.quitChan:
+			conn.Close()
+			return
+		}
+	}
+}
+
 // event loop
 func (s *defaultServiceServer) start() {
 	logger := s.node.logger
 	logger.Debugf("service server '%s' start listen %s.", s.service, s.listener.Addr().String())
-	s.node.waitGroup.Add(1)
 	defer func() {
 		logger.Debug("defaultServiceServer.start exit")
 		s.node.waitGroup.Done()
 	}()
 
+	connChan := make(chan net.Conn)
+	go s.listenRemoteClient(connChan)
 	for {
-		//logger.Debug("defaultServiceServer.start loop");
-		s.listener.SetDeadline(time.Now().Add(1 * time.Millisecond))
-		if conn, err := s.listener.Accept(); err != nil {
-			opError, ok := err.(*net.OpError)
-			if !ok || !opError.Timeout() {
-				logger.Debugf("s.listner.Accept() failed")
-				return
-			}
-		} else {
+		select {
+		case conn := <-connChan:
 			logger.Debugf("Connected from %s", conn.RemoteAddr().String())
 			session := newRemoteClientSession(s, conn)
 			s.sessions.PushBack(session)
 			go session.start()
-		}
-
-		timeoutChan := time.After(1 * time.Millisecond)
-		select {
 		case err := <-s.sessionErrorChan:
-			logger.Error("session error: %v", err)
+			logger.Debugf("session error: %v", err)
 			if sessionError, ok := err.(*remoteClientSessionError); ok {
 				for e := s.sessions.Front(); e != nil; e = e.Next() {
 					if e.Value == sessionError.session {
@@ -129,15 +346,12 @@ func (s *defaultServiceServer) start() {
 				logger.Warn("Failed unregisterService(%s): %v", s.service, err)
 			}
 			logger.Debug("Called unregisterService(%s)", s.service)
-			for e := s.sessions.Front(); e != nil; e = e.Next() {
-				session := e.Value.(*remoteClientSession)
-				session.quitChan <- struct{}{}
-			}
+			// Stop workers and sessions waiting for them. Sessions
+			// being handled finish by themselves.
+			close(s.quitChan)
 			s.sessions.Init() // Clear all sessions
 			logger.Debug("defaultServiceServer.start session cleared")
 			return
-		case <-timeoutChan:
-			break
 		}
 	}
 }
@@ -145,7 +359,6 @@ func (s *defaultServiceServer) start() {
 type remoteClientSession struct {
 	server       *defaultServiceServer
 	conn         net.Conn
-	quitChan     chan struct{}
 	responseChan chan []byte
 	errorChan    chan error
 }
@@ -154,8 +367,9 @@ func newRemoteClientSession(s *defaultServiceServer, conn net.Conn) *remoteClien
 	session := new(remoteClientSession)
 	session.server = s
 	session.conn = conn
-	session.responseChan = make(chan []byte)
-	session.errorChan = make(chan error)
+	// Buffered so that a handler finishing after the timeout does not block.
+	session.responseChan = make(chan []byte, 1)
+	session.errorChan = make(chan error, 1)
 	return session
 }
 
@@ -170,6 +384,7 @@ func (s *remoteClientSession) start() {
 	logger.Debugf("remoteClientSession.start '%s'", s.server.service)
 	defer func() {
 		logger.Debug("remoteClientSession.start exit")
+		conn.Close()
 	}()
 	defer func() {
 		if err := recover(); err != nil {
@@ -236,39 +451,39 @@ func (s *remoteClientSession) start() {
 		panic(err)
 	}
 
-	s.server.node.jobChan <- func() {
+	job := func() {
+		defer s.server.release()
 		srv := s.server.srvType.NewService()
 		reader := bytes.NewReader(resBuffer)
-		err := srv.ReqMessage().Deserialize(reader)
-		if err != nil {
+		if err := srv.ReqMessage().Deserialize(reader); err != nil {
 			s.errorChan <- err
+			return
 		}
-		args := []reflect.Value{reflect.ValueOf(srv)}
-		fun := reflect.ValueOf(s.server.handler)
-		results := fun.Call(args)
-
-		if len(results) != 1 {
-			logger.Debug("Service callback return type must be 'error'")
+		if err := s.server.callback(srv); err != nil {
+			logger.Debug("Service callback failure")
 			s.errorChan <- err
 			return
 		}
-		result := results[0]
-		if result.IsNil() {
-			logger.Debug("Service callback success")
-			var buf bytes.Buffer
-			_ = srv.ResMessage().Serialize(&buf)
-			s.responseChan <- buf.Bytes()
-		} else {
-			logger.Debug("Service callback failure")
-			if err, ok := result.Interface().(error); ok {
-				s.errorChan <- err
-			} else {
-				s.errorChan <- fmt.Errorf("Service handler has invalid signature")
-			}
+		logger.Debug("Service callback success")
+		resMsg, err := serializeMessage(srv.ResMessage())
+		if err != nil {
+			s.errorChan <- err
+			return
 		}
+		s.responseChan <- resMsg
+	}
+	if err := s.server.acquire(); err != nil {
+		s.errorChan <- err
+	} else if err := s.server.dispatch(job); err != nil {
+		s.server.release()
+		s.errorChan <- err
 	}
 
-	timeoutChan := time.After(1000 * time.Millisecond)
+	// A nil channel never fires, so the session waits forever.
+	var timeoutChan <-chan time.Time
+	if s.server.timeout > 0 {
+		timeoutChan = time.After(s.server.timeout)
+	}
 	select {
 	case resMsg := <-s.responseChan:
 		// 4. Write OK byte
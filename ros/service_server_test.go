@@ -0,0 +1,432 @@
+package ros
+
+import (
+	"bytes"
+	"container/list"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testInt64Msg struct {
+	Value int64
+}
+
+// Message type which creates *testInt64Msg.
+type testInt64Type struct{}
+
+var testInt64MsgType MessageType = testInt64Type{}
+
+func (t testInt64Type) Text() string        { return "int64 value\n" }
+func (t testInt64Type) MD5Sum() string      { return "34add168574510e6e17f5d23ecc077ef" }
+func (t testInt64Type) Name() string        { return "test_msgs/Int64" }
+func (t testInt64Type) NewMessage() Message { return new(testInt64Msg) }
+
+func (m *testInt64Msg) Type() MessageType { return testInt64MsgType }
+func (m *testInt64Msg) Serialize(buf *bytes.Buffer) error {
+	return binary.Write(buf, binary.LittleEndian, m.Value)
+}
+func (m *testInt64Msg) Deserialize(buf *bytes.Reader) error {
+	return binary.Read(buf, binary.LittleEndian, &m.Value)
+}
+
+type testDoubleSrv struct {
+	Request  testInt64Msg
+	Response testInt64Msg
+}
+
+func (s *testDoubleSrv) Type() ServiceType   { return testDoubleSrvType{} }
+func (s *testDoubleSrv) ReqMessage() Message { return &s.Request }
+func (s *testDoubleSrv) ResMessage() Message { return &s.Response }
+
+type testDoubleSrvType struct{}
+
+func (t testDoubleSrvType) Name() string              { return "test_msgs/Double" }
+func (t testDoubleSrvType) MD5Sum() string            { return "0123456789abcdef0123456789abcdef" }
+func (t testDoubleSrvType) RequestType() MessageType  { return testInt64MsgType }
+func (t testDoubleSrvType) ResponseType() MessageType { return testInt64MsgType }
+func (t testDoubleSrvType) NewService() Service       { return new(testDoubleSrv) }
+
+func TestNewServiceCallback(t *testing.T) {
+	var tests = []struct {
+		handler  interface{}
+		expected int64
+		err      string
+	}{
+		{func(srv *testDoubleSrv) error {
+			srv.Response.Value = srv.Request.Value * 2
+			return nil
+		}, 42, ""},
+		{func(req *testInt64Msg, res *testInt64Msg) error {
+			res.Value = req.Value * 2
+			return nil
+		}, 42, ""},
+		{func(req Message, res Message) error {
+			res.(*testInt64Msg).Value = req.(*testInt64Msg).Value * 2
+			return nil
+		}, 42, ""},
+		{func(req *testInt64Msg, res *testInt64Msg) error {
+			return errors.New("odd")
+		}, 0, "odd"},
+		{func(req *testInt64Msg, res *testInt64Msg) error {
+			panic("broken")
+		}, 0, "Service handler panicked: broken"},
+	}
+	for i, test := range tests {
+		callback, err := newServiceCallback(testDoubleSrvType{}, test.handler)
+		if err != nil {
+			t.Errorf("%d: %v", i, err)
+			continue
+		}
+		srv := &testDoubleSrv{Request: testInt64Msg{21}}
+		err = callback(srv)
+		if test.err == "" && err != nil {
+			t.Errorf("%d: unexpected error %v", i, err)
+		} else if test.err != "" && (err == nil || err.Error() != test.err) {
+			t.Errorf("%d: expected error %q but %v", i, test.err, err)
+		}
+		if srv.Response.Value != test.expected {
+			t.Errorf("%d: expected %d but %d", i, test.expected, srv.Response.Value)
+		}
+	}
+}
+
+func TestNewServiceCallbackInvalid(t *testing.T) {
+	handlers := []interface{}{
+		nil,
+		42,
+		func(srv *testDoubleSrv) {},
+		func(srv *testDoubleSrv) (int, error) { return 0, nil },
+		func(srv *testInt64Msg) error { return nil },
+		func(req *testInt64Msg, res *testDoubleSrv) error { return nil },
+		func(req, res, extra *testInt64Msg) error { return nil },
+	}
+	for i, handler := range handlers {
+		if _, err := newServiceCallback(testDoubleSrvType{}, handler); err == nil {
+			t.Errorf("%d: %T is accepted", i, handler)
+		}
+	}
+}
+
+// Start a service server without a master. Handlers of the node are run
+// only if runJobs is true.
+func startTestServiceServer(t *testing.T, handler interface{}, runJobs bool, options ...ServiceServerOption) (string, func()) {
+	callback, err := newServiceCallback(testDoubleSrvType{}, handler)
+	if err != nil {
+		t.Fatal(err)
+	}
+	node := &defaultNode{qualifiedName: "/server", jobChan: make(chan func(), 10), logger: NewDefaultLogger()}
+	node.logger.SetSeverity(LogLevelFatal)
+	server := &defaultServiceServer{
+		node:             node,
+		service:          "/double",
+		srvType:          testDoubleSrvType{},
+		callback:         callback,
+		sessions:         list.New(),
+		shutdownChan:     make(chan struct{}, 10),
+		sessionErrorChan: make(chan error, 10),
+		quitChan:         make(chan struct{}),
+	}
+	server.configure(options)
+	if server.listener, err = net.Listen("tcp", "127.0.0.1:0"); err != nil {
+		t.Fatal(err)
+	}
+	node.waitGroup.Add(1)
+	go server.start()
+	quit := make(chan struct{})
+	if runJobs {
+		go func() {
+			for {
+				select {
+				case job := <-node.jobChan:
+					job()
+				case <-quit:
+					return
+				}
+			}
+		}()
+	}
+	return server.listener.Addr().String(), func() {
+		server.Shutdown()
+		node.waitGroup.Wait()
+		close(quit)
+	}
+}
+
+func newTestServiceClient(options ...ServiceClientOption) *defaultServiceClient {
+	logger := NewDefaultLogger()
+	logger.SetSeverity(LogLevelFatal)
+	return newDefaultServiceClient(logger, "/client", "", "/double", testDoubleSrvType{}, options...)
+}
+
+func TestServiceCall(t *testing.T) {
+	address, stop := startTestServiceServer(t, func(req *testInt64Msg, res *testInt64Msg) error {
+		if req.Value < 0 {
+			return errors.New("negative value")
+		}
+		res.Value = req.Value * 2
+		return nil
+	}, true)
+	defer stop()
+	client := newTestServiceClient()
+
+	srv := &testDoubleSrv{Request: testInt64Msg{21}}
+	if err := client.doServiceRequest(srv, address); err != nil {
+		t.Fatal(err)
+	}
+	if srv.Response.Value != 42 {
+		t.Errorf("expected 42 but %d", srv.Response.Value)
+	}
+
+	srv = &testDoubleSrv{Request: testInt64Msg{-1}}
+	err := client.doServiceRequest(srv, address)
+	serviceError, ok := err.(*ServiceError)
+	if !ok {
+		t.Fatalf("expected *ServiceError but %T: %v", err, err)
+	}
+	if serviceError.Service != "/double" || serviceError.Message != "negative value" {
+		t.Errorf("unexpected error %#v", serviceError)
+	}
+	if !strings.Contains(err.Error(), "negative value") {
+		t.Errorf("unexpected message %s", err.Error())
+	}
+}
+
+func TestServiceCallTimeout(t *testing.T) {
+	// The handler is never run, so the server does not respond.
+	address, stop := startTestServiceServer(t, func(req *testInt64Msg, res *testInt64Msg) error {
+		return nil
+	}, false)
+	defer stop()
+	if client := newTestServiceClient(); client.responseTimeout != defaultServiceResponseTimeout {
+		t.Errorf("unexpected default timeout %v", client.responseTimeout)
+	}
+	client := newTestServiceClient(WithResponseTimeout(50 * time.Millisecond))
+
+	start := time.Now()
+	err := client.doServiceRequest(&testDoubleSrv{Request: testInt64Msg{21}}, address)
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("waited %v", elapsed)
+	}
+	serviceError, ok := err.(*ServiceError)
+	if !ok {
+		t.Fatalf("expected *ServiceError but %T: %v", err, err)
+	}
+	if !serviceError.Timeout || serviceError.Service != "/double" {
+		t.Errorf("unexpected error %#v", serviceError)
+	}
+}
+
+// Handler which records how many calls run at the same time.
+type concurrencyRecorder struct {
+	mutex   sync.Mutex
+	running int
+	max     int
+	started chan struct{}
+	release chan struct{}
+}
+
+func newConcurrencyRecorder() *concurrencyRecorder {
+	return &concurrencyRecorder{started: make(chan struct{}, 100), release: make(chan struct{})}
+}
+
+func (r *concurrencyRecorder) handle(req *testInt64Msg, res *testInt64Msg) error {
+	r.mutex.Lock()
+	r.running++
+	if r.running > r.max {
+		r.max = r.running
+	}
+	r.mutex.Unlock()
+	r.started <- struct{}{}
+	<-r.release
+	r.mutex.Lock()
+	r.running--
+	r.mutex.Unlock()
+	res.Value = req.Value * 2
+	return nil
+}
+
+// Call the service n times concurrently and return the errors.
+func callConcurrently(address string, n int) chan error {
+	errChan := make(chan error, n)
+	for i := 0; i < n; i++ {
+		go func(i int) {
+			srv := &testDoubleSrv{Request: testInt64Msg{int64(i)}}
+			err := newTestServiceClient().doServiceRequest(srv, address)
+			if err == nil && srv.Response.Value != int64(i)*2 {
+				err = fmt.Errorf("expected %d but %d", i*2, srv.Response.Value)
+			}
+			errChan <- err
+		}(i)
+	}
+	return errChan
+}
+
+func TestServiceWorkers(t *testing.T) {
+	recorder := newConcurrencyRecorder()
+	// Handlers must not depend on the node running jobs.
+	address, stop := startTestServiceServer(t, recorder.handle, false, WithServiceWorkers(4))
+	defer stop()
+	errChan := callConcurrently(address, 4)
+	for i := 0; i < 4; i++ {
+		select {
+		case <-recorder.started:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("only %d handlers started", i)
+		}
+	}
+	close(recorder.release)
+	for i := 0; i < 4; i++ {
+		if err := <-errChan; err != nil {
+			t.Error(err)
+		}
+	}
+	if recorder.max != 4 {
+		t.Errorf("expected 4 concurrent calls but %d", recorder.max)
+	}
+}
+
+func TestServiceTimeout(t *testing.T) {
+	release := make(chan struct{})
+	handler := func(req *testInt64Msg, res *testInt64Msg) error {
+		<-release
+		return nil
+	}
+	address, stop := startTestServiceServer(t, handler, true, WithServiceWorkers(1), WithServiceTimeout(50*time.Millisecond))
+	defer stop()
+	defer close(release)
+
+	start := time.Now()
+	err := newTestServiceClient().doServiceRequest(&testDoubleSrv{Request: testInt64Msg{21}}, address)
+	if err == nil {
+		t.Fatal("a request which is not handled in time succeeds")
+	}
+	if elapsed := time.Since(start); elapsed >= defaultServiceTimeout {
+		t.Errorf("waited %v for the default timeout", elapsed)
+	}
+
+	server := &defaultServiceServer{}
+	server.configure([]ServiceServerOption{WithServiceTimeout(0)})
+	if server.timeout != 0 {
+		t.Errorf("unexpected timeout %v", server.timeout)
+	}
+	server.configure(nil)
+	if server.timeout != defaultServiceTimeout {
+		t.Errorf("unexpected default timeout %v", server.timeout)
+	}
+}
+
+func TestServiceMaxInFlightWait(t *testing.T) {
+	recorder := newConcurrencyRecorder()
+	address, stop := startTestServiceServer(t, recorder.handle, false, WithServiceWorkers(2), WithMaxInFlight(1))
+	defer stop()
+	errChan := callConcurrently(address, 3)
+	for i := 0; i < 3; i++ {
+		select {
+		case <-recorder.started:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("only %d handlers started", i)
+		}
+		recorder.release <- struct{}{}
+	}
+	for i := 0; i < 3; i++ {
+		if err := <-errChan; err != nil {
+			t.Error(err)
+		}
+	}
+	if recorder.max != 1 {
+		t.Errorf("expected 1 concurrent call but %d", recorder.max)
+	}
+}
+
+func TestServiceMaxInFlightReject(t *testing.T) {
+	recorder := newConcurrencyRecorder()
+	address, stop := startTestServiceServer(t, recorder.handle, false,
+		WithServiceWorkers(1), WithQueuePolicy(ServiceQueueReject))
+	defer stop()
+	errChan := callConcurrently(address, 1)
+	<-recorder.started
+
+	srv := &testDoubleSrv{Request: testInt64Msg{1}}
+	err := newTestServiceClient().doServiceRequest(srv, address)
+	if serviceError, ok := err.(*ServiceError); !ok || !strings.Contains(serviceError.Message, "busy") {
+		t.Errorf("expected a busy error but %v", err)
+	}
+
+	close(recorder.release)
+	if err := <-errChan; err != nil {
+		t.Error(err)
+	}
+	// A slot is free again.
+	if err := newTestServiceClient().doServiceRequest(srv, address); err != nil {
+		t.Error(err)
+	}
+}
+
+// Without workers, handlers are run by the node one at a time.
+func TestServiceWithoutWorkers(t *testing.T) {
+	recorder := newConcurrencyRecorder()
+	close(recorder.release)
+	address, stop := startTestServiceServer(t, recorder.handle, true)
+	defer stop()
+	errChan := callConcurrently(address, 3)
+	for i := 0; i < 3; i++ {
+		if err := <-errChan; err != nil {
+			t.Error(err)
+		}
+	}
+	if recorder.max != 1 {
+		t.Errorf("expected 1 concurrent call but %d", recorder.max)
+	}
+}
+
+func TestServiceServerShutdown(t *testing.T) {
+	address, stop := startTestServiceServer(t, func(srv *testDoubleSrv) error { return nil }, true, WithServiceWorkers(1))
+	stop()
+	if conn, err := net.Dial("tcp", address); err == nil {
+		conn.Close()
+		t.Error("the listener is not closed")
+	}
+}
+
+// Requests waiting for a slot when the server is shut down fail as shutting
+// down rather than busy.
+func TestServiceCallDuringShutdown(t *testing.T) {
+	recorder := newConcurrencyRecorder()
+	address, stop := startTestServiceServer(t, recorder.handle, false, WithServiceWorkers(1))
+	errChan := callConcurrently(address, 1)
+	<-recorder.started
+
+	waitingChan := make(chan error, 1)
+	go func() {
+		waitingChan <- newTestServiceClient().doServiceRequest(&testDoubleSrv{}, address)
+	}()
+	// Let the request reach the server and wait for the slot.
+	time.Sleep(100 * time.Millisecond)
+	stop()
+	err := <-waitingChan
+	if serviceError, ok := err.(*ServiceError); !ok || !strings.Contains(serviceError.Message, "shutting down") {
+		t.Errorf("expected a shutting down error but %v", err)
+	}
+	// The request in flight is still handled.
+	close(recorder.release)
+	if err := <-errChan; err != nil {
+		t.Error(err)
+	}
+
+	// The shutdown is checked before the in-flight limit.
+	server := &defaultServiceServer{service: "/double", quitChan: make(chan struct{})}
+	server.configure([]ServiceServerOption{WithMaxInFlight(1), WithQueuePolicy(ServiceQueueReject)})
+	if err := server.acquire(); err != nil {
+		t.Fatal(err)
+	}
+	close(server.quitChan)
+	if err := server.acquire(); err == nil || !strings.Contains(err.Error(), "shutting down") {
+		t.Errorf("expected a shutting down error but %v", err)
+	}
+}
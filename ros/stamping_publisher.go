@@ -0,0 +1,109 @@
+package ros
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// StampingPublisher fills the std_msgs/Header of messages before
+// publishing them. As roscpp does, Seq counts messages published through
+// it starting from 0. Stamp is set to the time of Clock unless it is set
+// already. Messages without a header are published as they are.
+//
+// Like genmsg, a message has a header if its first field is
+// std_msgs/Header.
+type StampingPublisher struct {
+	Publisher
+	// Defaults to Now.
+	Clock func() Time
+	mutex sync.Mutex
+	seq   uint32
+}
+
+func NewStampingPublisher(pub Publisher) *StampingPublisher {
+	return &StampingPublisher{Publisher: pub, Clock: Now}
+}
+
+// Publish updates the header of the message and publishes it.
+func (p *StampingPublisher) Publish(msg Message) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if stampHeader(msg, p.seq, p.Clock) {
+		p.seq++
+	}
+	p.Publisher.Publish(msg)
+}
+
+// Indices of the seq and stamp fields in the header of a message type.
+type headerIndex struct {
+	found bool
+	seq   int
+	stamp int
+}
+
+var headerIndexCache sync.Map
+
+func findHeader(t reflect.Type) headerIndex {
+	if cached, ok := headerIndexCache.Load(t); ok {
+		return cached.(headerIndex)
+	}
+	index := headerIndex{}
+	if t.NumField() > 0 {
+		field := t.Field(0)
+		tag := strings.SplitN(field.Tag.Get("rosmsg"), ":", 2)
+		if len(tag) == 2 && (tag[1] == "Header" || tag[1] == "std_msgs/Header") && field.Type.Kind() == reflect.Struct {
+			index.seq, index.stamp = -1, -1
+			for i := 0; i < field.Type.NumField(); i++ {
+				f := field.Type.Field(i)
+				switch f.Tag.Get("rosmsg") {
+				case "seq:uint32":
+					if f.Type.Kind() == reflect.Uint32 {
+						index.seq = i
+					}
+				case "stamp:time":
+					if f.Type == timeType {
+						index.stamp = i
+					}
+				}
+			}
+			index.found = index.seq >= 0 && index.stamp >= 0
+		}
+	}
+	headerIndexCache.Store(t, index)
+	return index
+}
+
+// Set seq to the header of the message, and stamp if it is zero. It returns
+// false if the message has no header.
+func stampHeader(msg Message, seq uint32, clock func() Time) bool {
+	v, err := messageStruct(msg)
+	if err != nil {
+		return false
+	}
+	index := findHeader(v.Type())
+	if !index.found {
+		return false
+	}
+	header := v.Field(0)
+	header.Field(index.seq).SetUint(uint64(seq))
+	stamp := header.Field(index.stamp).Addr().Interface().(*Time)
+	if stamp.IsZero() {
+		*stamp = clock()
+	}
+	return true
+}
+
+// HeaderStamp returns the stamp of the header of the message. It returns
+// false if the message has no header.
+func HeaderStamp(msg Message) (Time, bool) {
+	v, err := messageStruct(msg)
+	if err != nil {
+		return Time{}, false
+	}
+	index := findHeader(v.Type())
+	if !index.found {
+		return Time{}, false
+	}
+	return v.Field(0).Field(index.stamp).Interface().(Time), true
+}
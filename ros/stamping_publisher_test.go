@@ -0,0 +1,119 @@
+package ros
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+)
+
+type testHeader struct {
+	Seq     uint32 `rosmsg:"seq:uint32"`
+	Stamp   Time   `rosmsg:"stamp:time"`
+	FrameId string `rosmsg:"frame_id:string"`
+}
+
+type testStampedMsg struct {
+	Header testHeader `rosmsg:"header:Header"`
+	Value  int32      `rosmsg:"value:int32"`
+}
+
+func (m *testStampedMsg) Type() MessageType                 { return nil }
+func (m *testStampedMsg) Serialize(buf *bytes.Buffer) error { return nil }
+func (m *testStampedMsg) Deserialize(buf *bytes.Reader) error {
+	return nil
+}
+
+// A header which is not the first field is not filled.
+type testNotStampedMsg struct {
+	Value  int32      `rosmsg:"value:int32"`
+	Header testHeader `rosmsg:"header:Header"`
+}
+
+func (m *testNotStampedMsg) Type() MessageType                 { return nil }
+func (m *testNotStampedMsg) Serialize(buf *bytes.Buffer) error { return nil }
+func (m *testNotStampedMsg) Deserialize(buf *bytes.Reader) error {
+	return nil
+}
+
+type testPublisher struct {
+	mutex     sync.Mutex
+	published []Message
+}
+
+func (p *testPublisher) Publish(msg Message) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.published = append(p.published, msg)
+}
+
+func (p *testPublisher) Shutdown() {}
+
+func TestStampingPublisher(t *testing.T) {
+	inner := &testPublisher{}
+	pub := NewStampingPublisher(inner)
+	pub.Clock = func() Time { return NewTime(10, 20) }
+
+	first := &testStampedMsg{}
+	pub.Publish(first)
+	stamped := &testStampedMsg{Header: testHeader{Seq: 100, Stamp: NewTime(1, 2), FrameId: "base"}}
+	pub.Publish(stamped)
+	pub.Publish(&testNotStampedMsg{})
+	pub.Publish(&testPoint{})
+	last := &testStampedMsg{}
+	pub.Publish(last)
+
+	if len(inner.published) != 5 {
+		t.Fatalf("expected 5 messages but %d", len(inner.published))
+	}
+	expected := testHeader{Seq: 0, Stamp: NewTime(10, 20)}
+	if first.Header != expected {
+		t.Errorf("expected %v but %v", expected, first.Header)
+	}
+	// The stamp set by the user is kept.
+	expected = testHeader{Seq: 1, Stamp: NewTime(1, 2), FrameId: "base"}
+	if stamped.Header != expected {
+		t.Errorf("expected %v but %v", expected, stamped.Header)
+	}
+	if h := inner.published[2].(*testNotStampedMsg).Header; h != (testHeader{}) {
+		t.Errorf("unexpected header %v", h)
+	}
+	// Messages without a header do not count.
+	if last.Header.Seq != 2 {
+		t.Errorf("expected seq 2 but %d", last.Header.Seq)
+	}
+}
+
+func TestStampingPublisherConcurrent(t *testing.T) {
+	inner := &testPublisher{}
+	pub := NewStampingPublisher(inner)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				pub.Publish(&testStampedMsg{})
+			}
+		}()
+	}
+	wg.Wait()
+	// Messages reach the publisher in the order of seq.
+	for i, msg := range inner.published {
+		if seq := msg.(*testStampedMsg).Header.Seq; seq != uint32(i) {
+			t.Fatalf("expected seq %d but %d", i, seq)
+		}
+	}
+	if len(inner.published) != 1000 {
+		t.Errorf("expected 1000 messages but %d", len(inner.published))
+	}
+}
+
+func TestHeaderStamp(t *testing.T) {
+	msg := &testStampedMsg{Header: testHeader{Stamp: NewTime(3, 4)}}
+	if stamp, ok := HeaderStamp(msg); !ok || stamp != NewTime(3, 4) {
+		t.Errorf("expected 3.000000004, got %v %v", stamp, ok)
+	}
+	if _, ok := HeaderStamp(&testNotStampedMsg{}); ok {
+		t.Error("message without a header has a stamp")
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
-	"io"
 	"net"
 	"reflect"
 	"sync"
@@ -26,9 +25,15 @@ type defaultSubscriber struct {
 	msgChan          chan messageEvent
 	callbacks        []interface{}
 	addCallbackChan  chan interface{}
+	sinks            []*messageSink
+	addSinkChan      chan *messageSink
+	removeSinkChan   chan *messageSink
 	shutdownChan     chan struct{}
 	connections      map[string]chan struct{}
 	disconnectedChan chan string
+	// Number of callbacks and channels, guarded by registerMutex of the
+	// node.
+	users int
 }
 
 func newDefaultSubscriber(topic string, msgType MessageType, callback interface{}) *defaultSubscriber {
@@ -38,16 +43,19 @@ func newDefaultSubscriber(topic string, msgType MessageType, callback interface{
 	sub.msgChan = make(chan messageEvent, 10)
 	sub.pubListChan = make(chan []string, 10)
 	sub.addCallbackChan = make(chan interface{}, 10)
+	sub.addSinkChan = make(chan *messageSink, 10)
+	sub.removeSinkChan = make(chan *messageSink, 10)
 	sub.shutdownChan = make(chan struct{}, 10)
 	sub.disconnectedChan = make(chan string, 10)
 	sub.connections = make(map[string]chan struct{})
-	sub.callbacks = []interface{}{callback}
+	if callback != nil {
+		sub.callbacks = []interface{}{callback}
+	}
 	return sub
 }
 
 func (sub *defaultSubscriber) start(wg *sync.WaitGroup, nodeId, nodeApiUri, masterUri string, jobChan chan func(), logger Logger) {
 	logger.Debugf("Subscriber goroutine for %s started.", sub.topic)
-	wg.Add(1)
 	defer wg.Done()
 	defer func() {
 		logger.Debug("defaultSubscriber.start exit")
@@ -98,9 +106,19 @@ func (sub *defaultSubscriber) start(wg *sync.WaitGroup, nodeId, nodeApiUri, mast
 		case callback := <-sub.addCallbackChan:
 			logger.Debug("Receive addCallbackChan")
 			sub.callbacks = append(sub.callbacks, callback)
+		case sink := <-sub.addSinkChan:
+			logger.Debug("Receive addSinkChan")
+			sub.sinks = append(sub.sinks, sink)
+		case sink := <-sub.removeSinkChan:
+			logger.Debug("Receive removeSinkChan")
+			sub.removeSink(sink)
 		case msgEvent := <-sub.msgChan:
 			// Pop received message then bind callbacks and enqueue to the job channle.
 			logger.Debug("Receive msgChan")
+			sub.sendToSinks(msgEvent, logger)
+			if len(sub.callbacks) == 0 {
+				continue
+			}
 			callbacks := make([]interface{}, len(sub.callbacks))
 			copy(callbacks, sub.callbacks)
 			jobChan <- func() {
@@ -109,6 +127,9 @@ func (sub *defaultSubscriber) start(wg *sync.WaitGroup, nodeId, nodeApiUri, mast
 				if err := m.Deserialize(reader); err != nil {
 					logger.Error(err)
 				}
+				if raw, ok := m.(*RawMessage); ok {
+					raw.msgType = newRawMessageTypeFromHeader(msgEvent.event.ConnectionHeader)
+				}
 				args := []reflect.Value{reflect.ValueOf(m), reflect.ValueOf(msgEvent.event)}
 				for _, callback := range callbacks {
 					fun := reflect.ValueOf(callback)
@@ -133,7 +154,140 @@ func (sub *defaultSubscriber) start(wg *sync.WaitGroup, nodeId, nodeApiUri, mast
 			if err != nil {
 				logger.Warn(err)
 			}
+			sub.closeSinks()
+			return
+		}
+	}
+}
+
+// Deserialize the message once and send it to the channels of SubscribeChan.
+func (sub *defaultSubscriber) sendToSinks(msgEvent messageEvent, logger Logger) {
+	if len(sub.sinks) == 0 {
+		return
+	}
+	m := sub.msgType.NewMessage()
+	if err := m.Deserialize(bytes.NewReader(msgEvent.bytes)); err != nil {
+		logger.Error(err)
+		return
+	}
+	if raw, ok := m.(*RawMessage); ok {
+		raw.msgType = newRawMessageTypeFromHeader(msgEvent.event.ConnectionHeader)
+	}
+	received := ReceivedMessage{m, msgEvent.event}
+	for _, sink := range sub.sinks {
+		sink.send(received, sub.shutdownChan)
+	}
+}
+
+// Remove the sink and close its channel. The sink may still be waiting in
+// addSinkChan.
+func (sub *defaultSubscriber) removeSink(sink *messageSink) {
+	for {
+		for i, s := range sub.sinks {
+			if s == sink {
+				sub.sinks = append(sub.sinks[:i:i], sub.sinks[i+1:]...)
+				close(sink.ch)
+				return
+			}
+		}
+		select {
+		case added := <-sub.addSinkChan:
+			sub.sinks = append(sub.sinks, added)
+		default:
+			return
+		}
+	}
+}
+
+func (sub *defaultSubscriber) closeSinks() {
+	for {
+		select {
+		case sink := <-sub.addSinkChan:
+			sub.sinks = append(sub.sinks, sink)
+		default:
+			for _, sink := range sub.sinks {
+				close(sink.ch)
+			}
+			sub.sinks = nil
+			return
+		}
+	}
+}
+
+// ReceivedMessage is an element of the channel returned by
+// Node.SubscribeChan.
+type ReceivedMessage struct {
+	Message Message
+	Event   MessageEvent
+}
+
+// OverflowPolicy decides what happens to a message when the channel of
+// Node.SubscribeChan is full.
+type OverflowPolicy int
+
+const (
+	// Discard the oldest message in the channel, like the queue of roscpp.
+	OverflowDropOldest OverflowPolicy = iota
+	// Discard the new message.
+	OverflowDropNewest
+	// Wait until the receiver takes a message. Messages from all
+	// publishers of the topic are held back meanwhile.
+	OverflowBlock
+)
+
+type SubscribeChanOption func(*messageSink)
+
+// WithOverflowPolicy sets the policy for a full channel. The default is
+// OverflowDropOldest.
+func WithOverflowPolicy(policy OverflowPolicy) SubscribeChanOption {
+	return func(sink *messageSink) {
+		sink.policy = policy
+	}
+}
+
+type messageSink struct {
+	ch     chan ReceivedMessage
+	policy OverflowPolicy
+}
+
+func newMessageSink(bufferSize int, options []SubscribeChanOption) *messageSink {
+	sink := &messageSink{ch: make(chan ReceivedMessage, bufferSize)}
+	for _, option := range options {
+		option(sink)
+	}
+	return sink
+}
+
+// Only the subscriber goroutine sends to the channel, so it cannot get full
+// between dropping a message and sending another.
+func (sink *messageSink) send(msg ReceivedMessage, shutdownChan chan struct{}) {
+	switch sink.policy {
+	case OverflowBlock:
+		select {
+		case sink.ch <- msg:
+		case <-shutdownChan:
+			// Put it back for the subscriber loop.
+			shutdownChan <- struct{}{}
+		}
+	case OverflowDropNewest:
+		select {
+		case sink.ch <- msg:
+		default:
+		}
+	default:
+		select {
+		case sink.ch <- msg:
 			return
+		default:
+		}
+		select {
+		case <-sink.ch:
+		default:
+		}
+		// Fails only if the channel is unbuffered and nobody is receiving.
+		select {
+		case sink.ch <- msg:
+		default:
 		}
 	}
 }
@@ -182,7 +336,7 @@ func startRemotePublisherConn(logger Logger,
 		resHeaderMap[h.key] = h.value
 		logger.Debugf("  `%s` = `%s`", h.key, h.value)
 	}
-	if resHeaderMap["type"] != msgType || resHeaderMap["md5sum"] != md5sum {
+	if md5sum != "*" && (resHeaderMap["type"] != msgType || resHeaderMap["md5sum"] != md5sum) {
 		logger.Fatalf("Incomatible message type!")
 	}
 	logger.Debug("Start receiving messages...")
@@ -191,52 +345,51 @@ func startRemotePublisherConn(logger Logger,
 		ConnectionHeader: resHeaderMap,
 	}
 
-	// 3. Start reading messages
+	// 3. Start reading messages. Bytes read before a timeout are kept, so
+	// that the rest of the size or the body is read next time.
 	readingSize := true
-	var msgSize uint32 = 0
-	var buffer []byte
+	sizeBuffer := make([]byte, 4)
+	buffer := sizeBuffer
+	read := 0
 	for {
 		select {
 		case <-quitChan:
 			return
 		default:
+		}
+		if read < len(buffer) {
 			conn.SetDeadline(time.Now().Add(10 * time.Millisecond))
-			if readingSize {
-				//logger.Debug("Reading message size...")
-				err := binary.Read(conn, binary.LittleEndian, &msgSize)
-				if err != nil {
-					if neterr, ok := err.(net.Error); ok && neterr.Timeout() {
-						// Timed out
-						//logger.Debug(neterr)
-						continue
-					} else {
-						logger.Error("Failed to read a message size")
-						disconnectedChan <- pubUri
-						return
-					}
+			n, err := conn.Read(buffer[read:])
+			read += n
+			if err != nil {
+				if neterr, ok := err.(net.Error); ok && neterr.Timeout() {
+					// Timed out
+					continue
 				}
-				logger.Debugf("  %d", msgSize)
-				buffer = make([]byte, int(msgSize))
-				readingSize = false
-			} else {
-				//logger.Debug("Reading message body...")
-				_, err = io.ReadFull(conn, buffer)
-				if err != nil {
-					if neterr, ok := err.(net.Error); ok && neterr.Timeout() {
-						// Timed out
-						//logger.Debug(neterr)
-						continue
-					} else {
-						logger.Error("Failed to read a message body")
-						disconnectedChan <- pubUri
-						return
-					}
+				if readingSize {
+					logger.Error("Failed to read a message size")
+				} else {
+					logger.Error("Failed to read a message body")
 				}
-				event.ReceiptTime = time.Now()
-				msgChan <- messageEvent{bytes: buffer, event: event}
-				readingSize = true
+				disconnectedChan <- pubUri
+				return
+			}
+			if read < len(buffer) {
+				continue
 			}
 		}
+		if readingSize {
+			msgSize := binary.LittleEndian.Uint32(sizeBuffer)
+			logger.Debugf("  %d", msgSize)
+			buffer = make([]byte, int(msgSize))
+			readingSize = false
+		} else {
+			event.ReceiptTime = time.Now()
+			msgChan <- messageEvent{bytes: buffer, event: event}
+			buffer = sizeBuffer
+			readingSize = true
+		}
+		read = 0
 	}
 }
 
@@ -247,3 +400,22 @@ func (sub *defaultSubscriber) Shutdown() {
 func (sub *defaultSubscriber) GetNumPublishers() int {
 	return len(sub.pubList)
 }
+
+// The Subscriber of a channel of SubscribeChan. Shutting it down closes
+// only its channel, so that other users of the topic keep receiving.
+type sinkSubscriber struct {
+	node *defaultNode
+	sub  *defaultSubscriber
+	sink *messageSink
+	once sync.Once
+}
+
+func (s *sinkSubscriber) Shutdown() {
+	s.once.Do(func() {
+		s.node.removeSink(s.sub, s.sink)
+	})
+}
+
+func (s *sinkSubscriber) GetNumPublishers() int {
+	return s.sub.GetNumPublishers()
+}
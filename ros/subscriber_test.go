@@ -0,0 +1,242 @@
+package ros
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"sync"
+	"testing"
+	"time"
+)
+
+func receivedValues(ch <-chan ReceivedMessage) []int64 {
+	var values []int64
+	for {
+		select {
+		case msg := <-ch:
+			values = append(values, msg.Message.(*testInt64Msg).Value)
+		default:
+			return values
+		}
+	}
+}
+
+func sendValues(sink *messageSink, shutdownChan chan struct{}, values ...int64) {
+	for _, v := range values {
+		sink.send(ReceivedMessage{Message: &testInt64Msg{v}}, shutdownChan)
+	}
+}
+
+func TestMessageSinkDropOldest(t *testing.T) {
+	sink := newMessageSink(2, nil)
+	sendValues(sink, nil, 1, 2, 3)
+	if values := receivedValues(sink.ch); len(values) != 2 || values[0] != 2 || values[1] != 3 {
+		t.Errorf("expected [2 3], got %v", values)
+	}
+}
+
+func TestMessageSinkDropNewest(t *testing.T) {
+	sink := newMessageSink(2, []SubscribeChanOption{WithOverflowPolicy(OverflowDropNewest)})
+	sendValues(sink, nil, 1, 2, 3)
+	if values := receivedValues(sink.ch); len(values) != 2 || values[0] != 1 || values[1] != 2 {
+		t.Errorf("expected [1 2], got %v", values)
+	}
+}
+
+func TestMessageSinkUnbufferedDoesNotBlock(t *testing.T) {
+	for _, policy := range []OverflowPolicy{OverflowDropOldest, OverflowDropNewest} {
+		sink := newMessageSink(0, []SubscribeChanOption{WithOverflowPolicy(policy)})
+		sendValues(sink, nil, 1)
+	}
+}
+
+func TestMessageSinkBlock(t *testing.T) {
+	sink := newMessageSink(1, []SubscribeChanOption{WithOverflowPolicy(OverflowBlock)})
+	shutdownChan := make(chan struct{}, 1)
+	done := make(chan struct{})
+	go func() {
+		sendValues(sink, shutdownChan, 1, 2)
+		close(done)
+	}()
+	if msg := <-sink.ch; msg.Message.(*testInt64Msg).Value != 1 {
+		t.Errorf("expected 1, got %v", msg.Message)
+	}
+	<-done
+	if values := receivedValues(sink.ch); len(values) != 1 || values[0] != 2 {
+		t.Errorf("expected [2], got %v", values)
+	}
+
+	// Shutdown releases a blocked sender and is left for the subscriber.
+	sendValues(sink, shutdownChan, 3)
+	done = make(chan struct{})
+	go func() {
+		sendValues(sink, shutdownChan, 4)
+		close(done)
+	}()
+	shutdownChan <- struct{}{}
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("sender is still blocked after shutdown")
+	}
+	if len(shutdownChan) != 1 {
+		t.Error("shutdown request is consumed")
+	}
+}
+
+func TestSubscribeChan(t *testing.T) {
+	sub := newDefaultSubscriber("/value", testInt64MsgType, nil)
+	sink := newMessageSink(10, nil)
+	sub.sinks = append(sub.sinks, sink)
+	done := make(chan struct{})
+	go func() {
+		var wg sync.WaitGroup
+		wg.Add(1)
+		sub.start(&wg, "/sub", "http://localhost:0/", "http://localhost:0/", make(chan func()), NewDefaultLogger())
+		close(done)
+	}()
+
+	var buf bytes.Buffer
+	(&testInt64Msg{42}).Serialize(&buf)
+	event := MessageEvent{PublisherName: "/pub"}
+	sub.msgChan <- messageEvent{bytes: buf.Bytes(), event: event}
+	select {
+	case msg := <-sink.ch:
+		if msg.Message.(*testInt64Msg).Value != 42 || msg.Event.PublisherName != "/pub" {
+			t.Errorf("unexpected message %v", msg)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no message is received")
+	}
+
+	sub.Shutdown()
+	select {
+	case _, ok := <-sink.ch:
+		if ok {
+			t.Error("channel is not closed")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("channel is not closed")
+	}
+	<-done
+}
+
+// A publisher pausing in the middle of a message for longer than the read
+// timeout of the subscriber.
+func TestRemotePublisherConnSlowPublisher(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	logger := NewDefaultLogger()
+	logger.SetSeverity(LogLevelFatal)
+	msgChan := make(chan messageEvent, 10)
+	quitChan := make(chan struct{}, 10)
+	go startRemotePublisherConn(logger, listener.Addr().String(), "/slow",
+		testInt64MsgType.MD5Sum(), testInt64MsgType.Name(), "/tester",
+		msgChan, quitChan, make(chan string, 10))
+	defer func() { quitChan <- struct{}{} }()
+
+	conn, err := listener.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if _, err := readConnectionHeader(conn); err != nil {
+		t.Fatal(err)
+	}
+	headers := []header{
+		{"callerid", "/slow_publisher"},
+		{"md5sum", testInt64MsgType.MD5Sum()},
+		{"type", testInt64MsgType.Name()},
+	}
+	if err := writeConnectionHeader(headers, conn); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	for _, value := range []int64{1, 2} {
+		binary.Write(&buf, binary.LittleEndian, uint32(8))
+		binary.Write(&buf, binary.LittleEndian, value)
+	}
+	data := buf.Bytes()
+	// Split the size of the first message and the body of the second.
+	for _, chunk := range [][]byte{data[:2], data[2:18], data[18:]} {
+		conn.Write(chunk)
+		time.Sleep(30 * time.Millisecond)
+	}
+
+	for _, expected := range []int64{1, 2} {
+		select {
+		case msgEvent := <-msgChan:
+			var msg testInt64Msg
+			if err := msg.Deserialize(bytes.NewReader(msgEvent.bytes)); err != nil || msg.Value != expected {
+				t.Errorf("expected %d but %d, %v", expected, msg.Value, err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("message %d is not received", expected)
+		}
+	}
+}
+
+// Wait until the channel is closed, skipping the messages left in it.
+func waitClosed(t *testing.T, ch <-chan ReceivedMessage) {
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case _, ok := <-ch:
+			if !ok {
+				return
+			}
+		case <-timeout:
+			t.Fatal("channel is not closed")
+		}
+	}
+}
+
+// Shutting down a subscriber of SubscribeChan closes only its channel, and
+// the topic is unsubscribed with the last one.
+func TestSubscribeChanShutdown(t *testing.T) {
+	masterUri, stopMaster := startTestMaster(t)
+	defer stopMaster()
+	node, err := newDefaultNode("/test_node", []string{"__master:=" + masterUri, "__ip:=127.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	node.logger.SetSeverity(LogLevelFatal)
+	defer node.Shutdown()
+
+	pub := node.NewPublisher("/value", testInt64MsgType)
+	first, firstSub := node.SubscribeChan("/value", testInt64MsgType, 10)
+	second, secondSub := node.SubscribeChan("/value", testInt64MsgType, 10)
+	firstSub.Shutdown()
+	firstSub.Shutdown()
+	waitClosed(t, first)
+
+	timeout := time.After(5 * time.Second)
+	// Messages published before the connection are lost, so publish
+	// until one is received.
+	for done := false; !done; {
+		pub.Publish(&testInt64Msg{7})
+		select {
+		case msg := <-second:
+			if value := msg.Message.(*testInt64Msg).Value; value != 7 {
+				t.Errorf("expected 7 but %d", value)
+			}
+			done = true
+		case <-timeout:
+			t.Fatal("no message is received")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	secondSub.Shutdown()
+	waitClosed(t, second)
+	deadline := time.Now().Add(5 * time.Second)
+	for len(topics(t, node, "getSubscriptions")) != 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("the topic is still subscribed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -1,11 +1,10 @@
 package main
 
-//go:generate gengo msg std_msgs/String
 import (
 	"fmt"
+	"github.com/akio/rosgo/msgs/std_msgs"
 	"github.com/akio/rosgo/ros"
 	"os"
-	"std_msgs"
 	"time"
 )
 
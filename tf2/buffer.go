@@ -0,0 +1,48 @@
+package tf2
+
+import (
+	"time"
+
+	"github.com/akio/rosgo/msgs/geometry_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+// Buffer is a BufferCore whose lookups wait for transforms to arrive.
+type Buffer struct {
+	*BufferCore
+}
+
+func NewBuffer(cacheTime ros.Duration) *Buffer {
+	return &Buffer{NewBufferCore(cacheTime)}
+}
+
+// LookupTransform is like BufferCore.LookupTransform but retries whenever
+// a transform is set until the timeout. It returns the last error if the
+// lookup does not succeed in time.
+func (b *Buffer) LookupTransform(target, source string, t ros.Time, timeout ros.Duration) (geometry_msgs.TransformStamped, error) {
+	var timer *time.Timer
+	for {
+		// Take the channel first not to miss an update during the lookup.
+		updated := b.updated()
+		result, err := b.BufferCore.LookupTransform(target, source, t)
+		if err == nil || timeout.IsZero() {
+			return result, err
+		}
+		if timer == nil {
+			timer = time.NewTimer(time.Duration(timeout.ToNSec()))
+			defer timer.Stop()
+		}
+		select {
+		case <-updated:
+		case <-timer.C:
+			return result, err
+		}
+	}
+}
+
+// CanTransform reports whether the transform becomes available within the
+// timeout.
+func (b *Buffer) CanTransform(target, source string, t ros.Time, timeout ros.Duration) bool {
+	_, err := b.LookupTransform(target, source, t, timeout)
+	return err == nil
+}
@@ -0,0 +1,315 @@
+// Package tf2 keeps track of coordinate frames over time, like tf2 and
+// tf2_ros.
+//
+// BufferCore stores transforms and looks up the transform between any two
+// frames of the tree at a time. Buffer adds timeouts to the lookups, and
+// TransformListener fills a Buffer from the /tf and /tf_static topics, which
+// TransformBroadcaster and StaticTransformBroadcaster publish to.
+//
+//	buffer := tf2.NewBuffer(tf2.DefaultCacheTime)
+//	listener := tf2.NewTransformListener(node, buffer)
+//	defer listener.Shutdown()
+//	transform, err := buffer.LookupTransform("map", "base_link", ros.Time{}, ros.NewDuration(1, 0))
+package tf2
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/akio/rosgo/msgs/geometry_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+// DefaultCacheTime is how long transforms are kept, as tf2 does.
+var DefaultCacheTime = ros.NewDuration(10, 0)
+
+// Deeper trees are considered to have a loop.
+const maxGraphDepth = 1000
+
+// LookupError is returned when a frame is unknown.
+type LookupError struct {
+	Message string
+}
+
+func (e *LookupError) Error() string {
+	return e.Message
+}
+
+// ConnectivityError is returned when two frames are not in the same tree.
+type ConnectivityError struct {
+	Message string
+}
+
+func (e *ConnectivityError) Error() string {
+	return e.Message
+}
+
+// ExtrapolationError is returned when a transform of the chain is not known
+// at the requested time.
+type ExtrapolationError struct {
+	Message string
+}
+
+func (e *ExtrapolationError) Error() string {
+	return e.Message
+}
+
+// BufferCore stores the transforms of a frame tree for the cache time and
+// looks up transforms between frames. It is safe for concurrent use.
+type BufferCore struct {
+	mutex     sync.RWMutex
+	cacheTime uint64
+	// Transforms to the parents by child frame.
+	frames map[string]*timeCache
+	// All frames including the roots which are no child.
+	known map[string]bool
+	// Closed and renewed whenever a transform is set.
+	updatedChan chan struct{}
+}
+
+func NewBufferCore(cacheTime ros.Duration) *BufferCore {
+	return &BufferCore{
+		cacheTime:   cacheTime.ToNSec(),
+		frames:      make(map[string]*timeCache),
+		known:       make(map[string]bool),
+		updatedChan: make(chan struct{}),
+	}
+}
+
+// tf2 frame ids have no leading slash, but tf ones did.
+func stripSlash(frame string) string {
+	return strings.TrimPrefix(frame, "/")
+}
+
+// SetTransform adds the transform from transform.ChildFrameId to
+// transform.Header.FrameId. authority is the node which sent it. Static
+// transforms are valid at any time.
+func (b *BufferCore) SetTransform(transform geometry_msgs.TransformStamped, authority string, isStatic bool) error {
+	parent := stripSlash(transform.Header.FrameId)
+	child := stripSlash(transform.ChildFrameId)
+	switch {
+	case child == "":
+		return fmt.Errorf("Ignoring transform from authority %s with an empty child_frame_id", authority)
+	case parent == "":
+		return fmt.Errorf("Ignoring transform with child_frame_id %s from authority %s because frame_id is not set", child, authority)
+	case parent == child:
+		return fmt.Errorf("Ignoring transform from authority %s with frame_id and child_frame_id %s because they are the same", authority, child)
+	case hasNaN(transform.Transform):
+		return fmt.Errorf("Ignoring transform for child_frame_id %s from authority %s because of a nan value in the transform", child, authority)
+	case math.Abs(quaternionLength2(transform.Transform.Rotation)-1) > 10e-3:
+		return fmt.Errorf("Ignoring transform for child_frame_id %s from authority %s because of an invalid quaternion in the transform", child, authority)
+	}
+	tf := transform.Transform
+	tf.Rotation = normalize(tf.Rotation)
+	entry := transformEntry{
+		stamp:     transform.Header.Stamp.ToNSec(),
+		parent:    parent,
+		transform: tf,
+		authority: authority,
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	cache, ok := b.frames[child]
+	if !ok || cache.static != isStatic {
+		cache = &timeCache{static: isStatic}
+		b.frames[child] = cache
+	}
+	if err := cache.insert(entry, b.cacheTime); err != nil {
+		return fmt.Errorf("Ignoring transform for child_frame_id %s from authority %s: %s", child, authority, err)
+	}
+	b.known[child] = true
+	b.known[parent] = true
+	close(b.updatedChan)
+	b.updatedChan = make(chan struct{})
+	return nil
+}
+
+// A channel closed when the next transform is set.
+func (b *BufferCore) updated() <-chan struct{} {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	return b.updatedChan
+}
+
+// LookupTransform returns the transform which maps points in the source
+// frame to the target frame at the time. The zero time means the latest
+// time when all transforms of the chain are known.
+func (b *BufferCore) LookupTransform(target, source string, t ros.Time) (geometry_msgs.TransformStamped, error) {
+	target, source = stripSlash(target), stripSlash(source)
+	b.mutex.RLock()
+	tf, stamp, err := b.lookup(target, source, t.ToNSec())
+	b.mutex.RUnlock()
+	if err != nil {
+		return geometry_msgs.TransformStamped{}, err
+	}
+	result := geometry_msgs.TransformStamped{ChildFrameId: source, Transform: tf}
+	result.Header.FrameId = target
+	result.Header.Stamp.FromNSec(stamp)
+	return result, nil
+}
+
+// CanTransform reports whether LookupTransform would succeed.
+func (b *BufferCore) CanTransform(target, source string, t ros.Time) bool {
+	_, err := b.LookupTransform(target, source, t)
+	return err == nil
+}
+
+// FrameExists reports whether any transform has the frame as the parent or
+// the child.
+func (b *BufferCore) FrameExists(frame string) bool {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	return b.known[stripSlash(frame)]
+}
+
+// FrameIds returns the known frames in sorted order.
+func (b *BufferCore) FrameIds() []string {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	frames := make([]string, 0, len(b.known))
+	for frame := range b.known {
+		frames = append(frames, frame)
+	}
+	sort.Strings(frames)
+	return frames
+}
+
+// Clear removes all transforms.
+func (b *BufferCore) Clear() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.frames = make(map[string]*timeCache)
+	b.known = make(map[string]bool)
+}
+
+func (b *BufferCore) checkFrame(frame string, arg string) error {
+	if frame == "" {
+		return &LookupError{fmt.Sprintf("Invalid argument passed to lookupTransform argument %s in tf2 frame_ids cannot be empty", arg)}
+	}
+	if !b.known[frame] {
+		return &LookupError{fmt.Sprintf("\"%s\" passed to lookupTransform argument %s does not exist. ", frame, arg)}
+	}
+	return nil
+}
+
+func (b *BufferCore) lookup(target, source string, stamp uint64) (geometry_msgs.Transform, uint64, error) {
+	if err := b.checkFrame(target, "target_frame"); err != nil {
+		return geometry_msgs.Transform{}, 0, err
+	}
+	if err := b.checkFrame(source, "source_frame"); err != nil {
+		return geometry_msgs.Transform{}, 0, err
+	}
+	if target == source {
+		return identity(), stamp, nil
+	}
+	if stamp == 0 {
+		var err error
+		if stamp, err = b.latestCommonTime(target, source); err != nil {
+			return geometry_msgs.Transform{}, 0, err
+		}
+	}
+
+	// Transforms from the source frame to its ancestors.
+	sourceChain := make(map[string]geometry_msgs.Transform)
+	var extrapolationErr error
+	frame, tf := source, identity()
+	for depth := 0; ; depth++ {
+		if depth > maxGraphDepth {
+			return geometry_msgs.Transform{}, 0, &LookupError{"The tf tree is invalid because it contains a loop."}
+		}
+		sourceChain[frame] = tf
+		if frame == target {
+			return tf, stamp, nil
+		}
+		cache, ok := b.frames[frame]
+		if !ok {
+			break
+		}
+		entry, err := cache.get(stamp)
+		if err != nil {
+			extrapolationErr = err
+			break
+		}
+		frame, tf = entry.parent, multiply(entry.transform, tf)
+	}
+
+	// Go up from the target frame until the chain of the source is met.
+	frame, tf = target, identity()
+	for depth := 0; ; depth++ {
+		if depth > maxGraphDepth {
+			return geometry_msgs.Transform{}, 0, &LookupError{"The tf tree is invalid because it contains a loop."}
+		}
+		if sourceTf, ok := sourceChain[frame]; ok {
+			return multiply(inverse(tf), sourceTf), stamp, nil
+		}
+		cache, ok := b.frames[frame]
+		if !ok {
+			break
+		}
+		entry, err := cache.get(stamp)
+		if err != nil {
+			extrapolationErr = err
+			break
+		}
+		frame, tf = entry.parent, multiply(entry.transform, tf)
+	}
+
+	if extrapolationErr != nil {
+		return geometry_msgs.Transform{}, 0, &ExtrapolationError{fmt.Sprintf(
+			"%s, when looking up transform from frame [%s] to frame [%s]",
+			extrapolationErr.(*ExtrapolationError).Message, source, target)}
+	}
+	return geometry_msgs.Transform{}, 0, &ConnectivityError{fmt.Sprintf(
+		"Could not find a connection between '%s' and '%s' because they are not part of the same tree. Tf has two or more unconnected trees.",
+		target, source)}
+}
+
+// The latest time when all transforms between the frames are known. It is
+// zero if they are all static.
+func (b *BufferCore) latestCommonTime(target, source string) (uint64, error) {
+	const none = math.MaxUint64
+	// The oldest of the latest stamps from the source frame to each of its
+	// ancestors.
+	sourceChain := make(map[string]uint64)
+	frame, oldest := source, uint64(none)
+	for depth := 0; depth <= maxGraphDepth; depth++ {
+		sourceChain[frame] = oldest
+		cache, ok := b.frames[frame]
+		if !ok {
+			break
+		}
+		entry := cache.latest()
+		if !cache.static && entry.stamp < oldest {
+			oldest = entry.stamp
+		}
+		frame = entry.parent
+	}
+	frame, oldest = target, uint64(none)
+	for depth := 0; depth <= maxGraphDepth; depth++ {
+		if sourceOldest, ok := sourceChain[frame]; ok {
+			if sourceOldest < oldest {
+				oldest = sourceOldest
+			}
+			if oldest == none {
+				return 0, nil
+			}
+			return oldest, nil
+		}
+		cache, ok := b.frames[frame]
+		if !ok {
+			break
+		}
+		entry := cache.latest()
+		if !cache.static && entry.stamp < oldest {
+			oldest = entry.stamp
+		}
+		frame = entry.parent
+	}
+	return 0, &ConnectivityError{fmt.Sprintf(
+		"Could not find a connection between '%s' and '%s' because they are not part of the same tree. Tf has two or more unconnected trees.",
+		target, source)}
+}
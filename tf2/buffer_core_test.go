@@ -0,0 +1,248 @@
+package tf2
+
+import (
+	"math"
+	"testing"
+
+	"github.com/akio/rosgo/msgs/geometry_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+func sec(s float64) ros.Time {
+	var t ros.Time
+	t.FromSec(s)
+	return t
+}
+
+// A transform stamped at sec seconds translating by (x, y, 0) and rotating by
+// yaw around z.
+func newTransform(parent, child string, s float64, x, y, yaw float64) geometry_msgs.TransformStamped {
+	var tf geometry_msgs.TransformStamped
+	tf.Header.FrameId = parent
+	tf.Header.Stamp = sec(s)
+	tf.ChildFrameId = child
+	tf.Transform.Translation = geometry_msgs.Vector3{X: x, Y: y}
+	tf.Transform.Rotation = geometry_msgs.Quaternion{Z: math.Sin(yaw / 2), W: math.Cos(yaw / 2)}
+	return tf
+}
+
+func setTransforms(t *testing.T, b *BufferCore, isStatic bool, transforms ...geometry_msgs.TransformStamped) {
+	t.Helper()
+	for _, tf := range transforms {
+		if err := b.SetTransform(tf, "test", isStatic); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func expectTransform(t *testing.T, tf geometry_msgs.TransformStamped, x, y, yaw float64) {
+	t.Helper()
+	const eps = 1e-9
+	q := tf.Transform.Rotation
+	actualYaw := math.Atan2(2*(q.W*q.Z+q.X*q.Y), 1-2*(q.Y*q.Y+q.Z*q.Z))
+	v := tf.Transform.Translation
+	if math.Abs(v.X-x) > eps || math.Abs(v.Y-y) > eps || math.Abs(v.Z) > eps || math.Abs(actualYaw-yaw) > eps {
+		t.Errorf("expected (%v, %v, yaw %v), got (%v, %v, %v, yaw %v)", x, y, yaw, v.X, v.Y, v.Z, actualYaw)
+	}
+}
+
+func TestLookupTransformChain(t *testing.T) {
+	b := NewBufferCore(DefaultCacheTime)
+	// map -> odom -> base_link -> laser
+	//            \-> camera
+	setTransforms(t, b, false,
+		newTransform("map", "odom", 1, 1, 0, 0),
+		newTransform("odom", "base_link", 1, 0, 2, math.Pi/2),
+		newTransform("base_link", "laser", 1, 1, 0, 0),
+		newTransform("/odom", "/camera", 1, 0, -1, 0),
+	)
+
+	tf, err := b.LookupTransform("map", "laser", sec(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tf.Header.FrameId != "map" || tf.ChildFrameId != "laser" || tf.Header.Stamp != sec(1) {
+		t.Errorf("unexpected header %v and child %s", tf.Header, tf.ChildFrameId)
+	}
+	expectTransform(t, tf, 1, 3, math.Pi/2)
+
+	tf, err = b.LookupTransform("laser", "map", sec(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectTransform(t, tf, -3, 1, -math.Pi/2)
+
+	// Through the common ancestor odom.
+	tf, err = b.LookupTransform("camera", "laser", sec(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectTransform(t, tf, 0, 4, math.Pi/2)
+
+	tf, err = b.LookupTransform("laser", "laser", sec(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectTransform(t, tf, 0, 0, 0)
+
+	if frames := b.FrameIds(); len(frames) != 5 {
+		t.Errorf("expected 5 frames, got %v", frames)
+	}
+}
+
+func TestLookupTransformInterpolation(t *testing.T) {
+	b := NewBufferCore(DefaultCacheTime)
+	setTransforms(t, b, false,
+		newTransform("odom", "base_link", 1, 0, 0, 0),
+		newTransform("odom", "base_link", 3, 2, 4, math.Pi/2),
+	)
+	tf, err := b.LookupTransform("odom", "base_link", sec(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectTransform(t, tf, 1, 2, math.Pi/4)
+
+	// The latest.
+	tf, err = b.LookupTransform("odom", "base_link", ros.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tf.Header.Stamp != sec(3) {
+		t.Errorf("expected stamp 3, got %v", tf.Header.Stamp)
+	}
+	expectTransform(t, tf, 2, 4, math.Pi/2)
+}
+
+func TestLookupTransformErrors(t *testing.T) {
+	b := NewBufferCore(DefaultCacheTime)
+	setTransforms(t, b, false,
+		newTransform("map", "odom", 1, 0, 0, 0),
+		newTransform("map", "odom", 2, 0, 0, 0),
+		newTransform("world", "robot2", 1, 0, 0, 0),
+	)
+
+	_, err := b.LookupTransform("map", "unknown", sec(1))
+	if _, ok := err.(*LookupError); !ok {
+		t.Errorf("expected LookupError, got %v", err)
+	}
+	_, err = b.LookupTransform("", "odom", sec(1))
+	if _, ok := err.(*LookupError); !ok {
+		t.Errorf("expected LookupError, got %v", err)
+	}
+	_, err = b.LookupTransform("map", "robot2", sec(1))
+	if _, ok := err.(*ConnectivityError); !ok {
+		t.Errorf("expected ConnectivityError, got %v", err)
+	}
+	_, err = b.LookupTransform("map", "robot2", ros.Time{})
+	if _, ok := err.(*ConnectivityError); !ok {
+		t.Errorf("expected ConnectivityError, got %v", err)
+	}
+	for _, s := range []float64{0.5, 2.5} {
+		_, err = b.LookupTransform("map", "odom", sec(s))
+		if _, ok := err.(*ExtrapolationError); !ok {
+			t.Errorf("expected ExtrapolationError at %v, got %v", s, err)
+		}
+	}
+	if b.CanTransform("map", "odom", sec(3)) {
+		t.Error("transform at 3 is available")
+	}
+	if !b.CanTransform("map", "odom", sec(1.5)) {
+		t.Error("transform at 1.5 is not available")
+	}
+}
+
+func TestSetTransformErrors(t *testing.T) {
+	b := NewBufferCore(ros.NewDuration(1, 0))
+	bad := []geometry_msgs.TransformStamped{
+		newTransform("map", "", 1, 0, 0, 0),
+		newTransform("", "odom", 1, 0, 0, 0),
+		newTransform("odom", "odom", 1, 0, 0, 0),
+		newTransform("map", "odom", 1, math.NaN(), 0, 0),
+	}
+	invalid := newTransform("map", "odom", 1, 0, 0, 0)
+	invalid.Transform.Rotation.W = 2
+	bad = append(bad, invalid)
+	for _, tf := range bad {
+		if err := b.SetTransform(tf, "test", false); err == nil {
+			t.Errorf("%v is accepted", tf)
+		}
+	}
+
+	setTransforms(t, b, false, newTransform("map", "odom", 5, 0, 0, 0))
+	if err := b.SetTransform(newTransform("map", "odom", 5, 1, 0, 0), "test", false); err == nil {
+		t.Error("repeated data is accepted")
+	}
+	if err := b.SetTransform(newTransform("map", "odom", 3, 1, 0, 0), "test", false); err == nil {
+		t.Error("data older than the cache time is accepted")
+	}
+
+	// Old data is dropped.
+	setTransforms(t, b, false, newTransform("map", "odom", 7, 0, 0, 0))
+	if b.CanTransform("map", "odom", sec(5.5)) {
+		t.Error("data older than the cache time is kept")
+	}
+}
+
+func TestStaticTransform(t *testing.T) {
+	b := NewBufferCore(DefaultCacheTime)
+	setTransforms(t, b, true, newTransform("base_link", "laser", 1, 1, 0, 0))
+	setTransforms(t, b, false,
+		newTransform("odom", "base_link", 10, 0, 0, 0),
+		newTransform("odom", "base_link", 12, 2, 0, 0),
+	)
+
+	// Static transforms are valid at any time.
+	tf, err := b.LookupTransform("base_link", "laser", sec(100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectTransform(t, tf, 1, 0, 0)
+	tf, err = b.LookupTransform("base_link", "laser", ros.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !tf.Header.Stamp.IsZero() {
+		t.Errorf("expected zero stamp, got %v", tf.Header.Stamp)
+	}
+
+	// The latest common time is that of the dynamic one.
+	tf, err = b.LookupTransform("odom", "laser", ros.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tf.Header.Stamp != sec(12) {
+		t.Errorf("expected stamp 12, got %v", tf.Header.Stamp)
+	}
+	expectTransform(t, tf, 3, 0, 0)
+	tf, err = b.LookupTransform("odom", "laser", sec(11))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectTransform(t, tf, 2, 0, 0)
+}
+
+func TestLatestCommonTime(t *testing.T) {
+	b := NewBufferCore(DefaultCacheTime)
+	setTransforms(t, b, false,
+		newTransform("map", "odom", 1, 0, 0, 0),
+		newTransform("map", "odom", 2, 1, 0, 0),
+		newTransform("odom", "base_link", 1, 0, 0, 0),
+		newTransform("odom", "base_link", 3, 0, 0, 0),
+		newTransform("odom", "camera", 1.5, 0, 0, 0),
+	)
+	tf, err := b.LookupTransform("map", "base_link", ros.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tf.Header.Stamp != sec(2) {
+		t.Errorf("expected stamp 2, got %v", tf.Header.Stamp)
+	}
+	// Transforms above the common ancestor do not matter.
+	tf, err = b.LookupTransform("camera", "base_link", ros.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tf.Header.Stamp != sec(1.5) {
+		t.Errorf("expected stamp 1.5, got %v", tf.Header.Stamp)
+	}
+}
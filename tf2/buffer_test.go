@@ -0,0 +1,39 @@
+package tf2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/akio/rosgo/ros"
+)
+
+func TestBufferWaitsForTransform(t *testing.T) {
+	b := NewBuffer(DefaultCacheTime)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		b.SetTransform(newTransform("map", "odom", 1, 0, 0, 0), "test", false)
+		time.Sleep(10 * time.Millisecond)
+		b.SetTransform(newTransform("odom", "base_link", 1, 1, 0, 0), "test", false)
+	}()
+	tf, err := b.LookupTransform("map", "base_link", sec(1), ros.NewDuration(5, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectTransform(t, tf, 1, 0, 0)
+}
+
+func TestBufferTimeout(t *testing.T) {
+	b := NewBuffer(DefaultCacheTime)
+	setTransforms(t, b.BufferCore, false, newTransform("map", "odom", 1, 0, 0, 0))
+	start := time.Now()
+	_, err := b.LookupTransform("map", "odom", sec(2), ros.NewDuration(0, 50000000))
+	if _, ok := err.(*ExtrapolationError); !ok {
+		t.Errorf("expected ExtrapolationError, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("returned after %v", elapsed)
+	}
+	if b.CanTransform("map", "base_link", sec(1), ros.Duration{}) {
+		t.Error("unknown transform is available")
+	}
+}
@@ -0,0 +1,108 @@
+package tf2
+
+import (
+	"math"
+
+	"github.com/akio/rosgo/msgs/geometry_msgs"
+)
+
+// Transforms map points in the child frame to the parent frame: rotate, then
+// translate.
+
+func identity() geometry_msgs.Transform {
+	return geometry_msgs.Transform{Rotation: geometry_msgs.Quaternion{W: 1}}
+}
+
+func quaternionMultiply(a, b geometry_msgs.Quaternion) geometry_msgs.Quaternion {
+	return geometry_msgs.Quaternion{
+		X: a.W*b.X + a.X*b.W + a.Y*b.Z - a.Z*b.Y,
+		Y: a.W*b.Y - a.X*b.Z + a.Y*b.W + a.Z*b.X,
+		Z: a.W*b.Z + a.X*b.Y - a.Y*b.X + a.Z*b.W,
+		W: a.W*b.W - a.X*b.X - a.Y*b.Y - a.Z*b.Z,
+	}
+}
+
+func conjugate(q geometry_msgs.Quaternion) geometry_msgs.Quaternion {
+	return geometry_msgs.Quaternion{X: -q.X, Y: -q.Y, Z: -q.Z, W: q.W}
+}
+
+func rotate(q geometry_msgs.Quaternion, v geometry_msgs.Vector3) geometry_msgs.Vector3 {
+	p := quaternionMultiply(quaternionMultiply(q, geometry_msgs.Quaternion{X: v.X, Y: v.Y, Z: v.Z}), conjugate(q))
+	return geometry_msgs.Vector3{X: p.X, Y: p.Y, Z: p.Z}
+}
+
+// multiply returns a*b, which maps points by b and then a.
+func multiply(a, b geometry_msgs.Transform) geometry_msgs.Transform {
+	t := rotate(a.Rotation, b.Translation)
+	return geometry_msgs.Transform{
+		Translation: geometry_msgs.Vector3{
+			X: a.Translation.X + t.X,
+			Y: a.Translation.Y + t.Y,
+			Z: a.Translation.Z + t.Z,
+		},
+		Rotation: quaternionMultiply(a.Rotation, b.Rotation),
+	}
+}
+
+func inverse(a geometry_msgs.Transform) geometry_msgs.Transform {
+	q := conjugate(a.Rotation)
+	t := rotate(q, a.Translation)
+	return geometry_msgs.Transform{
+		Translation: geometry_msgs.Vector3{X: -t.X, Y: -t.Y, Z: -t.Z},
+		Rotation:    q,
+	}
+}
+
+func quaternionLength2(q geometry_msgs.Quaternion) float64 {
+	return q.X*q.X + q.Y*q.Y + q.Z*q.Z + q.W*q.W
+}
+
+func normalize(q geometry_msgs.Quaternion) geometry_msgs.Quaternion {
+	l := math.Sqrt(quaternionLength2(q))
+	return geometry_msgs.Quaternion{X: q.X / l, Y: q.Y / l, Z: q.Z / l, W: q.W / l}
+}
+
+func slerp(a, b geometry_msgs.Quaternion, ratio float64) geometry_msgs.Quaternion {
+	dot := a.X*b.X + a.Y*b.Y + a.Z*b.Z + a.W*b.W
+	// Take the shorter path.
+	if dot < 0 {
+		b = geometry_msgs.Quaternion{X: -b.X, Y: -b.Y, Z: -b.Z, W: -b.W}
+		dot = -dot
+	}
+	wa, wb := 1-ratio, ratio
+	if dot < 0.9995 {
+		theta := math.Acos(dot)
+		sin := math.Sin(theta)
+		wa = math.Sin((1-ratio)*theta) / sin
+		wb = math.Sin(ratio*theta) / sin
+	}
+	return normalize(geometry_msgs.Quaternion{
+		X: wa*a.X + wb*b.X,
+		Y: wa*a.Y + wb*b.Y,
+		Z: wa*a.Z + wb*b.Z,
+		W: wa*a.W + wb*b.W,
+	})
+}
+
+func interpolate(a, b geometry_msgs.Transform, ratio float64) geometry_msgs.Transform {
+	return geometry_msgs.Transform{
+		Translation: geometry_msgs.Vector3{
+			X: a.Translation.X + (b.Translation.X-a.Translation.X)*ratio,
+			Y: a.Translation.Y + (b.Translation.Y-a.Translation.Y)*ratio,
+			Z: a.Translation.Z + (b.Translation.Z-a.Translation.Z)*ratio,
+		},
+		Rotation: slerp(a.Rotation, b.Rotation, ratio),
+	}
+}
+
+func hasNaN(t geometry_msgs.Transform) bool {
+	for _, v := range []float64{
+		t.Translation.X, t.Translation.Y, t.Translation.Z,
+		t.Rotation.X, t.Rotation.Y, t.Rotation.Z, t.Rotation.W,
+	} {
+		if math.IsNaN(v) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+package tf2
+
+import (
+	"fmt"
+
+	"github.com/akio/rosgo/msgs/geometry_msgs"
+)
+
+// A transform from the child frame to its parent at a time in nanoseconds.
+type transformEntry struct {
+	stamp     uint64
+	parent    string
+	transform geometry_msgs.Transform
+	authority string
+}
+
+// timeCache holds the transforms of a child frame sorted by time. A static
+// frame holds a single transform valid at any time.
+type timeCache struct {
+	static  bool
+	entries []transformEntry
+}
+
+func (c *timeCache) latest() transformEntry {
+	return c.entries[len(c.entries)-1]
+}
+
+func (c *timeCache) insert(entry transformEntry, cacheTime uint64) error {
+	if c.static {
+		c.entries = []transformEntry{entry}
+		return nil
+	}
+	if len(c.entries) > 0 {
+		newest := c.latest().stamp
+		if newest > cacheTime && entry.stamp < newest-cacheTime {
+			return fmt.Errorf("data at %s is older than the cache time", formatStamp(entry.stamp))
+		}
+	}
+	i := len(c.entries)
+	for i > 0 && c.entries[i-1].stamp > entry.stamp {
+		i--
+	}
+	if i > 0 && c.entries[i-1].stamp == entry.stamp {
+		return fmt.Errorf("data at %s is repeated", formatStamp(entry.stamp))
+	}
+	c.entries = append(c.entries, transformEntry{})
+	copy(c.entries[i+1:], c.entries[i:])
+	c.entries[i] = entry
+
+	newest := c.latest().stamp
+	if newest > cacheTime {
+		j := 0
+		for j < len(c.entries) && c.entries[j].stamp < newest-cacheTime {
+			j++
+		}
+		c.entries = c.entries[j:]
+	}
+	return nil
+}
+
+// Get the transform at the time, interpolating between the ones around
+// it. Zero is the latest time.
+func (c *timeCache) get(stamp uint64) (transformEntry, error) {
+	if c.static {
+		entry := c.latest()
+		entry.stamp = stamp
+		return entry, nil
+	}
+	if stamp == 0 {
+		return c.latest(), nil
+	}
+	oldest, newest := c.entries[0], c.latest()
+	if stamp < oldest.stamp {
+		return transformEntry{}, &ExtrapolationError{fmt.Sprintf(
+			"Lookup would require extrapolation into the past. Requested time %s but the earliest data is at time %s",
+			formatStamp(stamp), formatStamp(oldest.stamp))}
+	}
+	if stamp > newest.stamp {
+		return transformEntry{}, &ExtrapolationError{fmt.Sprintf(
+			"Lookup would require extrapolation into the future. Requested time %s but the latest data is at time %s",
+			formatStamp(stamp), formatStamp(newest.stamp))}
+	}
+	i := 0
+	for c.entries[i].stamp < stamp {
+		i++
+	}
+	after := c.entries[i]
+	if after.stamp == stamp {
+		return after, nil
+	}
+	before := c.entries[i-1]
+	// Transforms to different parents cannot be interpolated.
+	if before.parent != after.parent {
+		return before, nil
+	}
+	ratio := float64(stamp-before.stamp) / float64(after.stamp-before.stamp)
+	return transformEntry{
+		stamp:     stamp,
+		parent:    before.parent,
+		transform: interpolate(before.transform, after.transform, ratio),
+		authority: after.authority,
+	}, nil
+}
+
+func formatStamp(stamp uint64) string {
+	return fmt.Sprintf("%d.%09d", stamp/1000000000, stamp%1000000000)
+}
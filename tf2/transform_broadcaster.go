@@ -0,0 +1,73 @@
+package tf2
+
+import (
+	"sync"
+
+	"github.com/akio/rosgo/msgs/geometry_msgs"
+	"github.com/akio/rosgo/msgs/tf2_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+// TransformBroadcaster publishes transforms on /tf.
+type TransformBroadcaster struct {
+	pub ros.Publisher
+}
+
+func NewTransformBroadcaster(node ros.Node) *TransformBroadcaster {
+	return &TransformBroadcaster{node.NewPublisher("/tf", tf2_msgs.MsgTFMessage)}
+}
+
+// SendTransform publishes the transforms in a message.
+func (b *TransformBroadcaster) SendTransform(transforms ...geometry_msgs.TransformStamped) {
+	b.pub.Publish(&tf2_msgs.TFMessage{Transforms: transforms})
+}
+
+func (b *TransformBroadcaster) Shutdown() {
+	b.pub.Shutdown()
+}
+
+// StaticTransformBroadcaster publishes transforms which do not change on
+// /tf_static. It keeps all transforms sent so far and publishes them
+// together, as one message replaces the previous one for listeners. The
+// message is latched: subscribers connecting later receive it at once.
+type StaticTransformBroadcaster struct {
+	mutex sync.Mutex
+	pub   ros.Publisher
+	msg   tf2_msgs.TFMessage
+}
+
+func NewStaticTransformBroadcaster(node ros.Node) *StaticTransformBroadcaster {
+	return &StaticTransformBroadcaster{pub: node.NewLatchedPublisher("/tf_static", tf2_msgs.MsgTFMessage)}
+}
+
+// Copy the message so that publishers may keep it.
+func (b *StaticTransformBroadcaster) snapshot() *tf2_msgs.TFMessage {
+	transforms := make([]geometry_msgs.TransformStamped, len(b.msg.Transforms))
+	copy(transforms, b.msg.Transforms)
+	return &tf2_msgs.TFMessage{Transforms: transforms}
+}
+
+// SendTransform adds the transforms, replacing those of the same child
+// frames, and publishes all of them.
+func (b *StaticTransformBroadcaster) SendTransform(transforms ...geometry_msgs.TransformStamped) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	for _, transform := range transforms {
+		replaced := false
+		for i := range b.msg.Transforms {
+			if b.msg.Transforms[i].ChildFrameId == transform.ChildFrameId {
+				b.msg.Transforms[i] = transform
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			b.msg.Transforms = append(b.msg.Transforms, transform)
+		}
+	}
+	b.pub.Publish(b.snapshot())
+}
+
+func (b *StaticTransformBroadcaster) Shutdown() {
+	b.pub.Shutdown()
+}
@@ -0,0 +1,72 @@
+package tf2
+
+import (
+	"testing"
+
+	"github.com/akio/rosgo/internal/rostest"
+	"github.com/akio/rosgo/msgs/geometry_msgs"
+	"github.com/akio/rosgo/msgs/tf2_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+func childFrames(msg ros.Message) []string {
+	var frames []string
+	for _, transform := range msg.(*tf2_msgs.TFMessage).Transforms {
+		frames = append(frames, transform.ChildFrameId)
+	}
+	return frames
+}
+
+func TestTransformBroadcaster(t *testing.T) {
+	node := rostest.NewNode("/test_node")
+	b := NewTransformBroadcaster(node)
+	b.SendTransform(newTransform("map", "odom", 1, 0, 0, 0), newTransform("odom", "base_link", 1, 0, 0, 0))
+	published := node.Published("/tf")
+	if len(published) != 1 || node.Latched("/tf") {
+		t.Fatalf("published %d messages, latched %v", len(published), node.Latched("/tf"))
+	}
+	if frames := childFrames(published[0]); len(frames) != 2 {
+		t.Errorf("unexpected transforms %v", frames)
+	}
+	b.Shutdown()
+	if node.Advertised("/tf") {
+		t.Error("publisher is not shut down")
+	}
+}
+
+func TestStaticTransformBroadcaster(t *testing.T) {
+	node := rostest.NewNode("/test_node")
+	b := NewStaticTransformBroadcaster(node)
+	if !node.Advertised("/tf_static") || !node.Latched("/tf_static") {
+		t.Errorf("advertised %v, latched %v", node.Advertised("/tf_static"), node.Latched("/tf_static"))
+	}
+
+	b.SendTransform(newTransform("base_link", "laser", 1, 1, 0, 0))
+	b.SendTransform(newTransform("base_link", "camera", 1, 0, 0, 0), newTransform("base_link", "laser", 2, 2, 0, 0))
+	published := node.Published("/tf_static")
+	if len(published) != 2 {
+		t.Fatalf("published %d messages", len(published))
+	}
+	if frames := childFrames(published[0]); len(frames) != 1 {
+		t.Errorf("unexpected transforms %v", frames)
+	}
+	last := published[1].(*tf2_msgs.TFMessage).Transforms
+	if len(last) != 2 || last[0].ChildFrameId != "laser" || last[0].Transform.Translation != (geometry_msgs.Vector3{X: 2}) || last[1].ChildFrameId != "camera" {
+		t.Errorf("unexpected transforms %v", last)
+	}
+}
+
+func TestStaticTransformBroadcasterLoopback(t *testing.T) {
+	master := rostest.StartMaster(t)
+	b := NewStaticTransformBroadcaster(master.NewNode(t, "/broadcaster"))
+	b.SendTransform(newTransform("base_link", "laser", 1, 1, 0, 0))
+
+	// The transform sent before the listener connects is latched.
+	buffer := NewBuffer(DefaultCacheTime)
+	NewTransformListener(master.NewNode(t, "/listener"), buffer)
+	tf, err := buffer.LookupTransform("base_link", "laser", ros.Time{}, ros.NewDuration(5, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectTransform(t, tf, 1, 0, 0)
+}
@@ -0,0 +1,65 @@
+package tf2
+
+import (
+	"sync"
+
+	"github.com/akio/rosgo/msgs/tf2_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+// TransformListener fills a Buffer with the transforms published on /tf
+// and /tf_static. Messages are handled in its own goroutine, so lookups
+// with timeouts work without spinning the node.
+type TransformListener struct {
+	buffer    *Buffer
+	logger    ros.Logger
+	tfSub     ros.Subscriber
+	staticSub ros.Subscriber
+	wg        sync.WaitGroup
+}
+
+// The oldest transforms are dropped if the buffer is slow to take them, so
+// a stalled listener does not hold up other subscribers of the node.
+const listenerQueueSize = 100
+
+func NewTransformListener(node ros.Node, buffer *Buffer) *TransformListener {
+	l := &TransformListener{buffer: buffer, logger: node.Logger()}
+	tfChan, tfSub := node.SubscribeChan("/tf", tf2_msgs.MsgTFMessage, listenerQueueSize,
+		ros.WithOverflowPolicy(ros.OverflowDropOldest))
+	staticChan, staticSub := node.SubscribeChan("/tf_static", tf2_msgs.MsgTFMessage, listenerQueueSize,
+		ros.WithOverflowPolicy(ros.OverflowDropOldest))
+	l.tfSub, l.staticSub = tfSub, staticSub
+	l.wg.Add(2)
+	go l.listen(tfChan, false)
+	go l.listen(staticChan, true)
+	return l
+}
+
+func (l *TransformListener) listen(ch <-chan ros.ReceivedMessage, isStatic bool) {
+	defer l.wg.Done()
+	for received := range ch {
+		msg, ok := received.Message.(*tf2_msgs.TFMessage)
+		if !ok {
+			continue
+		}
+		authority := received.Event.PublisherName
+		for _, transform := range msg.Transforms {
+			if err := l.buffer.SetTransform(transform, authority, isStatic); err != nil {
+				l.logger.Warn(err)
+			}
+		}
+	}
+}
+
+// Buffer returns the buffer which the listener fills.
+func (l *TransformListener) Buffer() *Buffer {
+	return l.buffer
+}
+
+// Shutdown stops receiving transforms and waits for the received messages
+// to be handled. Other listeners of the node keep receiving.
+func (l *TransformListener) Shutdown() {
+	l.tfSub.Shutdown()
+	l.staticSub.Shutdown()
+	l.wg.Wait()
+}
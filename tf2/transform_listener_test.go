@@ -0,0 +1,83 @@
+package tf2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/akio/rosgo/internal/rostest"
+	"github.com/akio/rosgo/msgs/geometry_msgs"
+	"github.com/akio/rosgo/msgs/tf2_msgs"
+	"github.com/akio/rosgo/ros"
+)
+
+func TestTransformListener(t *testing.T) {
+	node := rostest.NewNode("/test_node")
+	buffer := NewBuffer(DefaultCacheTime)
+	listener := NewTransformListener(node, buffer)
+	node.NewPublisher("/tf", tf2_msgs.MsgTFMessage).Publish(&tf2_msgs.TFMessage{
+		Transforms: []geometry_msgs.TransformStamped{newTransform("map", "odom", 1, 1, 0, 0)},
+	})
+	node.NewLatchedPublisher("/tf_static", tf2_msgs.MsgTFMessage).Publish(&tf2_msgs.TFMessage{
+		Transforms: []geometry_msgs.TransformStamped{newTransform("odom", "base_link", 1, 0, 1, 0)},
+	})
+	tf, err := buffer.LookupTransform("map", "base_link", sec(1), ros.NewDuration(5, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectTransform(t, tf, 1, 1, 0)
+
+	listener.Shutdown()
+	if node.Subscribed("/tf") || node.Subscribed("/tf_static") {
+		t.Error("expected the subscribers to be shut down")
+	}
+	if listener.Buffer() != buffer {
+		t.Error("unexpected buffer")
+	}
+}
+
+func TestTransformListenerLoopback(t *testing.T) {
+	master := rostest.StartMaster(t)
+	broadcaster := NewTransformBroadcaster(master.NewNode(t, "/broadcaster"))
+	buffer := NewBuffer(DefaultCacheTime)
+	NewTransformListener(master.NewNode(t, "/listener"), buffer)
+
+	// Transforms sent before the listener connects are lost, so send
+	// until one is received.
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		broadcaster.SendTransform(newTransform("map", "odom", 1, 1, 2, 0))
+		tf, err := buffer.LookupTransform("map", "odom", sec(1), ros.NewDuration(0, 10000000))
+		if err == nil {
+			expectTransform(t, tf, 1, 2, 0)
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestTransformListenersOnOneNode(t *testing.T) {
+	master := rostest.StartMaster(t)
+	broadcaster := NewTransformBroadcaster(master.NewNode(t, "/broadcaster"))
+	node := master.NewNode(t, "/listener")
+	first := NewTransformListener(node, NewBuffer(DefaultCacheTime))
+	buffer := NewBuffer(DefaultCacheTime)
+	NewTransformListener(node, buffer)
+	first.Shutdown()
+
+	// Transforms sent before the listener connects are lost, so send
+	// until one is received.
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		broadcaster.SendTransform(newTransform("map", "odom", 1, 1, 2, 0))
+		tf, err := buffer.LookupTransform("map", "odom", sec(1), ros.NewDuration(0, 10000000))
+		if err == nil {
+			expectTransform(t, tf, 1, 2, 0)
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal(err)
+		}
+	}
+}